@@ -0,0 +1,25 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/mathbdw/book/config"
+	"github.com/mathbdw/book/internal/app"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "only report how many books would be purged")
+	flag.Parse()
+
+	cfg, err := config.ReadConfigYML("config.yml")
+	if err != nil {
+		log.Fatalf("Config error: %s", err)
+	}
+
+	if *dryRun {
+		cfg.Purge.DryRun = true
+	}
+
+	app.RunPurge(cfg)
+}
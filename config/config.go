@@ -29,6 +29,7 @@ type Database struct {
 	MaxIdleConns    int           `yaml:"maxIdleConns"`
 	ConnMaxIdleTime time.Duration `yaml:"connMaxIdleTime"`
 	ConnMaxLifetime time.Duration `yaml:"connMaxLifetime"`
+	SearchConfig    string        `yaml:"searchConfig"`
 }
 
 // Graylog - contains parameter address gelf
@@ -100,6 +101,11 @@ type Publisher struct {
 	Interval     time.Duration `yaml:"interval"`
 	BatchSize    uint64        `yaml:"batchSize"`
 	CountWorkers uint8         `yaml:"countWorkers"`
+	MaxAttempts  int32         `yaml:"maxAttempts"`
+	RetryBase    time.Duration `yaml:"retryBase"`
+	RetryMax     time.Duration `yaml:"retryMax"`
+	LockLease    time.Duration `yaml:"lockLease"`
+	Listen       bool          `yaml:"listen"`
 }
 
 // Topics - topics for kafka
@@ -125,6 +131,27 @@ type Status struct {
 	Port          uint16 `yaml:"port"`
 }
 
+// Purge - hard purge of removed books
+type Purge struct {
+	Retention time.Duration `yaml:"retention"`
+	BatchSize uint64        `yaml:"batchSize"`
+	Interval  time.Duration `yaml:"interval"`
+	DryRun    bool          `yaml:"dryRun"`
+}
+
+// Cursor - signing of the pagination cursors, the secrets come from CURSOR_KEYS as "id:secret,id:secret"
+type Cursor struct {
+	KeyID          string            `yaml:"keyID"`
+	Keys           map[string]string `yaml:"-" env:"CURSOR_KEYS,required" envKeyValSeparator:":"`
+	TTL            time.Duration     `yaml:"ttl"`
+	AcceptUnsigned bool              `yaml:"acceptUnsigned"`
+}
+
+// Idempotency - idempotency keys of the mutating requests
+type Idempotency struct {
+	TTL time.Duration `yaml:"ttl"`
+}
+
 type Bot struct {
 	Token       string `yaml:"token" env:"TBOT_TOKEN,required"`
 	ReadTimeout int    `yaml:"readTimeout"`
@@ -142,6 +169,10 @@ type Config struct {
 	Kafka    Kafka    `yaml:"kafka"`
 	Status   Status   `yaml:"status"`
 	Bot      Bot      `yaml:"telegram"`
+	Purge    Purge    `yaml:"purge"`
+	Cursor   Cursor   `yaml:"cursor"`
+
+	Idempotency Idempotency `yaml:"idempotency"`
 }
 
 // ReadConfigYML - read configurations from file and init instance Config.
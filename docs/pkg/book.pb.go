@@ -13,6 +13,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -25,6 +27,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BatchMode int32
+
+const (
+	BatchMode_BATCH_MODE_ALL_OR_NOTHING BatchMode = 0
+	BatchMode_BATCH_MODE_BEST_EFFORT    BatchMode = 1
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_ALL_OR_NOTHING",
+		1: "BATCH_MODE_BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_ALL_OR_NOTHING": 0,
+		"BATCH_MODE_BEST_EFFORT":    1,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_book_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_v1_book_proto_enumTypes[0]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{0}
+}
+
+type BookChangeType int32
+
+const (
+	BookChangeType_BOOK_CHANGE_TYPE_UNSPECIFIED BookChangeType = 0
+	BookChangeType_BOOK_CHANGE_TYPE_CREATED     BookChangeType = 1
+	BookChangeType_BOOK_CHANGE_TYPE_UPDATED     BookChangeType = 2
+	BookChangeType_BOOK_CHANGE_TYPE_DELETED     BookChangeType = 3
+	BookChangeType_BOOK_CHANGE_TYPE_RESTORED    BookChangeType = 4
+	BookChangeType_BOOK_CHANGE_TYPE_MERGED      BookChangeType = 5
+	BookChangeType_BOOK_CHANGE_TYPE_PURGED      BookChangeType = 6
+)
+
+// Enum value maps for BookChangeType.
+var (
+	BookChangeType_name = map[int32]string{
+		0: "BOOK_CHANGE_TYPE_UNSPECIFIED",
+		1: "BOOK_CHANGE_TYPE_CREATED",
+		2: "BOOK_CHANGE_TYPE_UPDATED",
+		3: "BOOK_CHANGE_TYPE_DELETED",
+		4: "BOOK_CHANGE_TYPE_RESTORED",
+		5: "BOOK_CHANGE_TYPE_MERGED",
+		6: "BOOK_CHANGE_TYPE_PURGED",
+	}
+	BookChangeType_value = map[string]int32{
+		"BOOK_CHANGE_TYPE_UNSPECIFIED": 0,
+		"BOOK_CHANGE_TYPE_CREATED":     1,
+		"BOOK_CHANGE_TYPE_UPDATED":     2,
+		"BOOK_CHANGE_TYPE_DELETED":     3,
+		"BOOK_CHANGE_TYPE_RESTORED":    4,
+		"BOOK_CHANGE_TYPE_MERGED":      5,
+		"BOOK_CHANGE_TYPE_PURGED":      6,
+	}
+)
+
+func (x BookChangeType) Enum() *BookChangeType {
+	p := new(BookChangeType)
+	*p = x
+	return p
+}
+
+func (x BookChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_book_proto_enumTypes[1].Descriptor()
+}
+
+func (BookChangeType) Type() protoreflect.EnumType {
+	return &file_v1_book_proto_enumTypes[1]
+}
+
+func (x BookChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookChangeType.Descriptor instead.
+func (BookChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{1}
+}
+
 type Book struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -32,25 +141,1527 @@ type Book struct {
 	Description   string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
 	Year          int32                  `protobuf:"varint,4,opt,name=Year,proto3" json:"Year,omitempty"`
 	Genre         string                 `protobuf:"bytes,5,opt,name=Genre,proto3" json:"Genre,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Authors       []*Author              `protobuf:"bytes,7,rep,name=authors,proto3" json:"authors,omitempty"`
+	GenreId       int64                  `protobuf:"varint,8,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	Isbn          string                 `protobuf:"bytes,9,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Version       int64                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Book) Reset() {
+	*x = Book{}
+	mi := &file_v1_book_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Book) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Book) ProtoMessage() {}
+
+func (x *Book) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Book.ProtoReflect.Descriptor instead.
+func (*Book) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Book) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Book) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Book) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Book) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Book) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *Book) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Book) GetAuthors() []*Author {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Book) GetGenreId() int64 {
+	if x != nil {
+		return x.GenreId
+	}
+	return 0
+}
+
+func (x *Book) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *Book) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type Genre struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Genre) Reset() {
+	*x = Genre{}
+	mi := &file_v1_book_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genre) ProtoMessage() {}
+
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Genre) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Genre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Genre) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Genre) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Author struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	mi := &file_v1_book_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Author) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Author) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Author) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type BookGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        []int64                `protobuf:"varint,1,rep,packed,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookGetRequest) Reset() {
+	*x = BookGetRequest{}
+	mi := &file_v1_book_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookGetRequest) ProtoMessage() {}
+
+func (x *BookGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookGetRequest.ProtoReflect.Descriptor instead.
+func (*BookGetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BookGetRequest) GetBookId() []int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return nil
+}
+
+func (x *BookGetRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type BookChangeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BookId          []int64                `protobuf:"varint,1,rep,packed,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BookChangeRequest) Reset() {
+	*x = BookChangeRequest{}
+	mi := &file_v1_book_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookChangeRequest) ProtoMessage() {}
+
+func (x *BookChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookChangeRequest.ProtoReflect.Descriptor instead.
+func (*BookChangeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BookChangeRequest) GetBookId() []int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return nil
+}
+
+func (x *BookChangeRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type BookAddRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Year        int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	// Deprecated: Marked as deprecated in v1/book.proto.
+	Genre         string  `protobuf:"bytes,4,opt,name=genre,proto3" json:"genre,omitempty"`
+	AuthorIds     []int64 `protobuf:"varint,5,rep,packed,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	GenreId       int64   `protobuf:"varint,6,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	Isbn          string  `protobuf:"bytes,7,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Force         bool    `protobuf:"varint,8,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookAddRequest) Reset() {
+	*x = BookAddRequest{}
+	mi := &file_v1_book_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookAddRequest) ProtoMessage() {}
+
+func (x *BookAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookAddRequest.ProtoReflect.Descriptor instead.
+func (*BookAddRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BookAddRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BookAddRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BookAddRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in v1/book.proto.
+func (x *BookAddRequest) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *BookAddRequest) GetAuthorIds() []int64 {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+func (x *BookAddRequest) GetGenreId() int64 {
+	if x != nil {
+		return x.GenreId
+	}
+	return 0
+}
+
+func (x *BookAddRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *BookAddRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// BookDuplicates - the details of AlreadyExists of Add when the book looks like the stored books
+type BookDuplicates struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        []int64                `protobuf:"varint,1,rep,packed,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookDuplicates) Reset() {
+	*x = BookDuplicates{}
+	mi := &file_v1_book_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookDuplicates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookDuplicates) ProtoMessage() {}
+
+func (x *BookDuplicates) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookDuplicates.ProtoReflect.Descriptor instead.
+func (*BookDuplicates) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BookDuplicates) GetBookId() []int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return nil
+}
+
+type BookGetByISBNRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isbn          string                 `protobuf:"bytes,1,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookGetByISBNRequest) Reset() {
+	*x = BookGetByISBNRequest{}
+	mi := &file_v1_book_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookGetByISBNRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookGetByISBNRequest) ProtoMessage() {}
+
+func (x *BookGetByISBNRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookGetByISBNRequest.ProtoReflect.Descriptor instead.
+func (*BookGetByISBNRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BookGetByISBNRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+type BookBatchAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Books         []*BookAddRequest      `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	Mode          BatchMode              `protobuf:"varint,2,opt,name=mode,proto3,enum=mathbdw.grpc.v1.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookBatchAddRequest) Reset() {
+	*x = BookBatchAddRequest{}
+	mi := &file_v1_book_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookBatchAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookBatchAddRequest) ProtoMessage() {}
+
+func (x *BookBatchAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookBatchAddRequest.ProtoReflect.Descriptor instead.
+func (*BookBatchAddRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BookBatchAddRequest) GetBooks() []*BookAddRequest {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+func (x *BookBatchAddRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_ALL_OR_NOTHING
+}
+
+type BookBatchAddResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Book          *Book                  `protobuf:"bytes,2,opt,name=book,proto3" json:"book,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookBatchAddResult) Reset() {
+	*x = BookBatchAddResult{}
+	mi := &file_v1_book_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookBatchAddResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookBatchAddResult) ProtoMessage() {}
+
+func (x *BookBatchAddResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookBatchAddResult.ProtoReflect.Descriptor instead.
+func (*BookBatchAddResult) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BookBatchAddResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BookBatchAddResult) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *BookBatchAddResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BookBatchAddResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BookBatchAddResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookBatchAddResponse) Reset() {
+	*x = BookBatchAddResponse{}
+	mi := &file_v1_book_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookBatchAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookBatchAddResponse) ProtoMessage() {}
+
+func (x *BookBatchAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookBatchAddResponse.ProtoReflect.Descriptor instead.
+func (*BookBatchAddResponse) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BookBatchAddResponse) GetResults() []*BookBatchAddResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BookUpdateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Year        int32                  `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
+	// Deprecated: Marked as deprecated in v1/book.proto.
+	Genre           string                 `protobuf:"bytes,5,opt,name=genre,proto3" json:"genre,omitempty"`
+	UpdateMask      *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	AuthorIds       []int64                `protobuf:"varint,7,rep,packed,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	GenreId         int64                  `protobuf:"varint,8,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	Isbn            string                 `protobuf:"bytes,9,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,10,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BookUpdateRequest) Reset() {
+	*x = BookUpdateRequest{}
+	mi := &file_v1_book_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookUpdateRequest) ProtoMessage() {}
+
+func (x *BookUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookUpdateRequest.ProtoReflect.Descriptor instead.
+func (*BookUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BookUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BookUpdateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BookUpdateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BookUpdateRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in v1/book.proto.
+func (x *BookUpdateRequest) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *BookUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *BookUpdateRequest) GetAuthorIds() []int64 {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+func (x *BookUpdateRequest) GetGenreId() int64 {
+	if x != nil {
+		return x.GenreId
+	}
+	return 0
+}
+
+func (x *BookUpdateRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *BookUpdateRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type BookListRequest struct {
+	state          protoimpl.MessageState            `protogen:"open.v1"`
+	Pagination     *BookListRequest_CursorPagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	AuthorId       int64                             `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	GenreId        int64                             `protobuf:"varint,3,opt,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	YearFrom       int32                             `protobuf:"varint,4,opt,name=year_from,json=yearFrom,proto3" json:"year_from,omitempty"`
+	YearTo         int32                             `protobuf:"varint,5,opt,name=year_to,json=yearTo,proto3" json:"year_to,omitempty"`
+	TitlePrefix    string                            `protobuf:"bytes,6,opt,name=title_prefix,json=titlePrefix,proto3" json:"title_prefix,omitempty"`
+	IncludeRemoved bool                              `protobuf:"varint,7,opt,name=include_removed,json=includeRemoved,proto3" json:"include_removed,omitempty"`
+	AsOf           *timestamppb.Timestamp            `protobuf:"bytes,8,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BookListRequest) Reset() {
+	*x = BookListRequest{}
+	mi := &file_v1_book_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookListRequest) ProtoMessage() {}
+
+func (x *BookListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookListRequest.ProtoReflect.Descriptor instead.
+func (*BookListRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BookListRequest) GetPagination() *BookListRequest_CursorPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *BookListRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *BookListRequest) GetGenreId() int64 {
+	if x != nil {
+		return x.GenreId
+	}
+	return 0
+}
+
+func (x *BookListRequest) GetYearFrom() int32 {
+	if x != nil {
+		return x.YearFrom
+	}
+	return 0
+}
+
+func (x *BookListRequest) GetYearTo() int32 {
+	if x != nil {
+		return x.YearTo
+	}
+	return 0
+}
+
+func (x *BookListRequest) GetTitlePrefix() string {
+	if x != nil {
+		return x.TitlePrefix
+	}
+	return ""
+}
+
+func (x *BookListRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *BookListRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type BookSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      uint64                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookSearchRequest) Reset() {
+	*x = BookSearchRequest{}
+	mi := &file_v1_book_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookSearchRequest) ProtoMessage() {}
+
+func (x *BookSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookSearchRequest.ProtoReflect.Descriptor instead.
+func (*BookSearchRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BookSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *BookSearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *BookSearchRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AuthorAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorAddRequest) Reset() {
+	*x = AuthorAddRequest{}
+	mi := &file_v1_book_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorAddRequest) ProtoMessage() {}
+
+func (x *AuthorAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorAddRequest.ProtoReflect.Descriptor instead.
+func (*AuthorAddRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuthorAddRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AuthorGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      []int64                `protobuf:"varint,1,rep,packed,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorGetRequest) Reset() {
+	*x = AuthorGetRequest{}
+	mi := &file_v1_book_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorGetRequest) ProtoMessage() {}
+
+func (x *AuthorGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorGetRequest.ProtoReflect.Descriptor instead.
+func (*AuthorGetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AuthorGetRequest) GetAuthorId() []int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return nil
+}
+
+type AuthorUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorUpdateRequest) Reset() {
+	*x = AuthorUpdateRequest{}
+	mi := &file_v1_book_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorUpdateRequest) ProtoMessage() {}
+
+func (x *AuthorUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AuthorUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AuthorUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuthorUpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AuthorListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      uint64                 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	AfterId       int64                  `protobuf:"varint,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorListRequest) Reset() {
+	*x = AuthorListRequest{}
+	mi := &file_v1_book_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorListRequest) ProtoMessage() {}
+
+func (x *AuthorListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorListRequest.ProtoReflect.Descriptor instead.
+func (*AuthorListRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuthorListRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AuthorListRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+type AuthorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authors       []*Author              `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorsResponse) Reset() {
+	*x = AuthorsResponse{}
+	mi := &file_v1_book_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorsResponse) ProtoMessage() {}
+
+func (x *AuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorsResponse.ProtoReflect.Descriptor instead.
+func (*AuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AuthorsResponse) GetAuthors() []*Author {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+type AuthorListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authors       []*Author              `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	NextAfterId   int64                  `protobuf:"varint,2,opt,name=next_after_id,json=nextAfterId,proto3" json:"next_after_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorListResponse) Reset() {
+	*x = AuthorListResponse{}
+	mi := &file_v1_book_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorListResponse) ProtoMessage() {}
+
+func (x *AuthorListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorListResponse.ProtoReflect.Descriptor instead.
+func (*AuthorListResponse) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AuthorListResponse) GetAuthors() []*Author {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *AuthorListResponse) GetNextAfterId() int64 {
+	if x != nil {
+		return x.NextAfterId
+	}
+	return 0
+}
+
+type GenreAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenreAddRequest) Reset() {
+	*x = GenreAddRequest{}
+	mi := &file_v1_book_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenreAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenreAddRequest) ProtoMessage() {}
+
+func (x *GenreAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenreAddRequest.ProtoReflect.Descriptor instead.
+func (*GenreAddRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GenreAddRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GenreAddRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type GenreGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GenreId       []int64                `protobuf:"varint,1,rep,packed,name=genre_id,json=genreId,proto3" json:"genre_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenreGetRequest) Reset() {
+	*x = GenreGetRequest{}
+	mi := &file_v1_book_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenreGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenreGetRequest) ProtoMessage() {}
+
+func (x *GenreGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenreGetRequest.ProtoReflect.Descriptor instead.
+func (*GenreGetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GenreGetRequest) GetGenreId() []int64 {
+	if x != nil {
+		return x.GenreId
+	}
+	return nil
+}
+
+type GenreUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenreUpdateRequest) Reset() {
+	*x = GenreUpdateRequest{}
+	mi := &file_v1_book_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenreUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenreUpdateRequest) ProtoMessage() {}
+
+func (x *GenreUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenreUpdateRequest.ProtoReflect.Descriptor instead.
+func (*GenreUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GenreUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GenreUpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GenreUpdateRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type GenresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genres        []*Genre               `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenresResponse) Reset() {
+	*x = GenresResponse{}
+	mi := &file_v1_book_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenresResponse) ProtoMessage() {}
+
+func (x *GenresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenresResponse.ProtoReflect.Descriptor instead.
+func (*GenresResponse) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GenresResponse) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+type BooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Book          []*Book                `protobuf:"bytes,1,rep,name=book,proto3" json:"book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BooksResponse) Reset() {
+	*x = BooksResponse{}
+	mi := &file_v1_book_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BooksResponse) ProtoMessage() {}
+
+func (x *BooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BooksResponse.ProtoReflect.Descriptor instead.
+func (*BooksResponse) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BooksResponse) GetBook() []*Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+type BookListResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Pagination    *BookListResponse_CursorPagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Books         []*Book                            `protobuf:"bytes,2,rep,name=books,proto3" json:"books,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Book) Reset() {
-	*x = Book{}
-	mi := &file_v1_book_proto_msgTypes[0]
+func (x *BookListResponse) Reset() {
+	*x = BookListResponse{}
+	mi := &file_v1_book_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Book) String() string {
+func (x *BookListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Book) ProtoMessage() {}
+func (*BookListResponse) ProtoMessage() {}
 
-func (x *Book) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_book_proto_msgTypes[0]
+func (x *BookListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,68 +1672,108 @@ func (x *Book) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Book.ProtoReflect.Descriptor instead.
-func (*Book) Descriptor() ([]byte, []int) {
-	return file_v1_book_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use BookListResponse.ProtoReflect.Descriptor instead.
+func (*BookListResponse) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Book) GetId() int64 {
+func (x *BookListResponse) GetPagination() *BookListResponse_CursorPagination {
 	if x != nil {
-		return x.Id
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (x *Book) GetTitle() string {
+func (x *BookListResponse) GetBooks() []*Book {
 	if x != nil {
-		return x.Title
+		return x.Books
 	}
-	return ""
+	return nil
 }
 
-func (x *Book) GetDescription() string {
+type BookSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Book          *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	Rank          float32                `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet       string                 `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookSearchResult) Reset() {
+	*x = BookSearchResult{}
+	mi := &file_v1_book_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookSearchResult) ProtoMessage() {}
+
+func (x *BookSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[26]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Book) GetYear() int32 {
+// Deprecated: Use BookSearchResult.ProtoReflect.Descriptor instead.
+func (*BookSearchResult) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BookSearchResult) GetBook() *Book {
 	if x != nil {
-		return x.Year
+		return x.Book
+	}
+	return nil
+}
+
+func (x *BookSearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
 	}
 	return 0
 }
 
-func (x *Book) GetGenre() string {
+func (x *BookSearchResult) GetSnippet() string {
 	if x != nil {
-		return x.Genre
+		return x.Snippet
 	}
 	return ""
 }
 
-type BookGetRequest struct {
+type BookSearchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookId        []int64                `protobuf:"varint,1,rep,packed,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Results       []*BookSearchResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	CursorNext    string                 `protobuf:"bytes,2,opt,name=cursor_next,json=cursorNext,proto3" json:"cursor_next,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BookGetRequest) Reset() {
-	*x = BookGetRequest{}
-	mi := &file_v1_book_proto_msgTypes[1]
+func (x *BookSearchResponse) Reset() {
+	*x = BookSearchResponse{}
+	mi := &file_v1_book_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BookGetRequest) String() string {
+func (x *BookSearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookGetRequest) ProtoMessage() {}
+func (*BookSearchResponse) ProtoMessage() {}
 
-func (x *BookGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_book_proto_msgTypes[1]
+func (x *BookSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -133,43 +1784,48 @@ func (x *BookGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookGetRequest.ProtoReflect.Descriptor instead.
-func (*BookGetRequest) Descriptor() ([]byte, []int) {
-	return file_v1_book_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use BookSearchResponse.ProtoReflect.Descriptor instead.
+func (*BookSearchResponse) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *BookGetRequest) GetBookId() []int64 {
+func (x *BookSearchResponse) GetResults() []*BookSearchResult {
 	if x != nil {
-		return x.BookId
+		return x.Results
 	}
 	return nil
 }
 
-type BookAddRequest struct {
+func (x *BookSearchResponse) GetCursorNext() string {
+	if x != nil {
+		return x.CursorNext
+	}
+	return ""
+}
+
+type BookMergeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Year          int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
-	Genre         string                 `protobuf:"bytes,4,opt,name=genre,proto3" json:"genre,omitempty"`
+	SourceIds     []int64                `protobuf:"varint,1,rep,packed,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BookAddRequest) Reset() {
-	*x = BookAddRequest{}
-	mi := &file_v1_book_proto_msgTypes[2]
+func (x *BookMergeRequest) Reset() {
+	*x = BookMergeRequest{}
+	mi := &file_v1_book_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BookAddRequest) String() string {
+func (x *BookMergeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookAddRequest) ProtoMessage() {}
+func (*BookMergeRequest) ProtoMessage() {}
 
-func (x *BookAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_book_proto_msgTypes[2]
+func (x *BookMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -180,61 +1836,95 @@ func (x *BookAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookAddRequest.ProtoReflect.Descriptor instead.
-func (*BookAddRequest) Descriptor() ([]byte, []int) {
-	return file_v1_book_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use BookMergeRequest.ProtoReflect.Descriptor instead.
+func (*BookMergeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *BookAddRequest) GetTitle() string {
+func (x *BookMergeRequest) GetSourceIds() []int64 {
 	if x != nil {
-		return x.Title
+		return x.SourceIds
 	}
-	return ""
+	return nil
 }
 
-func (x *BookAddRequest) GetDescription() string {
+func (x *BookMergeRequest) GetTargetId() int64 {
 	if x != nil {
-		return x.Description
+		return x.TargetId
 	}
-	return ""
+	return 0
 }
 
-func (x *BookAddRequest) GetYear() int32 {
+type BookHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookId        int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookHistoryRequest) Reset() {
+	*x = BookHistoryRequest{}
+	mi := &file_v1_book_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookHistoryRequest) ProtoMessage() {}
+
+func (x *BookHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[29]
 	if x != nil {
-		return x.Year
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *BookAddRequest) GetGenre() string {
+// Deprecated: Use BookHistoryRequest.ProtoReflect.Descriptor instead.
+func (*BookHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BookHistoryRequest) GetBookId() int64 {
 	if x != nil {
-		return x.Genre
+		return x.BookId
 	}
-	return ""
+	return 0
 }
 
-type BookListRequest struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Pagination    *BookListRequest_CursorPagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type BookVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Book          *Book                  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	Removed       bool                   `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+	Change        BookChangeType         `protobuf:"varint,3,opt,name=change,proto3,enum=mathbdw.grpc.v1.BookChangeType" json:"change,omitempty"`
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BookListRequest) Reset() {
-	*x = BookListRequest{}
-	mi := &file_v1_book_proto_msgTypes[3]
+func (x *BookVersion) Reset() {
+	*x = BookVersion{}
+	mi := &file_v1_book_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BookListRequest) String() string {
+func (x *BookVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookListRequest) ProtoMessage() {}
+func (*BookVersion) ProtoMessage() {}
 
-func (x *BookListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_book_proto_msgTypes[3]
+func (x *BookVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,40 +1935,68 @@ func (x *BookListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookListRequest.ProtoReflect.Descriptor instead.
-func (*BookListRequest) Descriptor() ([]byte, []int) {
-	return file_v1_book_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use BookVersion.ProtoReflect.Descriptor instead.
+func (*BookVersion) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *BookListRequest) GetPagination() *BookListRequest_CursorPagination {
+func (x *BookVersion) GetBook() *Book {
 	if x != nil {
-		return x.Pagination
+		return x.Book
 	}
 	return nil
 }
 
-type BooksResponse struct {
+func (x *BookVersion) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *BookVersion) GetChange() BookChangeType {
+	if x != nil {
+		return x.Change
+	}
+	return BookChangeType_BOOK_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *BookVersion) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BookVersion) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type BookHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Book          []*Book                `protobuf:"bytes,1,rep,name=book,proto3" json:"book,omitempty"`
+	Versions      []*BookVersion         `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BooksResponse) Reset() {
-	*x = BooksResponse{}
-	mi := &file_v1_book_proto_msgTypes[4]
+func (x *BookHistoryResponse) Reset() {
+	*x = BookHistoryResponse{}
+	mi := &file_v1_book_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BooksResponse) String() string {
+func (x *BookHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BooksResponse) ProtoMessage() {}
+func (*BookHistoryResponse) ProtoMessage() {}
 
-func (x *BooksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_book_proto_msgTypes[4]
+func (x *BookHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,41 +2007,41 @@ func (x *BooksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BooksResponse.ProtoReflect.Descriptor instead.
-func (*BooksResponse) Descriptor() ([]byte, []int) {
-	return file_v1_book_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BookHistoryResponse.ProtoReflect.Descriptor instead.
+func (*BookHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *BooksResponse) GetBook() []*Book {
+func (x *BookHistoryResponse) GetVersions() []*BookVersion {
 	if x != nil {
-		return x.Book
+		return x.Versions
 	}
 	return nil
 }
 
-type BookListResponse struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	Pagination    *BookListResponse_CursorPagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Books         []*Book                            `protobuf:"bytes,2,rep,name=books,proto3" json:"books,omitempty"`
+type BookListRequest_Sort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Order         string                 `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BookListResponse) Reset() {
-	*x = BookListResponse{}
-	mi := &file_v1_book_proto_msgTypes[5]
+func (x *BookListRequest_Sort) Reset() {
+	*x = BookListRequest_Sort{}
+	mi := &file_v1_book_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BookListResponse) String() string {
+func (x *BookListRequest_Sort) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookListResponse) ProtoMessage() {}
+func (*BookListRequest_Sort) ProtoMessage() {}
 
-func (x *BookListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_book_proto_msgTypes[5]
+func (x *BookListRequest_Sort) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_book_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,38 +2052,40 @@ func (x *BookListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookListResponse.ProtoReflect.Descriptor instead.
-func (*BookListResponse) Descriptor() ([]byte, []int) {
-	return file_v1_book_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BookListRequest_Sort.ProtoReflect.Descriptor instead.
+func (*BookListRequest_Sort) Descriptor() ([]byte, []int) {
+	return file_v1_book_proto_rawDescGZIP(), []int{12, 0}
 }
 
-func (x *BookListResponse) GetPagination() *BookListResponse_CursorPagination {
+func (x *BookListRequest_Sort) GetField() string {
 	if x != nil {
-		return x.Pagination
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-func (x *BookListResponse) GetBooks() []*Book {
+func (x *BookListRequest_Sort) GetOrder() string {
 	if x != nil {
-		return x.Books
+		return x.Order
 	}
-	return nil
+	return ""
 }
 
 type BookListRequest_CursorPagination struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	PageSize      uint64                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	SortBy        string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
-	SortOrder     string                 `protobuf:"bytes,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Cursor         string                  `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize       uint64                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SortBy         string                  `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder      string                  `protobuf:"bytes,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	WithTotalCount bool                    `protobuf:"varint,5,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	ThenBy         []*BookListRequest_Sort `protobuf:"bytes,6,rep,name=then_by,json=thenBy,proto3" json:"then_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BookListRequest_CursorPagination) Reset() {
 	*x = BookListRequest_CursorPagination{}
-	mi := &file_v1_book_proto_msgTypes[6]
+	mi := &file_v1_book_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -377,7 +2097,7 @@ func (x *BookListRequest_CursorPagination) String() string {
 func (*BookListRequest_CursorPagination) ProtoMessage() {}
 
 func (x *BookListRequest_CursorPagination) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_book_proto_msgTypes[6]
+	mi := &file_v1_book_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +2110,7 @@ func (x *BookListRequest_CursorPagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BookListRequest_CursorPagination.ProtoReflect.Descriptor instead.
 func (*BookListRequest_CursorPagination) Descriptor() ([]byte, []int) {
-	return file_v1_book_proto_rawDescGZIP(), []int{3, 0}
+	return file_v1_book_proto_rawDescGZIP(), []int{12, 1}
 }
 
 func (x *BookListRequest_CursorPagination) GetCursor() string {
@@ -421,16 +2141,34 @@ func (x *BookListRequest_CursorPagination) GetSortOrder() string {
 	return ""
 }
 
+func (x *BookListRequest_CursorPagination) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+func (x *BookListRequest_CursorPagination) GetThenBy() []*BookListRequest_Sort {
+	if x != nil {
+		return x.ThenBy
+	}
+	return nil
+}
+
 type BookListResponse_CursorPagination struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CursorNext    string                 `protobuf:"bytes,1,opt,name=cursorNext,proto3" json:"cursorNext,omitempty"`
+	CursorPrev    string                 `protobuf:"bytes,2,opt,name=cursorPrev,proto3" json:"cursorPrev,omitempty"`
+	HasPrevious   bool                   `protobuf:"varint,3,opt,name=hasPrevious,proto3" json:"hasPrevious,omitempty"`
+	HasNext       bool                   `protobuf:"varint,4,opt,name=hasNext,proto3" json:"hasNext,omitempty"`
+	TotalCount    *int64                 `protobuf:"varint,5,opt,name=totalCount,proto3,oneof" json:"totalCount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BookListResponse_CursorPagination) Reset() {
 	*x = BookListResponse_CursorPagination{}
-	mi := &file_v1_book_proto_msgTypes[7]
+	mi := &file_v1_book_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -442,7 +2180,7 @@ func (x *BookListResponse_CursorPagination) String() string {
 func (*BookListResponse_CursorPagination) ProtoMessage() {}
 
 func (x *BookListResponse_CursorPagination) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_book_proto_msgTypes[7]
+	mi := &file_v1_book_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +2193,7 @@ func (x *BookListResponse_CursorPagination) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use BookListResponse_CursorPagination.ProtoReflect.Descriptor instead.
 func (*BookListResponse_CursorPagination) Descriptor() ([]byte, []int) {
-	return file_v1_book_proto_rawDescGZIP(), []int{5, 0}
+	return file_v1_book_proto_rawDescGZIP(), []int{25, 0}
 }
 
 func (x *BookListResponse_CursorPagination) GetCursorNext() string {
@@ -465,63 +2203,298 @@ func (x *BookListResponse_CursorPagination) GetCursorNext() string {
 	return ""
 }
 
+func (x *BookListResponse_CursorPagination) GetCursorPrev() string {
+	if x != nil {
+		return x.CursorPrev
+	}
+	return ""
+}
+
+func (x *BookListResponse_CursorPagination) GetHasPrevious() bool {
+	if x != nil {
+		return x.HasPrevious
+	}
+	return false
+}
+
+func (x *BookListResponse_CursorPagination) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *BookListResponse_CursorPagination) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
 var File_v1_book_proto protoreflect.FileDescriptor
 
 const file_v1_book_proto_rawDesc = "" +
 	"\n" +
-	"\rv1/book.proto\x12\x0fmathbdw.grpc.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xa0\x02\n" +
+	"\rv1/book.proto\x12\x0fmathbdw.grpc.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x80\x06\n" +
 	"\x04Book\x12*\n" +
 	"\x02id\x18\x01 \x01(\x03B\x1a\x92A\x172\x12Identificator BookJ\x011R\x02id\x121\n" +
 	"\x05Title\x18\x02 \x01(\tB\x1b\x92A\x182\n" +
 	"Book titleJ\n" +
 	"\"The Book\"R\x05Title\x12H\n" +
 	"\vDescription\x18\x03 \x01(\tB&\x92A#2\vDescriptionJ\x14\"The Book Adventure\"R\vDescription\x128\n" +
-	"\x04Year\x18\x04 \x01(\x05B$\x92A!2\x19Year the book was writtenJ\x042000R\x04Year\x125\n" +
-	"\x05Genre\x18\x05 \x01(\tB\x1f\x92A\x1c2\rGenre of bookJ\v\"Adventure\"R\x05Genre\"o\n" +
+	"\x04Year\x18\x04 \x01(\x05B$\x92A!2\x19Year the book was writtenJ\x042000R\x04Year\x12>\n" +
+	"\x05Genre\x18\x05 \x01(\tB(\x92A%2\x16Name of the book genreJ\v\"Adventure\"R\x05Genre\x12q\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB6\x92A32\x19Time the book was createdJ\x16\"2025-09-01T10:00:00Z\"R\tcreatedAt\x12Z\n" +
+	"\aauthors\x18\a \x03(\v2\x17.mathbdw.grpc.v1.AuthorB'\x92A$2\"Authors of the book in their orderR\aauthors\x12B\n" +
+	"\bgenre_id\x18\b \x01(\x03B'\x92A$2\x1fIdentificator of the book genreJ\x011R\agenreId\x12O\n" +
+	"\x04isbn\x18\t \x01(\tB;\x92A82%ISBN-13 of the book, empty if unknownJ\x0f\"9780306406157\"R\x04isbn\x12q\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03BW\x92AT2OVersion of the book, increases on every write. Sent as ETag by the REST gatewayJ\x011R\aversion\"\xb7\x02\n" +
+	"\x05Genre\x12+\n" +
+	"\x02id\x18\x01 \x01(\x03B\x1b\x92A\x182\x13Identificator GenreJ\x011R\x02id\x120\n" +
+	"\x04name\x18\x02 \x01(\tB\x1c\x92A\x192\n" +
+	"Genre nameJ\v\"Adventure\"R\x04name\x12[\n" +
+	"\tparent_id\x18\x03 \x01(\x03B>\x92A;26Identificator of the parent genre, 0 - top level genreJ\x010R\bparentId\x12r\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB7\x92A42\x1aTime the genre was createdJ\x16\"2025-09-01T10:00:00Z\"R\tcreatedAt\"\xe0\x01\n" +
+	"\x06Author\x12,\n" +
+	"\x02id\x18\x01 \x01(\x03B\x1c\x92A\x192\x14Identificator AuthorJ\x011R\x02id\x123\n" +
+	"\x04name\x18\x02 \x01(\tB\x1f\x92A\x1c2\vAuthor nameJ\r\"Jules Verne\"R\x04name\x12s\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB8\x92A52\x1bTime the author was createdJ\x16\"2025-09-01T10:00:00Z\"R\tcreatedAt\"\x89\x02\n" +
 	"\x0eBookGetRequest\x12]\n" +
 	"\abook_id\x18\x01 \x03(\x03BD\x92A-2$Slice identificators. Unique params.J\x05[1,2]\xfaB\x11\x92\x01\x0e\b\x01\x10\n" +
-	"\x18\x01\"\x04\"\x02(\x01(\x00R\x06bookId\"\x94\x02\n" +
+	"\x18\x01\"\x04\"\x02(\x01(\x00R\x06bookId\x12\x97\x01\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampBf\x92Ac2IBooks in the state they had at the moment, the current state when not setJ\x16\"2025-10-01T00:00:00Z\"R\x04asOf\"\xa5\x02\n" +
+	"\x11BookChangeRequest\x12]\n" +
+	"\abook_id\x18\x01 \x03(\x03BD\x92A-2$Slice identificators. Unique params.J\x05[1,2]\xfaB\x11\x92\x01\x0e\b\x01\x10\n" +
+	"\x18\x01\"\x04\"\x02(\x01(\x00R\x06bookId\x12\xb0\x01\n" +
+	"\x10expected_version\x18\x02 \x01(\x03B\x84\x01\x92Az2uThe book is changed only at this version, 0 - any version. Needs one book_id. The If-Match header of the REST gatewayJ\x011\xfaB\x04\"\x02(\x00R\x0fexpectedVersion\"\xd0\x06\n" +
 	"\x0eBookAddRequest\x12;\n" +
 	"\x05title\x18\x01 \x01(\tB%\x92A\x182\x0eTitle the bookJ\x06\"Book\"\xfaB\ar\x05\x10\x02\x18\x80\x01R\x05title\x12Q\n" +
 	"\vdescription\x18\x02 \x01(\tB/\x92A%2\x14Description the bookJ\r\"Description\"\xfaB\x04r\x02\x10\x02R\vdescription\x123\n" +
-	"\x04year\x18\x03 \x01(\x05B\x1f\x92A\x152\rYear the bookJ\x042000\xfaB\x04\x1a\x02(\x01R\x04year\x12=\n" +
-	"\x05genre\x18\x04 \x01(\tB'\x92A\x1d2\x0eGenre the bookJ\v\"Adventure\"\xfaB\x04r\x02\x10\x02R\x05genre\"\xb1\x03\n" +
+	"\x04year\x18\x03 \x01(\x05B\x1f\x92A\x152\rYear the bookJ\x042000\xfaB\x04\x1a\x02(\x01R\x04year\x12\x83\x01\n" +
+	"\x05genre\x18\x04 \x01(\tBm\x92A^2ODeprecated, use genre_id. Name of the book genre, used when genre_id is not setJ\v\"Adventure\"\xfaB\ar\x05\x10\x02\xd0\x01\x01\x18\x01R\x05genre\x12b\n" +
+	"\n" +
+	"author_ids\x18\x05 \x03(\x03BC\x92A02&IDs of the book authors in their orderJ\x06[1, 2]\xfaB\r\x92\x01\n" +
+	"\x10\x14\x18\x01\"\x04\"\x02(\x01R\tauthorIds\x12v\n" +
+	"\bgenre_id\x18\x06 \x01(\x03B[\x92AQ2LIdentificator of the book genre, required unless the deprecated genre is setJ\x011\xfaB\x04\"\x02(\x00R\agenreId\x12\x89\x01\n" +
+	"\x04isbn\x18\a \x01(\tBu\x92AV2?ISBN-10 or ISBN-13 of the book, ISBN-10 is converted to ISBN-13J\x13\"978-0-306-40615-7\"\xfaB\x19r\x172\x12^[0-9Xx -]{10,17}$\xd0\x01\x01R\x04isbn\x12\x8a\x01\n" +
+	"\x05force\x18\b \x01(\bBt\x92Aq2hAdd the book even if it looks like a duplicate of a stored one. A book with the same ISBN is never addedJ\x05falseR\x05force\"\x96\x01\n" +
+	"\x0eBookDuplicates\x12\x83\x01\n" +
+	"\abook_id\x18\x01 \x03(\x03Bj\x92Ag2]IDs of the stored books with a similar title, the same year and genre, the most similar firstJ\x06[1, 2]R\x06bookId\"y\n" +
+	"\x14BookGetByISBNRequest\x12a\n" +
+	"\x04isbn\x18\x01 \x01(\tBM\x92A12\x1eISBN-10 or ISBN-13 of the bookJ\x0f\"9780306406157\"\xfaB\x16r\x142\x12^[0-9Xx -]{10,17}$R\x04isbn\"\xb7\x02\n" +
+	"\x13BookBatchAddRequest\x12\x80\x01\n" +
+	"\x05books\x18\x01 \x03(\v2\x1f.mathbdw.grpc.v1.BookAddRequestBI\x92A422Books to create, each item is validated separately\xfaB\x0f\x92\x01\f\b\x01\x10\xe8\a\"\x05\x8a\x01\x02\b\x01R\x05books\x12\x9c\x01\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x1a.mathbdw.grpc.v1.BatchModeBl\x92Aa2_All-or-nothing rejects the whole batch on any invalid item, best-effort creates the valid items\xfaB\x05\x82\x01\x02\x10\x01R\x04mode\"\x90\x02\n" +
+	"\x12BookBatchAddResult\x12A\n" +
+	"\x05index\x18\x01 \x01(\x05B+\x92A(2#Position of the item in the requestJ\x010R\x05index\x12a\n" +
+	"\x04book\x18\x02 \x01(\v2\x15.mathbdw.grpc.v1.BookB6\x92A321Created book, empty when the item was not createdR\x04book\x12T\n" +
+	"\x05error\x18\x03 \x01(\tB>\x92A;29Validation error of the item or reason it was not createdR\x05error\"U\n" +
+	"\x14BookBatchAddResponse\x12=\n" +
+	"\aresults\x18\x01 \x03(\v2#.mathbdw.grpc.v1.BookBatchAddResultR\aresults\"\xb5\t\n" +
+	"\x11BookUpdateRequest\x125\n" +
+	"\x02id\x18\x01 \x01(\x03B%\x92A\x1b2\x16Identificator the bookJ\x011\xfaB\x04\"\x02(\x01R\x02id\x12>\n" +
+	"\x05title\x18\x02 \x01(\tB(\x92A\x182\x0eTitle the bookJ\x06\"Book\"\xfaB\n" +
+	"r\b\x10\x02\x18\x80\x01\xd0\x01\x01R\x05title\x12T\n" +
+	"\vdescription\x18\x03 \x01(\tB2\x92A%2\x14Description the bookJ\r\"Description\"\xfaB\ar\x05\x10\x02\xd0\x01\x01R\vdescription\x125\n" +
+	"\x04year\x18\x04 \x01(\x05B!\x92A\x152\rYear the bookJ\x042000\xfaB\x06\x1a\x04(\x01@\x01R\x04year\x12\xb2\x01\n" +
+	"\x05genre\x18\x05 \x01(\tB\x9b\x01\x92A\x8b\x012|Deprecated, use genre_id. Name of the book genre, used when genre_id is not set, the update_mask path genre selects genre_idJ\v\"Adventure\"\xfaB\ar\x05\x10\x02\xd0\x01\x01\x18\x01R\x05genre\x12\xdd\x01\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskB\x9f\x01\x92A\x9b\x012\x89\x01Fields to update: title, description, year, genre_id, author_ids, isbn. All fields are updated if empty, author_ids and isbn only if sentJ\r\"description\"R\n" +
+	"updateMask\x12\x94\x01\n" +
+	"\n" +
+	"author_ids\x18\a \x03(\x03Bu\x92Ab2XIDs of the book authors in their order, an empty list in update_mask removes all authorsJ\x06[1, 2]\xfaB\r\x92\x01\n" +
+	"\x10\x14\x18\x01\"\x04\"\x02(\x01R\tauthorIds\x12I\n" +
+	"\bgenre_id\x18\b \x01(\x03B.\x92A$2\x1fIdentificator of the book genreJ\x011\xfaB\x04\"\x02(\x00R\agenreId\x12\x85\x01\n" +
+	"\x04isbn\x18\t \x01(\tBq\x92AR2?ISBN-10 or ISBN-13 of the book, ISBN-10 is converted to ISBN-13J\x0f\"9780306406157\"\xfaB\x19r\x172\x12^[0-9Xx -]{10,17}$\xd0\x01\x01R\x04isbn\x12\x9c\x01\n" +
+	"\x10expected_version\x18\n" +
+	" \x01(\x03Bq\x92Ag2bThe book is updated only at this version, 0 - any version. The If-Match header of the REST gatewayJ\x011\xfaB\x04\"\x02(\x00R\x0fexpectedVersion\"\x88\x0e\n" +
 	"\x0fBookListRequest\x12y\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v21.mathbdw.grpc.v1.BookListRequest.CursorPaginationB&\x92A\x1b2\x19map params for pagination\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
-	"pagination\x1a\xa2\x02\n" +
-	"\x10CursorPagination\x126\n" +
-	"\x06cursor\x18\x01 \x01(\tB\x1e\x92A\x1b2\rString cursorJ\n" +
-	"\"qwefszvs\"R\x06cursor\x12A\n" +
+	"pagination\x12S\n" +
+	"\tauthor_id\x18\x02 \x01(\x03B6\x92A,2'Only books of the author, 0 - all booksJ\x011\xfaB\x04\"\x02(\x00R\bauthorId\x12e\n" +
+	"\bgenre_id\x18\x03 \x01(\x03BJ\x92A@2;Only books of the genre and its child genres, 0 - all booksJ\x011\xfaB\x04\"\x02(\x00R\agenreId\x12m\n" +
+	"\tyear_from\x18\x04 \x01(\x05BP\x92AF2>Only books published in this year or later, 0 - no lower boundJ\x041900\xfaB\x04\x1a\x02(\x00R\byearFrom\x12k\n" +
+	"\ayear_to\x18\x05 \x01(\x05BR\x92AH2@Only books published in this year or earlier, 0 - no upper boundJ\x042000\xfaB\x04\x1a\x02(\x00R\x06yearTo\x12s\n" +
+	"\ftitle_prefix\x18\x06 \x01(\tBP\x92AE2<Only books whose title starts with the prefix, ignoring caseJ\x05\"war\"\xfaB\x05r\x03\x18\x80\x01R\vtitlePrefix\x12X\n" +
+	"\x0finclude_removed\x18\a \x01(\bB/\x92A,2#Admin flag, also list removed booksJ\x05falseR\x0eincludeRemoved\x12\xb3\x01\n" +
+	"\x05as_of\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x81\x01\x92A~2dBooks in the state they had at the moment, the current state when not set. The cursor is bound to itJ\x16\"2025-10-01T00:00:00Z\"R\x04asOf\x1a\xa6\x01\n" +
+	"\x04Sort\x12\\\n" +
+	"\x05field\x18\x01 \x01(\tBF\x92A\x172\rSorting fieldJ\x06\"year\"\xfaB)r'R\x02idR\x05titleR\x04yearR\bgenre_idR\n" +
+	"created_atR\x05field\x12@\n" +
+	"\x05order\x18\x02 \x01(\tB*\x92A\x172\rSorting orderJ\x06\"desc\"\xfaB\rr\vR\x03ascR\x04descR\x05order\x1a\xb3\x05\n" +
+	"\x10CursorPagination\x12\xad\x01\n" +
+	"\x06cursor\x18\x01 \x01(\tB\x94\x01\x92A\x90\x012[Cursor of the page from the previous response, valid only with the same sorting and filtersJ1\"eyJ2IjoyLCJzIjpbImlkIl0sImsiOlsiMyJdLCJpZCI6M30\"R\x06cursor\x12A\n" +
 	"\tpage_size\x18\x02 \x01(\x04B$\x92A\x162\x11Size rows on pageJ\x012\xfaB\b2\x060\x020\n" +
-	"02R\bpageSize\x12I\n" +
-	"\asort_by\x18\x03 \x01(\tB0\x92A\x172\rSorting fieldJ\x06\"year\"\xfaB\x13r\x11R\x02idR\x05titleR\x04yearR\x06sortBy\x12H\n" +
+	"02R\bpageSize\x12_\n" +
+	"\asort_by\x18\x03 \x01(\tBF\x92A\x172\rSorting fieldJ\x06\"year\"\xfaB)r'R\x02idR\x05titleR\x04yearR\bgenre_idR\n" +
+	"created_atR\x06sortBy\x12H\n" +
 	"\n" +
-	"sort_order\x18\x04 \x01(\tB)\x92A\x162\rSorting orderJ\x05\"asc\"\xfaB\rr\vR\x03ascR\x04descR\tsortOrder\"L\n" +
+	"sort_order\x18\x04 \x01(\tB)\x92A\x162\rSorting orderJ\x05\"asc\"\xfaB\rr\vR\x03ascR\x04descR\tsortOrder\x12i\n" +
+	"\x10with_total_count\x18\x05 \x01(\bB?\x92A<24Also return the number of books matching the filtersJ\x04trueR\x0ewithTotalCount\x12\x95\x01\n" +
+	"\athen_by\x18\x06 \x03(\v2%.mathbdw.grpc.v1.BookListRequest.SortBU\x92AJ2HNext sorting fields for equal values of sort_by, e.g. genre_id then year\xfaB\x05\x92\x01\x02\x10\x02R\x06thenBy\"\xb5\x02\n" +
+	"\x11BookSearchRequest\x12\x7f\n" +
+	"\x05query\x18\x01 \x01(\tBi\x92A\\2ISearch query over title, description and genre, supports quotes, OR and -J\x0f\"sea adventure\"\xfaB\ar\x05\x10\x02\x18\x80\x02R\x05query\x12[\n" +
+	"\x06cursor\x18\x02 \x01(\tBC\x92A@22Cursor of the next page from the previous responseJ\n" +
+	"\"qwefszvs\"R\x06cursor\x12B\n" +
+	"\tpage_size\x18\x03 \x01(\x04B%\x92A\x172\x11Size rows on pageJ\x0210\xfaB\b2\x060\x020\n" +
+	"02R\bpageSize\"Q\n" +
+	"\x10AuthorAddRequest\x12=\n" +
+	"\x04name\x18\x01 \x01(\tB)\x92A\x1c2\vAuthor nameJ\r\"Jules Verne\"\xfaB\ar\x05\x10\x02\x18\xff\x01R\x04name\"X\n" +
+	"\x10AuthorGetRequest\x12D\n" +
+	"\tauthor_id\x18\x01 \x03(\x03B'\x92A\x122\vIDs authorsJ\x03[1]\xfaB\x0f\x92\x01\f\b\x01\x10\n" +
+	"\x18\x01\"\x04\"\x02(\x01R\bauthorId\"\x8d\x01\n" +
+	"\x13AuthorUpdateRequest\x127\n" +
+	"\x02id\x18\x01 \x01(\x03B'\x92A\x1d2\x18Identificator the authorJ\x011\xfaB\x04\"\x02(\x01R\x02id\x12=\n" +
+	"\x04name\x18\x02 \x01(\tB)\x92A\x1c2\vAuthor nameJ\r\"Jules Verne\"\xfaB\ar\x05\x10\x02\x18\xff\x01R\x04name\"\xb6\x01\n" +
+	"\x11AuthorListRequest\x12B\n" +
+	"\tpage_size\x18\x01 \x01(\x04B%\x92A\x172\x11Size rows on pageJ\x0210\xfaB\b2\x060\x020\n" +
+	"02R\bpageSize\x12]\n" +
+	"\bafter_id\x18\x02 \x01(\x03BB\x92A823Last author ID of the previous page, 0 - first pageJ\x010\xfaB\x04\"\x02(\x00R\aafterId\"X\n" +
+	"\x0fAuthorsResponse\x12E\n" +
+	"\aauthors\x18\x01 \x03(\v2\x17.mathbdw.grpc.v1.AuthorB\x12\x92A\x0f2\rArray authorsR\aauthors\"\xa2\x01\n" +
+	"\x12AuthorListResponse\x121\n" +
+	"\aauthors\x18\x01 \x03(\v2\x17.mathbdw.grpc.v1.AuthorR\aauthors\x12Y\n" +
+	"\rnext_after_id\x18\x02 \x01(\x03B5\x92A22,after_id of the next page, 0 - no more pagesJ\x0210R\vnextAfterId\"\xc6\x01\n" +
+	"\x0fGenreAddRequest\x12O\n" +
+	"\x04name\x18\x01 \x01(\tB;\x92A/2 Genre name, unique ignoring caseJ\v\"Adventure\"\xfaB\x06r\x04\x10\x02\x18\x7fR\x04name\x12b\n" +
+	"\tparent_id\x18\x02 \x01(\x03BE\x92A;26Identificator of the parent genre, 0 - top level genreJ\x010\xfaB\x04\"\x02(\x00R\bparentId\"T\n" +
+	"\x0fGenreGetRequest\x12A\n" +
+	"\bgenre_id\x18\x01 \x03(\x03B&\x92A\x112\n" +
+	"IDs genresJ\x03[1]\xfaB\x0f\x92\x01\f\b\x01\x10\n" +
+	"\x18\x01\"\x04\"\x02(\x01R\agenreId\"\x81\x02\n" +
+	"\x12GenreUpdateRequest\x126\n" +
+	"\x02id\x18\x01 \x01(\x03B&\x92A\x1c2\x17Identificator the genreJ\x011\xfaB\x04\"\x02(\x01R\x02id\x12O\n" +
+	"\x04name\x18\x02 \x01(\tB;\x92A/2 Genre name, unique ignoring caseJ\v\"Adventure\"\xfaB\x06r\x04\x10\x02\x18\x7fR\x04name\x12b\n" +
+	"\tparent_id\x18\x03 \x01(\x03BE\x92A;26Identificator of the parent genre, 0 - top level genreJ\x010\xfaB\x04\"\x02(\x00R\bparentId\"S\n" +
+	"\x0eGenresResponse\x12A\n" +
+	"\x06genres\x18\x01 \x03(\v2\x16.mathbdw.grpc.v1.GenreB\x11\x92A\x0e2\fArray genresR\x06genres\"L\n" +
 	"\rBooksResponse\x12;\n" +
-	"\x04book\x18\x01 \x03(\v2\x15.mathbdw.grpc.v1.BookB\x10\x92A\r2\vArray booksR\x04book\"\xe9\x01\n" +
+	"\x04book\x18\x01 \x03(\v2\x15.mathbdw.grpc.v1.BookB\x10\x92A\r2\vArray booksR\x04book\"\xcb\x04\n" +
 	"\x10BookListResponse\x12R\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v22.mathbdw.grpc.v1.BookListResponse.CursorPaginationR\n" +
 	"pagination\x12+\n" +
-	"\x05books\x18\x02 \x03(\v2\x15.mathbdw.grpc.v1.BookR\x05books\x1aT\n" +
+	"\x05books\x18\x02 \x03(\v2\x15.mathbdw.grpc.v1.BookR\x05books\x1a\xb5\x03\n" +
 	"\x10CursorPagination\x12@\n" +
 	"\n" +
 	"cursorNext\x18\x01 \x01(\tB \x92A\x162\rSorting orderJ\x05\"asc\"\xfaB\x04r\x02\x10\x01R\n" +
-	"cursorNext2\xe8\x05\n" +
+	"cursorNext\x12Y\n" +
+	"\n" +
+	"cursorPrev\x18\x02 \x01(\tB9\x92A624Cursor of the previous page, empty on the first pageR\n" +
+	"cursorPrev\x12F\n" +
+	"\vhasPrevious\x18\x03 \x01(\bB$\x92A!2\x1fThere is a page before this oneR\vhasPrevious\x12=\n" +
+	"\ahasNext\x18\x04 \x01(\bB#\x92A 2\x1eThere is a page after this oneR\ahasNext\x12n\n" +
+	"\n" +
+	"totalCount\x18\x05 \x01(\x03BI\x92AF2@Number of books matching the filters, only with with_total_countJ\x0242H\x00R\n" +
+	"totalCount\x88\x01\x01B\r\n" +
+	"\v_totalCount\"\xf1\x01\n" +
+	"\x10BookSearchResult\x12)\n" +
+	"\x04book\x18\x01 \x01(\v2\x15.mathbdw.grpc.v1.BookR\x04book\x12@\n" +
+	"\x04rank\x18\x02 \x01(\x02B,\x92A)2\"Relevance of the book to the queryJ\x030.1R\x04rank\x12p\n" +
+	"\asnippet\x18\x03 \x01(\tBV\x92AS2:Fragments of title and description with matches in <b></b>J\x15\"The <b>Sea</b> Wolf\"R\asnippet\"\xa8\x01\n" +
+	"\x12BookSearchResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.mathbdw.grpc.v1.BookSearchResultR\aresults\x12U\n" +
+	"\vcursor_next\x18\x02 \x01(\tB4\x92A12/Cursor of the next page, empty on the last pageR\n" +
+	"cursorNext\"\xf7\x01\n" +
+	"\x10BookMergeRequest\x12\x86\x01\n" +
+	"\n" +
+	"source_ids\x18\x01 \x03(\x03Bg\x92AP2GIdentificators of the duplicates merged into the target. Unique params.J\x05[2,3]\xfaB\x11\x92\x01\x0e\b\x01\x10\n" +
+	"\x18\x01\"\x04\"\x02(\x01(\x00R\tsourceIds\x12Z\n" +
+	"\ttarget_id\x18\x02 \x01(\x03B=\x92A32.Identificator of the book kept after the mergeJ\x011\xfaB\x04\"\x02(\x01R\btargetId\"W\n" +
+	"\x12BookHistoryRequest\x12A\n" +
+	"\abook_id\x18\x01 \x01(\x03B(\x92A\x1e2\x19Identificator of the bookJ\x011\xfaB\x04\"\x02(\x01R\x06bookId\"\xa7\x03\n" +
+	"\vBookVersion\x12R\n" +
+	"\x04book\x18\x01 \x01(\v2\x15.mathbdw.grpc.v1.BookB'\x92A$2\"State of the book after the changeR\x04book\x12G\n" +
+	"\aremoved\x18\x02 \x01(\bB-\x92A*2(The book is soft-deleted in this versionR\aremoved\x12b\n" +
+	"\x06change\x18\x03 \x01(\x0e2\x1f.mathbdw.grpc.v1.BookChangeTypeB)\x92A&2$The change that produced the versionR\x06change\x12C\n" +
+	"\x05actor\x18\x04 \x01(\tB-\x92A*2(Caller of the change, empty when unknownR\x05actor\x12R\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x17\x92A\x142\x12Time of the changeR\tchangedAt\"~\n" +
+	"\x13BookHistoryResponse\x12g\n" +
+	"\bversions\x18\x01 \x03(\v2\x1c.mathbdw.grpc.v1.BookVersionB-\x92A*2(Versions of the book from the oldest oneR\bversions*F\n" +
+	"\tBatchMode\x12\x1d\n" +
+	"\x19BATCH_MODE_ALL_OR_NOTHING\x10\x00\x12\x1a\n" +
+	"\x16BATCH_MODE_BEST_EFFORT\x10\x01*\xe5\x01\n" +
+	"\x0eBookChangeType\x12 \n" +
+	"\x1cBOOK_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18BOOK_CHANGE_TYPE_CREATED\x10\x01\x12\x1c\n" +
+	"\x18BOOK_CHANGE_TYPE_UPDATED\x10\x02\x12\x1c\n" +
+	"\x18BOOK_CHANGE_TYPE_DELETED\x10\x03\x12\x1d\n" +
+	"\x19BOOK_CHANGE_TYPE_RESTORED\x10\x04\x12\x1b\n" +
+	"\x17BOOK_CHANGE_TYPE_MERGED\x10\x05\x12\x1b\n" +
+	"\x17BOOK_CHANGE_TYPE_PURGED\x10\x062\xe0\x17\n" +
 	"\vBookService\x12\x89\x02\n" +
 	"\bGetByIDs\x12\x1f.mathbdw.grpc.v1.BookGetRequest\x1a\x1e.mathbdw.grpc.v1.BooksResponse\"\xbb\x01\x92A\xa6\x01\n" +
 	"\x05books\x12\x10Get books by IDs\x1a\x8a\x01Get books by their IDs\n" +
 	"\n" +
 	"### Custom Headers:\n" +
 	"- **X-Request-ID**: Unique request identifier\n" +
-	"- **X-Upload-Token**: Upload authorization token\x82\xd3\xe4\x93\x02\v\x12\t/v1/books\x12\x92\x01\n" +
-	"\x03Add\x12\x1f.mathbdw.grpc.v1.BookAddRequest\x1a\x16.google.protobuf.Empty\"R\x92A;\n" +
-	"\x05books\x12\x11Create a new book\x1a\x1fCreate a new book in the system\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/books\x12\xab\x01\n" +
+	"- **X-Upload-Token**: Upload authorization token\x82\xd3\xe4\x93\x02\v\x12\t/v1/books\x12\xac\x01\n" +
+	"\tGetByISBN\x12%.mathbdw.grpc.v1.BookGetByISBNRequest\x1a\x15.mathbdw.grpc.v1.Book\"a\x92AA\n" +
+	"\x05books\x12\x10Get book by ISBN\x1a&Get the book by its ISBN-10 or ISBN-13\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/books/isbn/{isbn}\x12\x9e\x03\n" +
+	"\x03Add\x12\x1f.mathbdw.grpc.v1.BookAddRequest\x1a\x15.mathbdw.grpc.v1.Book\"\xde\x02\x92A\xc6\x02\n" +
+	"\x05books\x12\x11Create a new book\x1a\xa9\x02Create a new book in the system. A book with a similar title, the same year and genre is rejected with AlreadyExists and the BookDuplicates details unless force is set\n" +
+	"\n" +
+	"### Custom Headers:\n" +
+	"- **Idempotency-Key**: the retried request with the same key and body gets the book created by the first one\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/books\x12\xda\x01\n" +
+	"\bBatchAdd\x12$.mathbdw.grpc.v1.BookBatchAddRequest\x1a%.mathbdw.grpc.v1.BookBatchAddResponse\"\x80\x01\x92Ac\n" +
+	"\x05books\x12\x15Create books in batch\x1aCCreates books in a single transaction, reports result for each item\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/books/batch\x12\xa1\x02\n" +
+	"\x06Update\x12\".mathbdw.grpc.v1.BookUpdateRequest\x1a\x15.mathbdw.grpc.v1.Book\"\xdb\x01\x92A\xa9\x01\n" +
+	"\x05books\x12\rUpdate a book\x1a\x90\x01Updates the book by ID and returns its new state\n" +
+	"\n" +
+	"### Custom Headers:\n" +
+	"- **If-Match**: ETag of the book, the book is updated only at this version\x82\xd3\xe4\x93\x02(:\x01*Z\x13:\x01*2\x0e/v1/books/{id}\x1a\x0e/v1/books/{id}\x12\xab\x01\n" +
 	"\x04List\x12 .mathbdw.grpc.v1.BookListRequest\x1a!.mathbdw.grpc.v1.BookListResponse\"^\x92AF\n" +
-	"\x05books\x12\x1bList of books on pagination\x1a Returns a list of books by pages\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/book-list\x12\x89\x01\n" +
-	"\x06Delete\x12\x1f.mathbdw.grpc.v1.BookGetRequest\x1a\x16.google.protobuf.Empty\"F\x92A2\n" +
-	"\x05books\x12\x13Delete books by IDs\x1a\x14Deletes books by IDs\x82\xd3\xe4\x93\x02\v*\t/v1/booksB\x91\x02\x92A\xee\x01\x12\x85\x01\n" +
+	"\x05books\x12\x1bList of books on pagination\x1a Returns a list of books by pages\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/book-list\x12\xe7\x01\n" +
+	"\x06Search\x12\".mathbdw.grpc.v1.BookSearchRequest\x1a#.mathbdw.grpc.v1.BookSearchResponse\"\x93\x01\x92Ax\n" +
+	"\x05books\x12\x19Full-text search of books\x1aTReturns books matching the query, the most relevant first, with highlighted snippets\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/books/search\x12\xc5\x02\n" +
+	"\x06Delete\x12\".mathbdw.grpc.v1.BookChangeRequest\x1a\x16.google.protobuf.Empty\"\xfe\x01\x92A\xe9\x01\n" +
+	"\x05books\x12\x13Delete books by IDs\x1a\xca\x01Deletes books by IDs\n" +
+	"\n" +
+	"### Custom Headers:\n" +
+	"- **If-Match**: ETag of the book, the book is deleted only at this version\n" +
+	"- **Idempotency-Key**: the retried request with the same key and body is not repeated\x82\xd3\xe4\x93\x02\v*\t/v1/books\x12\x8b\x02\n" +
+	"\aRestore\x12\".mathbdw.grpc.v1.BookChangeRequest\x1a\x16.google.protobuf.Empty\"\xc3\x01\x92A\xa3\x01\n" +
+	"\x05books\x12\x14Restore books by IDs\x1a\x83\x01Restores soft-deleted books by IDs\n" +
+	"\n" +
+	"### Custom Headers:\n" +
+	"- **If-Match**: ETag of the book, the book is restored only at this version\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/books/restore\x12\x9f\x02\n" +
+	"\x05Merge\x12!.mathbdw.grpc.v1.BookMergeRequest\x1a\x15.mathbdw.grpc.v1.Book\"\xdb\x01\x92A\xb1\x01\n" +
+	"\x05books\x12\x15Merge duplicate books\x1a\x90\x01Deletes the source books and fills the empty fields of the target from them. The IDs of the sources return the target until a source is restored\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/books/{target_id}/merge\x12\xe3\x02\n" +
+	"\n" +
+	"GetHistory\x12#.mathbdw.grpc.v1.BookHistoryRequest\x1a$.mathbdw.grpc.v1.BookHistoryResponse\"\x89\x02\x92A\xe2\x01\n" +
+	"\x05books\x12\x11History of a book\x1a\xc5\x01Returns every version of the book with the time and the caller of the change, the purged books keep their history.\n" +
+	"\n" +
+	"The writes take the caller from the **X-Caller-Id** header (x-caller-id metadata)\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/books/{book_id}/history2\xe2\x06\n" +
+	"\rAuthorService\x12\x9c\x01\n" +
+	"\bGetByIDs\x12!.mathbdw.grpc.v1.AuthorGetRequest\x1a .mathbdw.grpc.v1.AuthorsResponse\"K\x92A5\n" +
+	"\aauthors\x12\x12Get authors by IDs\x1a\x16Returns authors by IDs\x82\xd3\xe4\x93\x02\r\x12\v/v1/authors\x12\x9d\x01\n" +
+	"\x03Add\x12!.mathbdw.grpc.v1.AuthorAddRequest\x1a\x17.mathbdw.grpc.v1.Author\"Z\x92AA\n" +
+	"\aauthors\x12\x13Create a new author\x1a!Create a new author in the system\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/authors\x12\x95\x01\n" +
+	"\x06Update\x12$.mathbdw.grpc.v1.AuthorUpdateRequest\x1a\x17.mathbdw.grpc.v1.Author\"L\x92A.\n" +
+	"\aauthors\x12\x10Update an author\x1a\x11Renames an author\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/authors/{id}\x12\xb7\x01\n" +
+	"\x04List\x12\".mathbdw.grpc.v1.AuthorListRequest\x1a#.mathbdw.grpc.v1.AuthorListResponse\"f\x92AL\n" +
+	"\aauthors\x12\x1dList of authors on pagination\x1a\"Returns a list of authors by pages\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/author-list\x12\xbf\x01\n" +
+	"\x06Delete\x12!.mathbdw.grpc.v1.AuthorGetRequest\x1a\x16.google.protobuf.Empty\"z\x92Ad\n" +
+	"\aauthors\x12\x15Delete authors by IDs\x1aBDeletes authors by IDs, authors linked to books can not be deleted\x82\xd3\xe4\x93\x02\r*\v/v1/authors2\x8c\a\n" +
+	"\fGenreService\x12\x96\x01\n" +
+	"\bGetByIDs\x12 .mathbdw.grpc.v1.GenreGetRequest\x1a\x1f.mathbdw.grpc.v1.GenresResponse\"G\x92A2\n" +
+	"\x06genres\x12\x11Get genres by IDs\x1a\x15Returns genres by IDs\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/genres\x12\xaf\x01\n" +
+	"\x03Add\x12 .mathbdw.grpc.v1.GenreAddRequest\x1a\x16.mathbdw.grpc.v1.Genre\"n\x92AV\n" +
+	"\x06genres\x12\x12Create a new genre\x1a8Creates a top level genre or a child of the parent genre\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/genres\x12\xb1\x01\n" +
+	"\x06Update\x12#.mathbdw.grpc.v1.GenreUpdateRequest\x1a\x16.mathbdw.grpc.v1.Genre\"j\x92AM\n" +
+	"\x06genres\x12\x0eUpdate a genre\x1a3Renames a genre or moves it to another parent genre\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/v1/genres/{id}\x12\xb4\x01\n" +
+	"\x04List\x12\x16.google.protobuf.Empty\x1a\x1f.mathbdw.grpc.v1.GenresResponse\"s\x92AZ\n" +
+	"\x06genres\x12\x0eList of genres\x1a@Returns all genres ordered by ID, the tree is built by parent_id\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/genre-list\x12\xc5\x01\n" +
+	"\x06Delete\x12 .mathbdw.grpc.v1.GenreGetRequest\x1a\x16.google.protobuf.Empty\"\x80\x01\x92Ak\n" +
+	"\x06genres\x12\x14Delete genres by IDs\x1aKDeletes genres by IDs, genres with books or child genres can not be deleted\x82\xd3\xe4\x93\x02\f*\n" +
+	"/v1/genresB\x91\x02\x92A\xee\x01\x12\x85\x01\n" +
 	"\x10Book Service API\x12'API for book management with OpenAPI v3\"C\n" +
 	"\vAPI Support\x12\x1fhttps://github.com/mathbdw/book\x1a\x13support@example.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ<\n" +
 	":\n" +
@@ -539,36 +2512,123 @@ func file_v1_book_proto_rawDescGZIP() []byte {
 	return file_v1_book_proto_rawDescData
 }
 
-var file_v1_book_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_v1_book_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_book_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_v1_book_proto_goTypes = []any{
-	(*Book)(nil),                              // 0: mathbdw.grpc.v1.Book
-	(*BookGetRequest)(nil),                    // 1: mathbdw.grpc.v1.BookGetRequest
-	(*BookAddRequest)(nil),                    // 2: mathbdw.grpc.v1.BookAddRequest
-	(*BookListRequest)(nil),                   // 3: mathbdw.grpc.v1.BookListRequest
-	(*BooksResponse)(nil),                     // 4: mathbdw.grpc.v1.BooksResponse
-	(*BookListResponse)(nil),                  // 5: mathbdw.grpc.v1.BookListResponse
-	(*BookListRequest_CursorPagination)(nil),  // 6: mathbdw.grpc.v1.BookListRequest.CursorPagination
-	(*BookListResponse_CursorPagination)(nil), // 7: mathbdw.grpc.v1.BookListResponse.CursorPagination
-	(*empty.Empty)(nil),                       // 8: google.protobuf.Empty
+	(BatchMode)(0),                            // 0: mathbdw.grpc.v1.BatchMode
+	(BookChangeType)(0),                       // 1: mathbdw.grpc.v1.BookChangeType
+	(*Book)(nil),                              // 2: mathbdw.grpc.v1.Book
+	(*Genre)(nil),                             // 3: mathbdw.grpc.v1.Genre
+	(*Author)(nil),                            // 4: mathbdw.grpc.v1.Author
+	(*BookGetRequest)(nil),                    // 5: mathbdw.grpc.v1.BookGetRequest
+	(*BookChangeRequest)(nil),                 // 6: mathbdw.grpc.v1.BookChangeRequest
+	(*BookAddRequest)(nil),                    // 7: mathbdw.grpc.v1.BookAddRequest
+	(*BookDuplicates)(nil),                    // 8: mathbdw.grpc.v1.BookDuplicates
+	(*BookGetByISBNRequest)(nil),              // 9: mathbdw.grpc.v1.BookGetByISBNRequest
+	(*BookBatchAddRequest)(nil),               // 10: mathbdw.grpc.v1.BookBatchAddRequest
+	(*BookBatchAddResult)(nil),                // 11: mathbdw.grpc.v1.BookBatchAddResult
+	(*BookBatchAddResponse)(nil),              // 12: mathbdw.grpc.v1.BookBatchAddResponse
+	(*BookUpdateRequest)(nil),                 // 13: mathbdw.grpc.v1.BookUpdateRequest
+	(*BookListRequest)(nil),                   // 14: mathbdw.grpc.v1.BookListRequest
+	(*BookSearchRequest)(nil),                 // 15: mathbdw.grpc.v1.BookSearchRequest
+	(*AuthorAddRequest)(nil),                  // 16: mathbdw.grpc.v1.AuthorAddRequest
+	(*AuthorGetRequest)(nil),                  // 17: mathbdw.grpc.v1.AuthorGetRequest
+	(*AuthorUpdateRequest)(nil),               // 18: mathbdw.grpc.v1.AuthorUpdateRequest
+	(*AuthorListRequest)(nil),                 // 19: mathbdw.grpc.v1.AuthorListRequest
+	(*AuthorsResponse)(nil),                   // 20: mathbdw.grpc.v1.AuthorsResponse
+	(*AuthorListResponse)(nil),                // 21: mathbdw.grpc.v1.AuthorListResponse
+	(*GenreAddRequest)(nil),                   // 22: mathbdw.grpc.v1.GenreAddRequest
+	(*GenreGetRequest)(nil),                   // 23: mathbdw.grpc.v1.GenreGetRequest
+	(*GenreUpdateRequest)(nil),                // 24: mathbdw.grpc.v1.GenreUpdateRequest
+	(*GenresResponse)(nil),                    // 25: mathbdw.grpc.v1.GenresResponse
+	(*BooksResponse)(nil),                     // 26: mathbdw.grpc.v1.BooksResponse
+	(*BookListResponse)(nil),                  // 27: mathbdw.grpc.v1.BookListResponse
+	(*BookSearchResult)(nil),                  // 28: mathbdw.grpc.v1.BookSearchResult
+	(*BookSearchResponse)(nil),                // 29: mathbdw.grpc.v1.BookSearchResponse
+	(*BookMergeRequest)(nil),                  // 30: mathbdw.grpc.v1.BookMergeRequest
+	(*BookHistoryRequest)(nil),                // 31: mathbdw.grpc.v1.BookHistoryRequest
+	(*BookVersion)(nil),                       // 32: mathbdw.grpc.v1.BookVersion
+	(*BookHistoryResponse)(nil),               // 33: mathbdw.grpc.v1.BookHistoryResponse
+	(*BookListRequest_Sort)(nil),              // 34: mathbdw.grpc.v1.BookListRequest.Sort
+	(*BookListRequest_CursorPagination)(nil),  // 35: mathbdw.grpc.v1.BookListRequest.CursorPagination
+	(*BookListResponse_CursorPagination)(nil), // 36: mathbdw.grpc.v1.BookListResponse.CursorPagination
+	(*timestamppb.Timestamp)(nil),             // 37: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),             // 38: google.protobuf.FieldMask
+	(*empty.Empty)(nil),                       // 39: google.protobuf.Empty
 }
 var file_v1_book_proto_depIdxs = []int32{
-	6, // 0: mathbdw.grpc.v1.BookListRequest.pagination:type_name -> mathbdw.grpc.v1.BookListRequest.CursorPagination
-	0, // 1: mathbdw.grpc.v1.BooksResponse.book:type_name -> mathbdw.grpc.v1.Book
-	7, // 2: mathbdw.grpc.v1.BookListResponse.pagination:type_name -> mathbdw.grpc.v1.BookListResponse.CursorPagination
-	0, // 3: mathbdw.grpc.v1.BookListResponse.books:type_name -> mathbdw.grpc.v1.Book
-	1, // 4: mathbdw.grpc.v1.BookService.GetByIDs:input_type -> mathbdw.grpc.v1.BookGetRequest
-	2, // 5: mathbdw.grpc.v1.BookService.Add:input_type -> mathbdw.grpc.v1.BookAddRequest
-	3, // 6: mathbdw.grpc.v1.BookService.List:input_type -> mathbdw.grpc.v1.BookListRequest
-	1, // 7: mathbdw.grpc.v1.BookService.Delete:input_type -> mathbdw.grpc.v1.BookGetRequest
-	4, // 8: mathbdw.grpc.v1.BookService.GetByIDs:output_type -> mathbdw.grpc.v1.BooksResponse
-	8, // 9: mathbdw.grpc.v1.BookService.Add:output_type -> google.protobuf.Empty
-	5, // 10: mathbdw.grpc.v1.BookService.List:output_type -> mathbdw.grpc.v1.BookListResponse
-	8, // 11: mathbdw.grpc.v1.BookService.Delete:output_type -> google.protobuf.Empty
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	37, // 0: mathbdw.grpc.v1.Book.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 1: mathbdw.grpc.v1.Book.authors:type_name -> mathbdw.grpc.v1.Author
+	37, // 2: mathbdw.grpc.v1.Genre.created_at:type_name -> google.protobuf.Timestamp
+	37, // 3: mathbdw.grpc.v1.Author.created_at:type_name -> google.protobuf.Timestamp
+	37, // 4: mathbdw.grpc.v1.BookGetRequest.as_of:type_name -> google.protobuf.Timestamp
+	7,  // 5: mathbdw.grpc.v1.BookBatchAddRequest.books:type_name -> mathbdw.grpc.v1.BookAddRequest
+	0,  // 6: mathbdw.grpc.v1.BookBatchAddRequest.mode:type_name -> mathbdw.grpc.v1.BatchMode
+	2,  // 7: mathbdw.grpc.v1.BookBatchAddResult.book:type_name -> mathbdw.grpc.v1.Book
+	11, // 8: mathbdw.grpc.v1.BookBatchAddResponse.results:type_name -> mathbdw.grpc.v1.BookBatchAddResult
+	38, // 9: mathbdw.grpc.v1.BookUpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	35, // 10: mathbdw.grpc.v1.BookListRequest.pagination:type_name -> mathbdw.grpc.v1.BookListRequest.CursorPagination
+	37, // 11: mathbdw.grpc.v1.BookListRequest.as_of:type_name -> google.protobuf.Timestamp
+	4,  // 12: mathbdw.grpc.v1.AuthorsResponse.authors:type_name -> mathbdw.grpc.v1.Author
+	4,  // 13: mathbdw.grpc.v1.AuthorListResponse.authors:type_name -> mathbdw.grpc.v1.Author
+	3,  // 14: mathbdw.grpc.v1.GenresResponse.genres:type_name -> mathbdw.grpc.v1.Genre
+	2,  // 15: mathbdw.grpc.v1.BooksResponse.book:type_name -> mathbdw.grpc.v1.Book
+	36, // 16: mathbdw.grpc.v1.BookListResponse.pagination:type_name -> mathbdw.grpc.v1.BookListResponse.CursorPagination
+	2,  // 17: mathbdw.grpc.v1.BookListResponse.books:type_name -> mathbdw.grpc.v1.Book
+	2,  // 18: mathbdw.grpc.v1.BookSearchResult.book:type_name -> mathbdw.grpc.v1.Book
+	28, // 19: mathbdw.grpc.v1.BookSearchResponse.results:type_name -> mathbdw.grpc.v1.BookSearchResult
+	2,  // 20: mathbdw.grpc.v1.BookVersion.book:type_name -> mathbdw.grpc.v1.Book
+	1,  // 21: mathbdw.grpc.v1.BookVersion.change:type_name -> mathbdw.grpc.v1.BookChangeType
+	37, // 22: mathbdw.grpc.v1.BookVersion.changed_at:type_name -> google.protobuf.Timestamp
+	32, // 23: mathbdw.grpc.v1.BookHistoryResponse.versions:type_name -> mathbdw.grpc.v1.BookVersion
+	34, // 24: mathbdw.grpc.v1.BookListRequest.CursorPagination.then_by:type_name -> mathbdw.grpc.v1.BookListRequest.Sort
+	5,  // 25: mathbdw.grpc.v1.BookService.GetByIDs:input_type -> mathbdw.grpc.v1.BookGetRequest
+	9,  // 26: mathbdw.grpc.v1.BookService.GetByISBN:input_type -> mathbdw.grpc.v1.BookGetByISBNRequest
+	7,  // 27: mathbdw.grpc.v1.BookService.Add:input_type -> mathbdw.grpc.v1.BookAddRequest
+	10, // 28: mathbdw.grpc.v1.BookService.BatchAdd:input_type -> mathbdw.grpc.v1.BookBatchAddRequest
+	13, // 29: mathbdw.grpc.v1.BookService.Update:input_type -> mathbdw.grpc.v1.BookUpdateRequest
+	14, // 30: mathbdw.grpc.v1.BookService.List:input_type -> mathbdw.grpc.v1.BookListRequest
+	15, // 31: mathbdw.grpc.v1.BookService.Search:input_type -> mathbdw.grpc.v1.BookSearchRequest
+	6,  // 32: mathbdw.grpc.v1.BookService.Delete:input_type -> mathbdw.grpc.v1.BookChangeRequest
+	6,  // 33: mathbdw.grpc.v1.BookService.Restore:input_type -> mathbdw.grpc.v1.BookChangeRequest
+	30, // 34: mathbdw.grpc.v1.BookService.Merge:input_type -> mathbdw.grpc.v1.BookMergeRequest
+	31, // 35: mathbdw.grpc.v1.BookService.GetHistory:input_type -> mathbdw.grpc.v1.BookHistoryRequest
+	17, // 36: mathbdw.grpc.v1.AuthorService.GetByIDs:input_type -> mathbdw.grpc.v1.AuthorGetRequest
+	16, // 37: mathbdw.grpc.v1.AuthorService.Add:input_type -> mathbdw.grpc.v1.AuthorAddRequest
+	18, // 38: mathbdw.grpc.v1.AuthorService.Update:input_type -> mathbdw.grpc.v1.AuthorUpdateRequest
+	19, // 39: mathbdw.grpc.v1.AuthorService.List:input_type -> mathbdw.grpc.v1.AuthorListRequest
+	17, // 40: mathbdw.grpc.v1.AuthorService.Delete:input_type -> mathbdw.grpc.v1.AuthorGetRequest
+	23, // 41: mathbdw.grpc.v1.GenreService.GetByIDs:input_type -> mathbdw.grpc.v1.GenreGetRequest
+	22, // 42: mathbdw.grpc.v1.GenreService.Add:input_type -> mathbdw.grpc.v1.GenreAddRequest
+	24, // 43: mathbdw.grpc.v1.GenreService.Update:input_type -> mathbdw.grpc.v1.GenreUpdateRequest
+	39, // 44: mathbdw.grpc.v1.GenreService.List:input_type -> google.protobuf.Empty
+	23, // 45: mathbdw.grpc.v1.GenreService.Delete:input_type -> mathbdw.grpc.v1.GenreGetRequest
+	26, // 46: mathbdw.grpc.v1.BookService.GetByIDs:output_type -> mathbdw.grpc.v1.BooksResponse
+	2,  // 47: mathbdw.grpc.v1.BookService.GetByISBN:output_type -> mathbdw.grpc.v1.Book
+	2,  // 48: mathbdw.grpc.v1.BookService.Add:output_type -> mathbdw.grpc.v1.Book
+	12, // 49: mathbdw.grpc.v1.BookService.BatchAdd:output_type -> mathbdw.grpc.v1.BookBatchAddResponse
+	2,  // 50: mathbdw.grpc.v1.BookService.Update:output_type -> mathbdw.grpc.v1.Book
+	27, // 51: mathbdw.grpc.v1.BookService.List:output_type -> mathbdw.grpc.v1.BookListResponse
+	29, // 52: mathbdw.grpc.v1.BookService.Search:output_type -> mathbdw.grpc.v1.BookSearchResponse
+	39, // 53: mathbdw.grpc.v1.BookService.Delete:output_type -> google.protobuf.Empty
+	39, // 54: mathbdw.grpc.v1.BookService.Restore:output_type -> google.protobuf.Empty
+	2,  // 55: mathbdw.grpc.v1.BookService.Merge:output_type -> mathbdw.grpc.v1.Book
+	33, // 56: mathbdw.grpc.v1.BookService.GetHistory:output_type -> mathbdw.grpc.v1.BookHistoryResponse
+	20, // 57: mathbdw.grpc.v1.AuthorService.GetByIDs:output_type -> mathbdw.grpc.v1.AuthorsResponse
+	4,  // 58: mathbdw.grpc.v1.AuthorService.Add:output_type -> mathbdw.grpc.v1.Author
+	4,  // 59: mathbdw.grpc.v1.AuthorService.Update:output_type -> mathbdw.grpc.v1.Author
+	21, // 60: mathbdw.grpc.v1.AuthorService.List:output_type -> mathbdw.grpc.v1.AuthorListResponse
+	39, // 61: mathbdw.grpc.v1.AuthorService.Delete:output_type -> google.protobuf.Empty
+	25, // 62: mathbdw.grpc.v1.GenreService.GetByIDs:output_type -> mathbdw.grpc.v1.GenresResponse
+	3,  // 63: mathbdw.grpc.v1.GenreService.Add:output_type -> mathbdw.grpc.v1.Genre
+	3,  // 64: mathbdw.grpc.v1.GenreService.Update:output_type -> mathbdw.grpc.v1.Genre
+	25, // 65: mathbdw.grpc.v1.GenreService.List:output_type -> mathbdw.grpc.v1.GenresResponse
+	39, // 66: mathbdw.grpc.v1.GenreService.Delete:output_type -> google.protobuf.Empty
+	46, // [46:67] is the sub-list for method output_type
+	25, // [25:46] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_v1_book_proto_init() }
@@ -576,18 +2636,20 @@ func file_v1_book_proto_init() {
 	if File_v1_book_proto != nil {
 		return
 	}
+	file_v1_book_proto_msgTypes[34].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_book_proto_rawDesc), len(file_v1_book_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   35,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_v1_book_proto_goTypes,
 		DependencyIndexes: file_v1_book_proto_depIdxs,
+		EnumInfos:         file_v1_book_proto_enumTypes,
 		MessageInfos:      file_v1_book_proto_msgTypes,
 	}.Build()
 	File_v1_book_proto = out.File
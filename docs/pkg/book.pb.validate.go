@@ -66,19 +66,3898 @@ func (m *Book) validate(all bool) error {
 
 	// no validation rules for Genre
 
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAuthors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookValidationError{
+					field:  fmt.Sprintf("Authors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for GenreId
+
+	// no validation rules for Isbn
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return BookMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookMultiError is an error wrapping multiple validation errors returned by
+// Book.ValidateAll() if the designated constraints aren't met.
+type BookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookMultiError) AllErrors() []error { return m }
+
+// BookValidationError is the validation error returned by Book.Validate if the
+// designated constraints aren't met.
+type BookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookValidationError) ErrorName() string { return "BookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookValidationError{}
+
+// Validate checks the field values on Genre with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Genre) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Genre with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GenreMultiError, or nil if none found.
+func (m *Genre) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Genre) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for ParentId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenreValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenreValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenreValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenreMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenreMultiError is an error wrapping multiple validation errors returned by
+// Genre.ValidateAll() if the designated constraints aren't met.
+type GenreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenreMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenreMultiError) AllErrors() []error { return m }
+
+// GenreValidationError is the validation error returned by Genre.Validate if
+// the designated constraints aren't met.
+type GenreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenreValidationError) ErrorName() string { return "GenreValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenre.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenreValidationError{}
+
+// Validate checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Author) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuthorMultiError, or nil if none found.
+func (m *Author) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Author) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorMultiError is an error wrapping multiple validation errors returned by
+// Author.ValidateAll() if the designated constraints aren't met.
+type AuthorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorMultiError) AllErrors() []error { return m }
+
+// AuthorValidationError is the validation error returned by Author.Validate if
+// the designated constraints aren't met.
+type AuthorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorValidationError) ErrorName() string { return "AuthorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorValidationError{}
+
+// Validate checks the field values on BookGetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookGetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookGetRequestMultiError,
+// or nil if none found.
+func (m *BookGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetBookId()); l < 1 || l > 10 {
+		err := BookGetRequestValidationError{
+			field:  "BookId",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BookGetRequest_BookId_Unique := make(map[int64]struct{}, len(m.GetBookId()))
+
+	for idx, item := range m.GetBookId() {
+		_, _ = idx, item
+
+		if _, exists := _BookGetRequest_BookId_Unique[item]; exists {
+			err := BookGetRequestValidationError{
+				field:  fmt.Sprintf("BookId[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BookGetRequest_BookId_Unique[item] = struct{}{}
+		}
+
+		if item < 1 {
+			err := BookGetRequestValidationError{
+				field:  fmt.Sprintf("BookId[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAsOf()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookGetRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookGetRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookGetRequestValidationError{
+				field:  "AsOf",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BookGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookGetRequestMultiError is an error wrapping multiple validation errors
+// returned by BookGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BookGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookGetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookGetRequestMultiError) AllErrors() []error { return m }
+
+// BookGetRequestValidationError is the validation error returned by
+// BookGetRequest.Validate if the designated constraints aren't met.
+type BookGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookGetRequestValidationError) ErrorName() string { return "BookGetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookGetRequestValidationError{}
+
+// Validate checks the field values on BookChangeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BookChangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookChangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookChangeRequestMultiError, or nil if none found.
+func (m *BookChangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookChangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetBookId()); l < 1 || l > 10 {
+		err := BookChangeRequestValidationError{
+			field:  "BookId",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BookChangeRequest_BookId_Unique := make(map[int64]struct{}, len(m.GetBookId()))
+
+	for idx, item := range m.GetBookId() {
+		_, _ = idx, item
+
+		if _, exists := _BookChangeRequest_BookId_Unique[item]; exists {
+			err := BookChangeRequestValidationError{
+				field:  fmt.Sprintf("BookId[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BookChangeRequest_BookId_Unique[item] = struct{}{}
+		}
+
+		if item < 1 {
+			err := BookChangeRequestValidationError{
+				field:  fmt.Sprintf("BookId[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := BookChangeRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BookChangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookChangeRequestMultiError is an error wrapping multiple validation errors
+// returned by BookChangeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BookChangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookChangeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookChangeRequestMultiError) AllErrors() []error { return m }
+
+// BookChangeRequestValidationError is the validation error returned by
+// BookChangeRequest.Validate if the designated constraints aren't met.
+type BookChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookChangeRequestValidationError) ErrorName() string {
+	return "BookChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BookChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookChangeRequestValidationError{}
+
+// Validate checks the field values on BookAddRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookAddRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookAddRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookAddRequestMultiError,
+// or nil if none found.
+func (m *BookAddRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookAddRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 2 || l > 128 {
+		err := BookAddRequestValidationError{
+			field:  "Title",
+			reason: "value length must be between 2 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) < 2 {
+		err := BookAddRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetYear() < 1 {
+		err := BookAddRequestValidationError{
+			field:  "Year",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGenre() != "" {
+
+		if utf8.RuneCountInString(m.GetGenre()) < 2 {
+			err := BookAddRequestValidationError{
+				field:  "Genre",
+				reason: "value length must be at least 2 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetAuthorIds()) > 20 {
+		err := BookAddRequestValidationError{
+			field:  "AuthorIds",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BookAddRequest_AuthorIds_Unique := make(map[int64]struct{}, len(m.GetAuthorIds()))
+
+	for idx, item := range m.GetAuthorIds() {
+		_, _ = idx, item
+
+		if _, exists := _BookAddRequest_AuthorIds_Unique[item]; exists {
+			err := BookAddRequestValidationError{
+				field:  fmt.Sprintf("AuthorIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BookAddRequest_AuthorIds_Unique[item] = struct{}{}
+		}
+
+		if item < 1 {
+			err := BookAddRequestValidationError{
+				field:  fmt.Sprintf("AuthorIds[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetGenreId() < 0 {
+		err := BookAddRequestValidationError{
+			field:  "GenreId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetIsbn() != "" {
+
+		if !_BookAddRequest_Isbn_Pattern.MatchString(m.GetIsbn()) {
+			err := BookAddRequestValidationError{
+				field:  "Isbn",
+				reason: "value does not match regex pattern \"^[0-9Xx -]{10,17}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Force
+
+	if len(errors) > 0 {
+		return BookAddRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookAddRequestMultiError is an error wrapping multiple validation errors
+// returned by BookAddRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BookAddRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookAddRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookAddRequestMultiError) AllErrors() []error { return m }
+
+// BookAddRequestValidationError is the validation error returned by
+// BookAddRequest.Validate if the designated constraints aren't met.
+type BookAddRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookAddRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookAddRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookAddRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookAddRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookAddRequestValidationError) ErrorName() string { return "BookAddRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookAddRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookAddRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookAddRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookAddRequestValidationError{}
+
+var _BookAddRequest_Isbn_Pattern = regexp.MustCompile("^[0-9Xx -]{10,17}$")
+
+// Validate checks the field values on BookDuplicates with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookDuplicates) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookDuplicates with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookDuplicatesMultiError,
+// or nil if none found.
+func (m *BookDuplicates) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookDuplicates) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BookDuplicatesMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookDuplicatesMultiError is an error wrapping multiple validation errors
+// returned by BookDuplicates.ValidateAll() if the designated constraints
+// aren't met.
+type BookDuplicatesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookDuplicatesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookDuplicatesMultiError) AllErrors() []error { return m }
+
+// BookDuplicatesValidationError is the validation error returned by
+// BookDuplicates.Validate if the designated constraints aren't met.
+type BookDuplicatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookDuplicatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookDuplicatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookDuplicatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookDuplicatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookDuplicatesValidationError) ErrorName() string { return "BookDuplicatesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookDuplicatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookDuplicates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookDuplicatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookDuplicatesValidationError{}
+
+// Validate checks the field values on BookGetByISBNRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BookGetByISBNRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookGetByISBNRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookGetByISBNRequestMultiError, or nil if none found.
+func (m *BookGetByISBNRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookGetByISBNRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_BookGetByISBNRequest_Isbn_Pattern.MatchString(m.GetIsbn()) {
+		err := BookGetByISBNRequestValidationError{
+			field:  "Isbn",
+			reason: "value does not match regex pattern \"^[0-9Xx -]{10,17}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BookGetByISBNRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookGetByISBNRequestMultiError is an error wrapping multiple validation
+// errors returned by BookGetByISBNRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BookGetByISBNRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookGetByISBNRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookGetByISBNRequestMultiError) AllErrors() []error { return m }
+
+// BookGetByISBNRequestValidationError is the validation error returned by
+// BookGetByISBNRequest.Validate if the designated constraints aren't met.
+type BookGetByISBNRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookGetByISBNRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookGetByISBNRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookGetByISBNRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookGetByISBNRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookGetByISBNRequestValidationError) ErrorName() string {
+	return "BookGetByISBNRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BookGetByISBNRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookGetByISBNRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookGetByISBNRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookGetByISBNRequestValidationError{}
+
+var _BookGetByISBNRequest_Isbn_Pattern = regexp.MustCompile("^[0-9Xx -]{10,17}$")
+
+// Validate checks the field values on BookBatchAddRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BookBatchAddRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookBatchAddRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookBatchAddRequestMultiError, or nil if none found.
+func (m *BookBatchAddRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookBatchAddRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetBooks()); l < 1 || l > 1000 {
+		err := BookBatchAddRequestValidationError{
+			field:  "Books",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBooks() {
+		_, _ = idx, item
+
+		// skipping validation for books
+
+	}
+
+	if _, ok := BatchMode_name[int32(m.GetMode())]; !ok {
+		err := BookBatchAddRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BookBatchAddRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookBatchAddRequestMultiError is an error wrapping multiple validation
+// errors returned by BookBatchAddRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BookBatchAddRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookBatchAddRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookBatchAddRequestMultiError) AllErrors() []error { return m }
+
+// BookBatchAddRequestValidationError is the validation error returned by
+// BookBatchAddRequest.Validate if the designated constraints aren't met.
+type BookBatchAddRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookBatchAddRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookBatchAddRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookBatchAddRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookBatchAddRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookBatchAddRequestValidationError) ErrorName() string {
+	return "BookBatchAddRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BookBatchAddRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookBatchAddRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookBatchAddRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookBatchAddRequestValidationError{}
+
+// Validate checks the field values on BookBatchAddResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BookBatchAddResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookBatchAddResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookBatchAddResultMultiError, or nil if none found.
+func (m *BookBatchAddResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookBatchAddResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookBatchAddResultValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookBatchAddResultValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookBatchAddResultValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return BookBatchAddResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookBatchAddResultMultiError is an error wrapping multiple validation errors
+// returned by BookBatchAddResult.ValidateAll() if the designated constraints
+// aren't met.
+type BookBatchAddResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookBatchAddResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookBatchAddResultMultiError) AllErrors() []error { return m }
+
+// BookBatchAddResultValidationError is the validation error returned by
+// BookBatchAddResult.Validate if the designated constraints aren't met.
+type BookBatchAddResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookBatchAddResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookBatchAddResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookBatchAddResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookBatchAddResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookBatchAddResultValidationError) ErrorName() string {
+	return "BookBatchAddResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BookBatchAddResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookBatchAddResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookBatchAddResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookBatchAddResultValidationError{}
+
+// Validate checks the field values on BookBatchAddResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BookBatchAddResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookBatchAddResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookBatchAddResponseMultiError, or nil if none found.
+func (m *BookBatchAddResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookBatchAddResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookBatchAddResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookBatchAddResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookBatchAddResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BookBatchAddResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookBatchAddResponseMultiError is an error wrapping multiple validation
+// errors returned by BookBatchAddResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BookBatchAddResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookBatchAddResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookBatchAddResponseMultiError) AllErrors() []error { return m }
+
+// BookBatchAddResponseValidationError is the validation error returned by
+// BookBatchAddResponse.Validate if the designated constraints aren't met.
+type BookBatchAddResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookBatchAddResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookBatchAddResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookBatchAddResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookBatchAddResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookBatchAddResponseValidationError) ErrorName() string {
+	return "BookBatchAddResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BookBatchAddResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookBatchAddResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookBatchAddResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookBatchAddResponseValidationError{}
+
+// Validate checks the field values on BookUpdateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BookUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookUpdateRequestMultiError, or nil if none found.
+func (m *BookUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := BookUpdateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTitle() != "" {
+
+		if l := utf8.RuneCountInString(m.GetTitle()); l < 2 || l > 128 {
+			err := BookUpdateRequestValidationError{
+				field:  "Title",
+				reason: "value length must be between 2 and 128 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetDescription() != "" {
+
+		if utf8.RuneCountInString(m.GetDescription()) < 2 {
+			err := BookUpdateRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at least 2 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetYear() != 0 {
+
+		if m.GetYear() < 1 {
+			err := BookUpdateRequestValidationError{
+				field:  "Year",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetGenre() != "" {
+
+		if utf8.RuneCountInString(m.GetGenre()) < 2 {
+			err := BookUpdateRequestValidationError{
+				field:  "Genre",
+				reason: "value length must be at least 2 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookUpdateRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookUpdateRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookUpdateRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetAuthorIds()) > 20 {
+		err := BookUpdateRequestValidationError{
+			field:  "AuthorIds",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BookUpdateRequest_AuthorIds_Unique := make(map[int64]struct{}, len(m.GetAuthorIds()))
+
+	for idx, item := range m.GetAuthorIds() {
+		_, _ = idx, item
+
+		if _, exists := _BookUpdateRequest_AuthorIds_Unique[item]; exists {
+			err := BookUpdateRequestValidationError{
+				field:  fmt.Sprintf("AuthorIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BookUpdateRequest_AuthorIds_Unique[item] = struct{}{}
+		}
+
+		if item < 1 {
+			err := BookUpdateRequestValidationError{
+				field:  fmt.Sprintf("AuthorIds[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetGenreId() < 0 {
+		err := BookUpdateRequestValidationError{
+			field:  "GenreId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetIsbn() != "" {
+
+		if !_BookUpdateRequest_Isbn_Pattern.MatchString(m.GetIsbn()) {
+			err := BookUpdateRequestValidationError{
+				field:  "Isbn",
+				reason: "value does not match regex pattern \"^[0-9Xx -]{10,17}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetExpectedVersion() < 0 {
+		err := BookUpdateRequestValidationError{
+			field:  "ExpectedVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BookUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookUpdateRequestMultiError is an error wrapping multiple validation errors
+// returned by BookUpdateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BookUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookUpdateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookUpdateRequestMultiError) AllErrors() []error { return m }
+
+// BookUpdateRequestValidationError is the validation error returned by
+// BookUpdateRequest.Validate if the designated constraints aren't met.
+type BookUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookUpdateRequestValidationError) ErrorName() string {
+	return "BookUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BookUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookUpdateRequestValidationError{}
+
+var _BookUpdateRequest_Isbn_Pattern = regexp.MustCompile("^[0-9Xx -]{10,17}$")
+
+// Validate checks the field values on BookListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BookListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookListRequestMultiError, or nil if none found.
+func (m *BookListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPagination() == nil {
+		err := BookListRequestValidationError{
+			field:  "Pagination",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookListRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookListRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookListRequestValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAuthorId() < 0 {
+		err := BookListRequestValidationError{
+			field:  "AuthorId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGenreId() < 0 {
+		err := BookListRequestValidationError{
+			field:  "GenreId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetYearFrom() < 0 {
+		err := BookListRequestValidationError{
+			field:  "YearFrom",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetYearTo() < 0 {
+		err := BookListRequestValidationError{
+			field:  "YearTo",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitlePrefix()) > 128 {
+		err := BookListRequestValidationError{
+			field:  "TitlePrefix",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeRemoved
+
+	if all {
+		switch v := interface{}(m.GetAsOf()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookListRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookListRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookListRequestValidationError{
+				field:  "AsOf",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BookListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookListRequestMultiError is an error wrapping multiple validation errors
+// returned by BookListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BookListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookListRequestMultiError) AllErrors() []error { return m }
+
+// BookListRequestValidationError is the validation error returned by
+// BookListRequest.Validate if the designated constraints aren't met.
+type BookListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookListRequestValidationError) ErrorName() string { return "BookListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookListRequestValidationError{}
+
+// Validate checks the field values on BookSearchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BookSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookSearchRequestMultiError, or nil if none found.
+func (m *BookSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 2 || l > 256 {
+		err := BookSearchRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 2 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cursor
+
+	if _, ok := _BookSearchRequest_PageSize_InLookup[m.GetPageSize()]; !ok {
+		err := BookSearchRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be in list [2 10 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BookSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookSearchRequestMultiError is an error wrapping multiple validation errors
+// returned by BookSearchRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BookSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookSearchRequestMultiError) AllErrors() []error { return m }
+
+// BookSearchRequestValidationError is the validation error returned by
+// BookSearchRequest.Validate if the designated constraints aren't met.
+type BookSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookSearchRequestValidationError) ErrorName() string {
+	return "BookSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BookSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookSearchRequestValidationError{}
+
+var _BookSearchRequest_PageSize_InLookup = map[uint64]struct{}{
+	2:  {},
+	10: {},
+	50: {},
+}
+
+// Validate checks the field values on AuthorAddRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorAddRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorAddRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorAddRequestMultiError, or nil if none found.
+func (m *AuthorAddRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorAddRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 2 || l > 255 {
+		err := AuthorAddRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 2 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AuthorAddRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorAddRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthorAddRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorAddRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorAddRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorAddRequestMultiError) AllErrors() []error { return m }
+
+// AuthorAddRequestValidationError is the validation error returned by
+// AuthorAddRequest.Validate if the designated constraints aren't met.
+type AuthorAddRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorAddRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorAddRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorAddRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorAddRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorAddRequestValidationError) ErrorName() string { return "AuthorAddRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorAddRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorAddRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorAddRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorAddRequestValidationError{}
+
+// Validate checks the field values on AuthorGetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorGetRequestMultiError, or nil if none found.
+func (m *AuthorGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetAuthorId()); l < 1 || l > 10 {
+		err := AuthorGetRequestValidationError{
+			field:  "AuthorId",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_AuthorGetRequest_AuthorId_Unique := make(map[int64]struct{}, len(m.GetAuthorId()))
+
+	for idx, item := range m.GetAuthorId() {
+		_, _ = idx, item
+
+		if _, exists := _AuthorGetRequest_AuthorId_Unique[item]; exists {
+			err := AuthorGetRequestValidationError{
+				field:  fmt.Sprintf("AuthorId[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_AuthorGetRequest_AuthorId_Unique[item] = struct{}{}
+		}
+
+		if item < 1 {
+			err := AuthorGetRequestValidationError{
+				field:  fmt.Sprintf("AuthorId[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuthorGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorGetRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthorGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorGetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorGetRequestMultiError) AllErrors() []error { return m }
+
+// AuthorGetRequestValidationError is the validation error returned by
+// AuthorGetRequest.Validate if the designated constraints aren't met.
+type AuthorGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorGetRequestValidationError) ErrorName() string { return "AuthorGetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorGetRequestValidationError{}
+
+// Validate checks the field values on AuthorUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorUpdateRequestMultiError, or nil if none found.
+func (m *AuthorUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := AuthorUpdateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 2 || l > 255 {
+		err := AuthorUpdateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 2 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AuthorUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AuthorUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuthorUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorUpdateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AuthorUpdateRequestValidationError is the validation error returned by
+// AuthorUpdateRequest.Validate if the designated constraints aren't met.
+type AuthorUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorUpdateRequestValidationError) ErrorName() string {
+	return "AuthorUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorUpdateRequestValidationError{}
+
+// Validate checks the field values on AuthorListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorListRequestMultiError, or nil if none found.
+func (m *AuthorListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _AuthorListRequest_PageSize_InLookup[m.GetPageSize()]; !ok {
+		err := AuthorListRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be in list [2 10 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAfterId() < 0 {
+		err := AuthorListRequestValidationError{
+			field:  "AfterId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AuthorListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorListRequestMultiError is an error wrapping multiple validation errors
+// returned by AuthorListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorListRequestMultiError) AllErrors() []error { return m }
+
+// AuthorListRequestValidationError is the validation error returned by
+// AuthorListRequest.Validate if the designated constraints aren't met.
+type AuthorListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorListRequestValidationError) ErrorName() string {
+	return "AuthorListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorListRequestValidationError{}
+
+var _AuthorListRequest_PageSize_InLookup = map[uint64]struct{}{
+	2:  {},
+	10: {},
+	50: {},
+}
+
+// Validate checks the field values on AuthorsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuthorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorsResponseMultiError, or nil if none found.
+func (m *AuthorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuthors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthorsResponseValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthorsResponseValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthorsResponseValidationError{
+					field:  fmt.Sprintf("Authors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuthorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorsResponseMultiError is an error wrapping multiple validation errors
+// returned by AuthorsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorsResponseMultiError) AllErrors() []error { return m }
+
+// AuthorsResponseValidationError is the validation error returned by
+// AuthorsResponse.Validate if the designated constraints aren't met.
+type AuthorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorsResponseValidationError) ErrorName() string { return "AuthorsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorsResponseValidationError{}
+
+// Validate checks the field values on AuthorListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthorListResponseMultiError, or nil if none found.
+func (m *AuthorListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuthors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthorListResponseValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthorListResponseValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthorListResponseValidationError{
+					field:  fmt.Sprintf("Authors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextAfterId
+
+	if len(errors) > 0 {
+		return AuthorListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorListResponseMultiError is an error wrapping multiple validation errors
+// returned by AuthorListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorListResponseMultiError) AllErrors() []error { return m }
+
+// AuthorListResponseValidationError is the validation error returned by
+// AuthorListResponse.Validate if the designated constraints aren't met.
+type AuthorListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorListResponseValidationError) ErrorName() string {
+	return "AuthorListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorListResponseValidationError{}
+
+// Validate checks the field values on GenreAddRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenreAddRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenreAddRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenreAddRequestMultiError, or nil if none found.
+func (m *GenreAddRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenreAddRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 2 || l > 127 {
+		err := GenreAddRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 2 and 127 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetParentId() < 0 {
+		err := GenreAddRequestValidationError{
+			field:  "ParentId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GenreAddRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenreAddRequestMultiError is an error wrapping multiple validation errors
+// returned by GenreAddRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GenreAddRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenreAddRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenreAddRequestMultiError) AllErrors() []error { return m }
+
+// GenreAddRequestValidationError is the validation error returned by
+// GenreAddRequest.Validate if the designated constraints aren't met.
+type GenreAddRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenreAddRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenreAddRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenreAddRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenreAddRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenreAddRequestValidationError) ErrorName() string { return "GenreAddRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenreAddRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenreAddRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenreAddRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenreAddRequestValidationError{}
+
+// Validate checks the field values on GenreGetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenreGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenreGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenreGetRequestMultiError, or nil if none found.
+func (m *GenreGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenreGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetGenreId()); l < 1 || l > 10 {
+		err := GenreGetRequestValidationError{
+			field:  "GenreId",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_GenreGetRequest_GenreId_Unique := make(map[int64]struct{}, len(m.GetGenreId()))
+
+	for idx, item := range m.GetGenreId() {
+		_, _ = idx, item
+
+		if _, exists := _GenreGetRequest_GenreId_Unique[item]; exists {
+			err := GenreGetRequestValidationError{
+				field:  fmt.Sprintf("GenreId[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_GenreGetRequest_GenreId_Unique[item] = struct{}{}
+		}
+
+		if item < 1 {
+			err := GenreGetRequestValidationError{
+				field:  fmt.Sprintf("GenreId[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GenreGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenreGetRequestMultiError is an error wrapping multiple validation errors
+// returned by GenreGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GenreGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenreGetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenreGetRequestMultiError) AllErrors() []error { return m }
+
+// GenreGetRequestValidationError is the validation error returned by
+// GenreGetRequest.Validate if the designated constraints aren't met.
+type GenreGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenreGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenreGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenreGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenreGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenreGetRequestValidationError) ErrorName() string { return "GenreGetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenreGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenreGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenreGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenreGetRequestValidationError{}
+
+// Validate checks the field values on GenreUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenreUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenreUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenreUpdateRequestMultiError, or nil if none found.
+func (m *GenreUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenreUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := GenreUpdateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 2 || l > 127 {
+		err := GenreUpdateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 2 and 127 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetParentId() < 0 {
+		err := GenreUpdateRequestValidationError{
+			field:  "ParentId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GenreUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenreUpdateRequestMultiError is an error wrapping multiple validation errors
+// returned by GenreUpdateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GenreUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenreUpdateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenreUpdateRequestMultiError) AllErrors() []error { return m }
+
+// GenreUpdateRequestValidationError is the validation error returned by
+// GenreUpdateRequest.Validate if the designated constraints aren't met.
+type GenreUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenreUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenreUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenreUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenreUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenreUpdateRequestValidationError) ErrorName() string {
+	return "GenreUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenreUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenreUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenreUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenreUpdateRequestValidationError{}
+
+// Validate checks the field values on GenresResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenresResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenresResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenresResponseMultiError,
+// or nil if none found.
+func (m *GenresResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenresResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGenres() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GenresResponseValidationError{
+						field:  fmt.Sprintf("Genres[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GenresResponseValidationError{
+						field:  fmt.Sprintf("Genres[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GenresResponseValidationError{
+					field:  fmt.Sprintf("Genres[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GenresResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenresResponseMultiError is an error wrapping multiple validation errors
+// returned by GenresResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GenresResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenresResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenresResponseMultiError) AllErrors() []error { return m }
+
+// GenresResponseValidationError is the validation error returned by
+// GenresResponse.Validate if the designated constraints aren't met.
+type GenresResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenresResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenresResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenresResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenresResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenresResponseValidationError) ErrorName() string { return "GenresResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenresResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenresResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenresResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenresResponseValidationError{}
+
+// Validate checks the field values on BooksResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BooksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BooksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BooksResponseMultiError, or
+// nil if none found.
+func (m *BooksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BooksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBook() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BooksResponseValidationError{
+						field:  fmt.Sprintf("Book[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BooksResponseValidationError{
+						field:  fmt.Sprintf("Book[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BooksResponseValidationError{
+					field:  fmt.Sprintf("Book[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BooksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BooksResponseMultiError is an error wrapping multiple validation errors
+// returned by BooksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BooksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BooksResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BooksResponseMultiError) AllErrors() []error { return m }
+
+// BooksResponseValidationError is the validation error returned by
+// BooksResponse.Validate if the designated constraints aren't met.
+type BooksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BooksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BooksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BooksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BooksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BooksResponseValidationError) ErrorName() string { return "BooksResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BooksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBooksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BooksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BooksResponseValidationError{}
+
+// Validate checks the field values on BookListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BookListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookListResponseMultiError, or nil if none found.
+func (m *BookListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookListResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookListResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookListResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookListResponseValidationError{
+						field:  fmt.Sprintf("Books[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookListResponseValidationError{
+						field:  fmt.Sprintf("Books[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookListResponseValidationError{
+					field:  fmt.Sprintf("Books[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BookListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookListResponseMultiError is an error wrapping multiple validation errors
+// returned by BookListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BookListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookListResponseMultiError) AllErrors() []error { return m }
+
+// BookListResponseValidationError is the validation error returned by
+// BookListResponse.Validate if the designated constraints aren't met.
+type BookListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookListResponseValidationError) ErrorName() string { return "BookListResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookListResponseValidationError{}
+
+// Validate checks the field values on BookSearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BookSearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookSearchResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookSearchResultMultiError, or nil if none found.
+func (m *BookSearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookSearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookSearchResultValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookSearchResultValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookSearchResultValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rank
+
+	// no validation rules for Snippet
+
 	if len(errors) > 0 {
-		return BookMultiError(errors)
+		return BookSearchResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// BookMultiError is an error wrapping multiple validation errors returned by
-// Book.ValidateAll() if the designated constraints aren't met.
-type BookMultiError []error
+// BookSearchResultMultiError is an error wrapping multiple validation errors
+// returned by BookSearchResult.ValidateAll() if the designated constraints
+// aren't met.
+type BookSearchResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BookMultiError) Error() string {
+func (m BookSearchResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -87,11 +3966,11 @@ func (m BookMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BookMultiError) AllErrors() []error { return m }
+func (m BookSearchResultMultiError) AllErrors() []error { return m }
 
-// BookValidationError is the validation error returned by Book.Validate if the
-// designated constraints aren't met.
-type BookValidationError struct {
+// BookSearchResultValidationError is the validation error returned by
+// BookSearchResult.Validate if the designated constraints aren't met.
+type BookSearchResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -99,22 +3978,22 @@ type BookValidationError struct {
 }
 
 // Field function returns field value.
-func (e BookValidationError) Field() string { return e.field }
+func (e BookSearchResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BookValidationError) Reason() string { return e.reason }
+func (e BookSearchResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BookValidationError) Cause() error { return e.cause }
+func (e BookSearchResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BookValidationError) Key() bool { return e.key }
+func (e BookSearchResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BookValidationError) ErrorName() string { return "BookValidationError" }
+func (e BookSearchResultValidationError) ErrorName() string { return "BookSearchResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BookValidationError) Error() string {
+func (e BookSearchResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -126,14 +4005,14 @@ func (e BookValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBook.%s: %s%s",
+		"invalid %sBookSearchResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BookValidationError{}
+var _ error = BookSearchResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -141,33 +4020,171 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BookValidationError{}
+} = BookSearchResultValidationError{}
 
-// Validate checks the field values on BookGetRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BookGetRequest) Validate() error {
+// Validate checks the field values on BookSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BookSearchResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BookGetRequest with the rules defined
+// ValidateAll checks the field values on BookSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookSearchResponseMultiError, or nil if none found.
+func (m *BookSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookSearchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookSearchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookSearchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CursorNext
+
+	if len(errors) > 0 {
+		return BookSearchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookSearchResponseMultiError is an error wrapping multiple validation errors
+// returned by BookSearchResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BookSearchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookSearchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookSearchResponseMultiError) AllErrors() []error { return m }
+
+// BookSearchResponseValidationError is the validation error returned by
+// BookSearchResponse.Validate if the designated constraints aren't met.
+type BookSearchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookSearchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookSearchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookSearchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookSearchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookSearchResponseValidationError) ErrorName() string {
+	return "BookSearchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BookSearchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookSearchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookSearchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookSearchResponseValidationError{}
+
+// Validate checks the field values on BookMergeRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BookGetRequestMultiError,
-// or nil if none found.
-func (m *BookGetRequest) ValidateAll() error {
+// first error encountered is returned, or nil if there are no violations.
+func (m *BookMergeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookMergeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookMergeRequestMultiError, or nil if none found.
+func (m *BookMergeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BookGetRequest) validate(all bool) error {
+func (m *BookMergeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := len(m.GetBookId()); l < 1 || l > 10 {
-		err := BookGetRequestValidationError{
-			field:  "BookId",
+	if l := len(m.GetSourceIds()); l < 1 || l > 10 {
+		err := BookMergeRequestValidationError{
+			field:  "SourceIds",
 			reason: "value must contain between 1 and 10 items, inclusive",
 		}
 		if !all {
@@ -176,14 +4193,14 @@ func (m *BookGetRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	_BookGetRequest_BookId_Unique := make(map[int64]struct{}, len(m.GetBookId()))
+	_BookMergeRequest_SourceIds_Unique := make(map[int64]struct{}, len(m.GetSourceIds()))
 
-	for idx, item := range m.GetBookId() {
+	for idx, item := range m.GetSourceIds() {
 		_, _ = idx, item
 
-		if _, exists := _BookGetRequest_BookId_Unique[item]; exists {
-			err := BookGetRequestValidationError{
-				field:  fmt.Sprintf("BookId[%v]", idx),
+		if _, exists := _BookMergeRequest_SourceIds_Unique[item]; exists {
+			err := BookMergeRequestValidationError{
+				field:  fmt.Sprintf("SourceIds[%v]", idx),
 				reason: "repeated value must contain unique items",
 			}
 			if !all {
@@ -191,12 +4208,12 @@ func (m *BookGetRequest) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		} else {
-			_BookGetRequest_BookId_Unique[item] = struct{}{}
+			_BookMergeRequest_SourceIds_Unique[item] = struct{}{}
 		}
 
 		if item < 1 {
-			err := BookGetRequestValidationError{
-				field:  fmt.Sprintf("BookId[%v]", idx),
+			err := BookMergeRequestValidationError{
+				field:  fmt.Sprintf("SourceIds[%v]", idx),
 				reason: "value must be greater than or equal to 1",
 			}
 			if !all {
@@ -207,20 +4224,31 @@ func (m *BookGetRequest) validate(all bool) error {
 
 	}
 
+	if m.GetTargetId() < 1 {
+		err := BookMergeRequestValidationError{
+			field:  "TargetId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return BookGetRequestMultiError(errors)
+		return BookMergeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BookGetRequestMultiError is an error wrapping multiple validation errors
-// returned by BookGetRequest.ValidateAll() if the designated constraints
+// BookMergeRequestMultiError is an error wrapping multiple validation errors
+// returned by BookMergeRequest.ValidateAll() if the designated constraints
 // aren't met.
-type BookGetRequestMultiError []error
+type BookMergeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BookGetRequestMultiError) Error() string {
+func (m BookMergeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -229,11 +4257,11 @@ func (m BookGetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BookGetRequestMultiError) AllErrors() []error { return m }
+func (m BookMergeRequestMultiError) AllErrors() []error { return m }
 
-// BookGetRequestValidationError is the validation error returned by
-// BookGetRequest.Validate if the designated constraints aren't met.
-type BookGetRequestValidationError struct {
+// BookMergeRequestValidationError is the validation error returned by
+// BookMergeRequest.Validate if the designated constraints aren't met.
+type BookMergeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -241,22 +4269,22 @@ type BookGetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BookGetRequestValidationError) Field() string { return e.field }
+func (e BookMergeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BookGetRequestValidationError) Reason() string { return e.reason }
+func (e BookMergeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BookGetRequestValidationError) Cause() error { return e.cause }
+func (e BookMergeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BookGetRequestValidationError) Key() bool { return e.key }
+func (e BookMergeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BookGetRequestValidationError) ErrorName() string { return "BookGetRequestValidationError" }
+func (e BookMergeRequestValidationError) ErrorName() string { return "BookMergeRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BookGetRequestValidationError) Error() string {
+func (e BookMergeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -268,14 +4296,14 @@ func (e BookGetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBookGetRequest.%s: %s%s",
+		"invalid %sBookMergeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BookGetRequestValidationError{}
+var _ error = BookMergeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -283,55 +4311,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BookGetRequestValidationError{}
+} = BookMergeRequestValidationError{}
 
-// Validate checks the field values on BookAddRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BookAddRequest) Validate() error {
+// Validate checks the field values on BookHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BookHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BookAddRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BookAddRequestMultiError,
-// or nil if none found.
-func (m *BookAddRequest) ValidateAll() error {
+// ValidateAll checks the field values on BookHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookHistoryRequestMultiError, or nil if none found.
+func (m *BookHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BookAddRequest) validate(all bool) error {
+func (m *BookHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetTitle()); l < 2 || l > 128 {
-		err := BookAddRequestValidationError{
-			field:  "Title",
-			reason: "value length must be between 2 and 128 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDescription()) < 2 {
-		err := BookAddRequestValidationError{
-			field:  "Description",
-			reason: "value length must be at least 2 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetYear() < 1 {
-		err := BookAddRequestValidationError{
-			field:  "Year",
+	if m.GetBookId() < 1 {
+		err := BookHistoryRequestValidationError{
+			field:  "BookId",
 			reason: "value must be greater than or equal to 1",
 		}
 		if !all {
@@ -340,31 +4346,20 @@ func (m *BookAddRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetGenre()) < 2 {
-		err := BookAddRequestValidationError{
-			field:  "Genre",
-			reason: "value length must be at least 2 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return BookAddRequestMultiError(errors)
+		return BookHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BookAddRequestMultiError is an error wrapping multiple validation errors
-// returned by BookAddRequest.ValidateAll() if the designated constraints
+// BookHistoryRequestMultiError is an error wrapping multiple validation errors
+// returned by BookHistoryRequest.ValidateAll() if the designated constraints
 // aren't met.
-type BookAddRequestMultiError []error
+type BookHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BookAddRequestMultiError) Error() string {
+func (m BookHistoryRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -373,11 +4368,11 @@ func (m BookAddRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BookAddRequestMultiError) AllErrors() []error { return m }
+func (m BookHistoryRequestMultiError) AllErrors() []error { return m }
 
-// BookAddRequestValidationError is the validation error returned by
-// BookAddRequest.Validate if the designated constraints aren't met.
-type BookAddRequestValidationError struct {
+// BookHistoryRequestValidationError is the validation error returned by
+// BookHistoryRequest.Validate if the designated constraints aren't met.
+type BookHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -385,22 +4380,24 @@ type BookAddRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BookAddRequestValidationError) Field() string { return e.field }
+func (e BookHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BookAddRequestValidationError) Reason() string { return e.reason }
+func (e BookHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BookAddRequestValidationError) Cause() error { return e.cause }
+func (e BookHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BookAddRequestValidationError) Key() bool { return e.key }
+func (e BookHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BookAddRequestValidationError) ErrorName() string { return "BookAddRequestValidationError" }
+func (e BookHistoryRequestValidationError) ErrorName() string {
+	return "BookHistoryRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BookAddRequestValidationError) Error() string {
+func (e BookHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -412,14 +4409,14 @@ func (e BookAddRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBookAddRequest.%s: %s%s",
+		"invalid %sBookHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BookAddRequestValidationError{}
+var _ error = BookHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -427,64 +4424,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BookAddRequestValidationError{}
+} = BookHistoryRequestValidationError{}
 
-// Validate checks the field values on BookListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *BookListRequest) Validate() error {
+// Validate checks the field values on BookVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookVersion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BookListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// BookListRequestMultiError, or nil if none found.
-func (m *BookListRequest) ValidateAll() error {
+// ValidateAll checks the field values on BookVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookVersionMultiError, or
+// nil if none found.
+func (m *BookVersion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BookListRequest) validate(all bool) error {
+func (m *BookVersion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPagination() == nil {
-		err := BookListRequestValidationError{
-			field:  "Pagination",
-			reason: "value is required",
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookVersionValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookVersionValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookVersionValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for Removed
+
+	// no validation rules for Change
+
+	// no validation rules for Actor
+
 	if all {
-		switch v := interface{}(m.GetPagination()).(type) {
+		switch v := interface{}(m.GetChangedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BookListRequestValidationError{
-					field:  "Pagination",
+				errors = append(errors, BookVersionValidationError{
+					field:  "ChangedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, BookListRequestValidationError{
-					field:  "Pagination",
+				errors = append(errors, BookVersionValidationError{
+					field:  "ChangedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return BookListRequestValidationError{
-				field:  "Pagination",
+			return BookVersionValidationError{
+				field:  "ChangedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -492,19 +4513,18 @@ func (m *BookListRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return BookListRequestMultiError(errors)
+		return BookVersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// BookListRequestMultiError is an error wrapping multiple validation errors
-// returned by BookListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type BookListRequestMultiError []error
+// BookVersionMultiError is an error wrapping multiple validation errors
+// returned by BookVersion.ValidateAll() if the designated constraints aren't met.
+type BookVersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BookListRequestMultiError) Error() string {
+func (m BookVersionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -513,11 +4533,11 @@ func (m BookListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BookListRequestMultiError) AllErrors() []error { return m }
+func (m BookVersionMultiError) AllErrors() []error { return m }
 
-// BookListRequestValidationError is the validation error returned by
-// BookListRequest.Validate if the designated constraints aren't met.
-type BookListRequestValidationError struct {
+// BookVersionValidationError is the validation error returned by
+// BookVersion.Validate if the designated constraints aren't met.
+type BookVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -525,22 +4545,22 @@ type BookListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BookListRequestValidationError) Field() string { return e.field }
+func (e BookVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BookListRequestValidationError) Reason() string { return e.reason }
+func (e BookVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BookListRequestValidationError) Cause() error { return e.cause }
+func (e BookVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BookListRequestValidationError) Key() bool { return e.key }
+func (e BookVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BookListRequestValidationError) ErrorName() string { return "BookListRequestValidationError" }
+func (e BookVersionValidationError) ErrorName() string { return "BookVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BookListRequestValidationError) Error() string {
+func (e BookVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -552,14 +4572,14 @@ func (e BookListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBookListRequest.%s: %s%s",
+		"invalid %sBookVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BookListRequestValidationError{}
+var _ error = BookVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -567,47 +4587,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BookListRequestValidationError{}
+} = BookVersionValidationError{}
 
-// Validate checks the field values on BooksResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BooksResponse) Validate() error {
+// Validate checks the field values on BookHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BookHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BooksResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BooksResponseMultiError, or
-// nil if none found.
-func (m *BooksResponse) ValidateAll() error {
+// ValidateAll checks the field values on BookHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookHistoryResponseMultiError, or nil if none found.
+func (m *BookHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BooksResponse) validate(all bool) error {
+func (m *BookHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBook() {
+	for idx, item := range m.GetVersions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, BooksResponseValidationError{
-						field:  fmt.Sprintf("Book[%v]", idx),
+					errors = append(errors, BookHistoryResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, BooksResponseValidationError{
-						field:  fmt.Sprintf("Book[%v]", idx),
+					errors = append(errors, BookHistoryResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -615,8 +4635,8 @@ func (m *BooksResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return BooksResponseValidationError{
-					field:  fmt.Sprintf("Book[%v]", idx),
+				return BookHistoryResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -626,19 +4646,19 @@ func (m *BooksResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return BooksResponseMultiError(errors)
+		return BookHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BooksResponseMultiError is an error wrapping multiple validation errors
-// returned by BooksResponse.ValidateAll() if the designated constraints
-// aren't met.
-type BooksResponseMultiError []error
+// BookHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by BookHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BookHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BooksResponseMultiError) Error() string {
+func (m BookHistoryResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -647,11 +4667,11 @@ func (m BooksResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BooksResponseMultiError) AllErrors() []error { return m }
+func (m BookHistoryResponseMultiError) AllErrors() []error { return m }
 
-// BooksResponseValidationError is the validation error returned by
-// BooksResponse.Validate if the designated constraints aren't met.
-type BooksResponseValidationError struct {
+// BookHistoryResponseValidationError is the validation error returned by
+// BookHistoryResponse.Validate if the designated constraints aren't met.
+type BookHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -659,22 +4679,24 @@ type BooksResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BooksResponseValidationError) Field() string { return e.field }
+func (e BookHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BooksResponseValidationError) Reason() string { return e.reason }
+func (e BookHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BooksResponseValidationError) Cause() error { return e.cause }
+func (e BookHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BooksResponseValidationError) Key() bool { return e.key }
+func (e BookHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BooksResponseValidationError) ErrorName() string { return "BooksResponseValidationError" }
+func (e BookHistoryResponseValidationError) ErrorName() string {
+	return "BookHistoryResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BooksResponseValidationError) Error() string {
+func (e BookHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -686,14 +4708,14 @@ func (e BooksResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBooksResponse.%s: %s%s",
+		"invalid %sBookHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BooksResponseValidationError{}
+var _ error = BookHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -701,107 +4723,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BooksResponseValidationError{}
+} = BookHistoryResponseValidationError{}
 
-// Validate checks the field values on BookListResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *BookListResponse) Validate() error {
+// Validate checks the field values on BookListRequest_Sort with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BookListRequest_Sort) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BookListResponse with the rules
+// ValidateAll checks the field values on BookListRequest_Sort with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BookListResponseMultiError, or nil if none found.
-func (m *BookListResponse) ValidateAll() error {
+// BookListRequest_SortMultiError, or nil if none found.
+func (m *BookListRequest_Sort) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BookListResponse) validate(all bool) error {
+func (m *BookListRequest_Sort) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPagination()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BookListResponseValidationError{
-					field:  "Pagination",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, BookListResponseValidationError{
-					field:  "Pagination",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if _, ok := _BookListRequest_Sort_Field_InLookup[m.GetField()]; !ok {
+		err := BookListRequest_SortValidationError{
+			field:  "Field",
+			reason: "value must be in list [id title year genre_id created_at]",
 		}
-	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BookListResponseValidationError{
-				field:  "Pagination",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetBooks() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, BookListResponseValidationError{
-						field:  fmt.Sprintf("Books[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, BookListResponseValidationError{
-						field:  fmt.Sprintf("Books[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return BookListResponseValidationError{
-					field:  fmt.Sprintf("Books[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if _, ok := _BookListRequest_Sort_Order_InLookup[m.GetOrder()]; !ok {
+		err := BookListRequest_SortValidationError{
+			field:  "Order",
+			reason: "value must be in list [asc desc]",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return BookListResponseMultiError(errors)
+		return BookListRequest_SortMultiError(errors)
 	}
 
 	return nil
 }
 
-// BookListResponseMultiError is an error wrapping multiple validation errors
-// returned by BookListResponse.ValidateAll() if the designated constraints
-// aren't met.
-type BookListResponseMultiError []error
+// BookListRequest_SortMultiError is an error wrapping multiple validation
+// errors returned by BookListRequest_Sort.ValidateAll() if the designated
+// constraints aren't met.
+type BookListRequest_SortMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BookListResponseMultiError) Error() string {
+func (m BookListRequest_SortMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -810,11 +4791,11 @@ func (m BookListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BookListResponseMultiError) AllErrors() []error { return m }
+func (m BookListRequest_SortMultiError) AllErrors() []error { return m }
 
-// BookListResponseValidationError is the validation error returned by
-// BookListResponse.Validate if the designated constraints aren't met.
-type BookListResponseValidationError struct {
+// BookListRequest_SortValidationError is the validation error returned by
+// BookListRequest_Sort.Validate if the designated constraints aren't met.
+type BookListRequest_SortValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -822,22 +4803,24 @@ type BookListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BookListResponseValidationError) Field() string { return e.field }
+func (e BookListRequest_SortValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BookListResponseValidationError) Reason() string { return e.reason }
+func (e BookListRequest_SortValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BookListResponseValidationError) Cause() error { return e.cause }
+func (e BookListRequest_SortValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BookListResponseValidationError) Key() bool { return e.key }
+func (e BookListRequest_SortValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BookListResponseValidationError) ErrorName() string { return "BookListResponseValidationError" }
+func (e BookListRequest_SortValidationError) ErrorName() string {
+	return "BookListRequest_SortValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BookListResponseValidationError) Error() string {
+func (e BookListRequest_SortValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -849,14 +4832,14 @@ func (e BookListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBookListResponse.%s: %s%s",
+		"invalid %sBookListRequest_Sort.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BookListResponseValidationError{}
+var _ error = BookListRequest_SortValidationError{}
 
 var _ interface {
 	Field() string
@@ -864,7 +4847,20 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BookListResponseValidationError{}
+} = BookListRequest_SortValidationError{}
+
+var _BookListRequest_Sort_Field_InLookup = map[string]struct{}{
+	"id":         {},
+	"title":      {},
+	"year":       {},
+	"genre_id":   {},
+	"created_at": {},
+}
+
+var _BookListRequest_Sort_Order_InLookup = map[string]struct{}{
+	"asc":  {},
+	"desc": {},
+}
 
 // Validate checks the field values on BookListRequest_CursorPagination with
 // the rules defined in the proto definition for this message. If any rules
@@ -905,7 +4901,7 @@ func (m *BookListRequest_CursorPagination) validate(all bool) error {
 	if _, ok := _BookListRequest_CursorPagination_SortBy_InLookup[m.GetSortBy()]; !ok {
 		err := BookListRequest_CursorPaginationValidationError{
 			field:  "SortBy",
-			reason: "value must be in list [id title year]",
+			reason: "value must be in list [id title year genre_id created_at]",
 		}
 		if !all {
 			return err
@@ -924,6 +4920,53 @@ func (m *BookListRequest_CursorPagination) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for WithTotalCount
+
+	if len(m.GetThenBy()) > 2 {
+		err := BookListRequest_CursorPaginationValidationError{
+			field:  "ThenBy",
+			reason: "value must contain no more than 2 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetThenBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookListRequest_CursorPaginationValidationError{
+						field:  fmt.Sprintf("ThenBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookListRequest_CursorPaginationValidationError{
+						field:  fmt.Sprintf("ThenBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookListRequest_CursorPaginationValidationError{
+					field:  fmt.Sprintf("ThenBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return BookListRequest_CursorPaginationMultiError(errors)
 	}
@@ -1013,9 +5056,11 @@ var _BookListRequest_CursorPagination_PageSize_InLookup = map[uint64]struct{}{
 }
 
 var _BookListRequest_CursorPagination_SortBy_InLookup = map[string]struct{}{
-	"id":    {},
-	"title": {},
-	"year":  {},
+	"id":         {},
+	"title":      {},
+	"year":       {},
+	"genre_id":   {},
+	"created_at": {},
 }
 
 var _BookListRequest_CursorPagination_SortOrder_InLookup = map[string]struct{}{
@@ -1057,6 +5102,16 @@ func (m *BookListResponse_CursorPagination) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for CursorPrev
+
+	// no validation rules for HasPrevious
+
+	// no validation rules for HasNext
+
+	if m.TotalCount != nil {
+		// no validation rules for TotalCount
+	}
+
 	if len(errors) > 0 {
 		return BookListResponse_CursorPaginationMultiError(errors)
 	}
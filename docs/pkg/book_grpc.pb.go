@@ -20,10 +20,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookService_GetByIDs_FullMethodName = "/mathbdw.grpc.v1.BookService/GetByIDs"
-	BookService_Add_FullMethodName      = "/mathbdw.grpc.v1.BookService/Add"
-	BookService_List_FullMethodName     = "/mathbdw.grpc.v1.BookService/List"
-	BookService_Delete_FullMethodName   = "/mathbdw.grpc.v1.BookService/Delete"
+	BookService_GetByIDs_FullMethodName   = "/mathbdw.grpc.v1.BookService/GetByIDs"
+	BookService_GetByISBN_FullMethodName  = "/mathbdw.grpc.v1.BookService/GetByISBN"
+	BookService_Add_FullMethodName        = "/mathbdw.grpc.v1.BookService/Add"
+	BookService_BatchAdd_FullMethodName   = "/mathbdw.grpc.v1.BookService/BatchAdd"
+	BookService_Update_FullMethodName     = "/mathbdw.grpc.v1.BookService/Update"
+	BookService_List_FullMethodName       = "/mathbdw.grpc.v1.BookService/List"
+	BookService_Search_FullMethodName     = "/mathbdw.grpc.v1.BookService/Search"
+	BookService_Delete_FullMethodName     = "/mathbdw.grpc.v1.BookService/Delete"
+	BookService_Restore_FullMethodName    = "/mathbdw.grpc.v1.BookService/Restore"
+	BookService_Merge_FullMethodName      = "/mathbdw.grpc.v1.BookService/Merge"
+	BookService_GetHistory_FullMethodName = "/mathbdw.grpc.v1.BookService/GetHistory"
 )
 
 // BookServiceClient is the client API for BookService service.
@@ -31,9 +38,16 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BookServiceClient interface {
 	GetByIDs(ctx context.Context, in *BookGetRequest, opts ...grpc.CallOption) (*BooksResponse, error)
-	Add(ctx context.Context, in *BookAddRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetByISBN(ctx context.Context, in *BookGetByISBNRequest, opts ...grpc.CallOption) (*Book, error)
+	Add(ctx context.Context, in *BookAddRequest, opts ...grpc.CallOption) (*Book, error)
+	BatchAdd(ctx context.Context, in *BookBatchAddRequest, opts ...grpc.CallOption) (*BookBatchAddResponse, error)
+	Update(ctx context.Context, in *BookUpdateRequest, opts ...grpc.CallOption) (*Book, error)
 	List(ctx context.Context, in *BookListRequest, opts ...grpc.CallOption) (*BookListResponse, error)
-	Delete(ctx context.Context, in *BookGetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Search(ctx context.Context, in *BookSearchRequest, opts ...grpc.CallOption) (*BookSearchResponse, error)
+	Delete(ctx context.Context, in *BookChangeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Restore(ctx context.Context, in *BookChangeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Merge(ctx context.Context, in *BookMergeRequest, opts ...grpc.CallOption) (*Book, error)
+	GetHistory(ctx context.Context, in *BookHistoryRequest, opts ...grpc.CallOption) (*BookHistoryResponse, error)
 }
 
 type bookServiceClient struct {
@@ -54,9 +68,19 @@ func (c *bookServiceClient) GetByIDs(ctx context.Context, in *BookGetRequest, op
 	return out, nil
 }
 
-func (c *bookServiceClient) Add(ctx context.Context, in *BookAddRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *bookServiceClient) GetByISBN(ctx context.Context, in *BookGetByISBNRequest, opts ...grpc.CallOption) (*Book, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(empty.Empty)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookService_GetByISBN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) Add(ctx context.Context, in *BookAddRequest, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
 	err := c.cc.Invoke(ctx, BookService_Add_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -64,6 +88,26 @@ func (c *bookServiceClient) Add(ctx context.Context, in *BookAddRequest, opts ..
 	return out, nil
 }
 
+func (c *bookServiceClient) BatchAdd(ctx context.Context, in *BookBatchAddRequest, opts ...grpc.CallOption) (*BookBatchAddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookBatchAddResponse)
+	err := c.cc.Invoke(ctx, BookService_BatchAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) Update(ctx context.Context, in *BookUpdateRequest, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookServiceClient) List(ctx context.Context, in *BookListRequest, opts ...grpc.CallOption) (*BookListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BookListResponse)
@@ -74,7 +118,17 @@ func (c *bookServiceClient) List(ctx context.Context, in *BookListRequest, opts
 	return out, nil
 }
 
-func (c *bookServiceClient) Delete(ctx context.Context, in *BookGetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *bookServiceClient) Search(ctx context.Context, in *BookSearchRequest, opts ...grpc.CallOption) (*BookSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookSearchResponse)
+	err := c.cc.Invoke(ctx, BookService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) Delete(ctx context.Context, in *BookChangeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, BookService_Delete_FullMethodName, in, out, cOpts...)
@@ -84,14 +138,51 @@ func (c *bookServiceClient) Delete(ctx context.Context, in *BookGetRequest, opts
 	return out, nil
 }
 
+func (c *bookServiceClient) Restore(ctx context.Context, in *BookChangeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, BookService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) Merge(ctx context.Context, in *BookMergeRequest, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookService_Merge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetHistory(ctx context.Context, in *BookHistoryRequest, opts ...grpc.CallOption) (*BookHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookHistoryResponse)
+	err := c.cc.Invoke(ctx, BookService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookServiceServer is the server API for BookService service.
 // All implementations must embed UnimplementedBookServiceServer
 // for forward compatibility.
 type BookServiceServer interface {
 	GetByIDs(context.Context, *BookGetRequest) (*BooksResponse, error)
-	Add(context.Context, *BookAddRequest) (*empty.Empty, error)
+	GetByISBN(context.Context, *BookGetByISBNRequest) (*Book, error)
+	Add(context.Context, *BookAddRequest) (*Book, error)
+	BatchAdd(context.Context, *BookBatchAddRequest) (*BookBatchAddResponse, error)
+	Update(context.Context, *BookUpdateRequest) (*Book, error)
 	List(context.Context, *BookListRequest) (*BookListResponse, error)
-	Delete(context.Context, *BookGetRequest) (*empty.Empty, error)
+	Search(context.Context, *BookSearchRequest) (*BookSearchResponse, error)
+	Delete(context.Context, *BookChangeRequest) (*empty.Empty, error)
+	Restore(context.Context, *BookChangeRequest) (*empty.Empty, error)
+	Merge(context.Context, *BookMergeRequest) (*Book, error)
+	GetHistory(context.Context, *BookHistoryRequest) (*BookHistoryResponse, error)
 	mustEmbedUnimplementedBookServiceServer()
 }
 
@@ -105,15 +196,36 @@ type UnimplementedBookServiceServer struct{}
 func (UnimplementedBookServiceServer) GetByIDs(context.Context, *BookGetRequest) (*BooksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByIDs not implemented")
 }
-func (UnimplementedBookServiceServer) Add(context.Context, *BookAddRequest) (*empty.Empty, error) {
+func (UnimplementedBookServiceServer) GetByISBN(context.Context, *BookGetByISBNRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByISBN not implemented")
+}
+func (UnimplementedBookServiceServer) Add(context.Context, *BookAddRequest) (*Book, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
 }
+func (UnimplementedBookServiceServer) BatchAdd(context.Context, *BookBatchAddRequest) (*BookBatchAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAdd not implemented")
+}
+func (UnimplementedBookServiceServer) Update(context.Context, *BookUpdateRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
 func (UnimplementedBookServiceServer) List(context.Context, *BookListRequest) (*BookListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-func (UnimplementedBookServiceServer) Delete(context.Context, *BookGetRequest) (*empty.Empty, error) {
+func (UnimplementedBookServiceServer) Search(context.Context, *BookSearchRequest) (*BookSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedBookServiceServer) Delete(context.Context, *BookChangeRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedBookServiceServer) Restore(context.Context, *BookChangeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedBookServiceServer) Merge(context.Context, *BookMergeRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Merge not implemented")
+}
+func (UnimplementedBookServiceServer) GetHistory(context.Context, *BookHistoryRequest) (*BookHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
 func (UnimplementedBookServiceServer) mustEmbedUnimplementedBookServiceServer() {}
 func (UnimplementedBookServiceServer) testEmbeddedByValue()                     {}
 
@@ -153,6 +265,24 @@ func _BookService_GetByIDs_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookService_GetByISBN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookGetByISBNRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetByISBN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetByISBN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetByISBN(ctx, req.(*BookGetByISBNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BookAddRequest)
 	if err := dec(in); err != nil {
@@ -171,6 +301,42 @@ func _BookService_Add_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookService_BatchAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookBatchAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).BatchAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_BatchAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).BatchAdd(ctx, req.(*BookBatchAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).Update(ctx, req.(*BookUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BookListRequest)
 	if err := dec(in); err != nil {
@@ -189,8 +355,26 @@ func _BookService_List_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).Search(ctx, req.(*BookSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BookGetRequest)
+	in := new(BookChangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -202,7 +386,61 @@ func _BookService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 		FullMethod: BookService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).Delete(ctx, req.(*BookGetRequest))
+		return srv.(BookServiceServer).Delete(ctx, req.(*BookChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).Restore(ctx, req.(*BookChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).Merge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_Merge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).Merge(ctx, req.(*BookMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetHistory(ctx, req.(*BookHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -218,18 +456,554 @@ var BookService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByIDs",
 			Handler:    _BookService_GetByIDs_Handler,
 		},
+		{
+			MethodName: "GetByISBN",
+			Handler:    _BookService_GetByISBN_Handler,
+		},
 		{
 			MethodName: "Add",
 			Handler:    _BookService_Add_Handler,
 		},
+		{
+			MethodName: "BatchAdd",
+			Handler:    _BookService_BatchAdd_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _BookService_Update_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _BookService_List_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _BookService_Search_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _BookService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _BookService_Restore_Handler,
+		},
+		{
+			MethodName: "Merge",
+			Handler:    _BookService_Merge_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _BookService_GetHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/book.proto",
+}
+
+const (
+	AuthorService_GetByIDs_FullMethodName = "/mathbdw.grpc.v1.AuthorService/GetByIDs"
+	AuthorService_Add_FullMethodName      = "/mathbdw.grpc.v1.AuthorService/Add"
+	AuthorService_Update_FullMethodName   = "/mathbdw.grpc.v1.AuthorService/Update"
+	AuthorService_List_FullMethodName     = "/mathbdw.grpc.v1.AuthorService/List"
+	AuthorService_Delete_FullMethodName   = "/mathbdw.grpc.v1.AuthorService/Delete"
+)
+
+// AuthorServiceClient is the client API for AuthorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthorServiceClient interface {
+	GetByIDs(ctx context.Context, in *AuthorGetRequest, opts ...grpc.CallOption) (*AuthorsResponse, error)
+	Add(ctx context.Context, in *AuthorAddRequest, opts ...grpc.CallOption) (*Author, error)
+	Update(ctx context.Context, in *AuthorUpdateRequest, opts ...grpc.CallOption) (*Author, error)
+	List(ctx context.Context, in *AuthorListRequest, opts ...grpc.CallOption) (*AuthorListResponse, error)
+	Delete(ctx context.Context, in *AuthorGetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type authorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthorServiceClient(cc grpc.ClientConnInterface) AuthorServiceClient {
+	return &authorServiceClient{cc}
+}
+
+func (c *authorServiceClient) GetByIDs(ctx context.Context, in *AuthorGetRequest, opts ...grpc.CallOption) (*AuthorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorsResponse)
+	err := c.cc.Invoke(ctx, AuthorService_GetByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) Add(ctx context.Context, in *AuthorAddRequest, opts ...grpc.CallOption) (*Author, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Author)
+	err := c.cc.Invoke(ctx, AuthorService_Add_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) Update(ctx context.Context, in *AuthorUpdateRequest, opts ...grpc.CallOption) (*Author, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Author)
+	err := c.cc.Invoke(ctx, AuthorService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) List(ctx context.Context, in *AuthorListRequest, opts ...grpc.CallOption) (*AuthorListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorListResponse)
+	err := c.cc.Invoke(ctx, AuthorService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) Delete(ctx context.Context, in *AuthorGetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, AuthorService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthorServiceServer is the server API for AuthorService service.
+// All implementations must embed UnimplementedAuthorServiceServer
+// for forward compatibility.
+type AuthorServiceServer interface {
+	GetByIDs(context.Context, *AuthorGetRequest) (*AuthorsResponse, error)
+	Add(context.Context, *AuthorAddRequest) (*Author, error)
+	Update(context.Context, *AuthorUpdateRequest) (*Author, error)
+	List(context.Context, *AuthorListRequest) (*AuthorListResponse, error)
+	Delete(context.Context, *AuthorGetRequest) (*empty.Empty, error)
+	mustEmbedUnimplementedAuthorServiceServer()
+}
+
+// UnimplementedAuthorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthorServiceServer struct{}
+
+func (UnimplementedAuthorServiceServer) GetByIDs(context.Context, *AuthorGetRequest) (*AuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByIDs not implemented")
+}
+func (UnimplementedAuthorServiceServer) Add(context.Context, *AuthorAddRequest) (*Author, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedAuthorServiceServer) Update(context.Context, *AuthorUpdateRequest) (*Author, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedAuthorServiceServer) List(context.Context, *AuthorListRequest) (*AuthorListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedAuthorServiceServer) Delete(context.Context, *AuthorGetRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedAuthorServiceServer) mustEmbedUnimplementedAuthorServiceServer() {}
+func (UnimplementedAuthorServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeAuthorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthorServiceServer will
+// result in compilation errors.
+type UnsafeAuthorServiceServer interface {
+	mustEmbedUnimplementedAuthorServiceServer()
+}
+
+func RegisterAuthorServiceServer(s grpc.ServiceRegistrar, srv AuthorServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthorService_ServiceDesc, srv)
+}
+
+func _AuthorService_GetByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).GetByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorService_GetByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).GetByIDs(ctx, req.(*AuthorGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorService_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).Add(ctx, req.(*AuthorAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).Update(ctx, req.(*AuthorUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).List(ctx, req.(*AuthorListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthorService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).Delete(ctx, req.(*AuthorGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthorService_ServiceDesc is the grpc.ServiceDesc for AuthorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mathbdw.grpc.v1.AuthorService",
+	HandlerType: (*AuthorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetByIDs",
+			Handler:    _AuthorService_GetByIDs_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _AuthorService_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _AuthorService_Update_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _AuthorService_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _AuthorService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/book.proto",
+}
+
+const (
+	GenreService_GetByIDs_FullMethodName = "/mathbdw.grpc.v1.GenreService/GetByIDs"
+	GenreService_Add_FullMethodName      = "/mathbdw.grpc.v1.GenreService/Add"
+	GenreService_Update_FullMethodName   = "/mathbdw.grpc.v1.GenreService/Update"
+	GenreService_List_FullMethodName     = "/mathbdw.grpc.v1.GenreService/List"
+	GenreService_Delete_FullMethodName   = "/mathbdw.grpc.v1.GenreService/Delete"
+)
+
+// GenreServiceClient is the client API for GenreService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GenreServiceClient interface {
+	GetByIDs(ctx context.Context, in *GenreGetRequest, opts ...grpc.CallOption) (*GenresResponse, error)
+	Add(ctx context.Context, in *GenreAddRequest, opts ...grpc.CallOption) (*Genre, error)
+	Update(ctx context.Context, in *GenreUpdateRequest, opts ...grpc.CallOption) (*Genre, error)
+	List(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenresResponse, error)
+	Delete(ctx context.Context, in *GenreGetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type genreServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGenreServiceClient(cc grpc.ClientConnInterface) GenreServiceClient {
+	return &genreServiceClient{cc}
+}
+
+func (c *genreServiceClient) GetByIDs(ctx context.Context, in *GenreGetRequest, opts ...grpc.CallOption) (*GenresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenresResponse)
+	err := c.cc.Invoke(ctx, GenreService_GetByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genreServiceClient) Add(ctx context.Context, in *GenreAddRequest, opts ...grpc.CallOption) (*Genre, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Genre)
+	err := c.cc.Invoke(ctx, GenreService_Add_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genreServiceClient) Update(ctx context.Context, in *GenreUpdateRequest, opts ...grpc.CallOption) (*Genre, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Genre)
+	err := c.cc.Invoke(ctx, GenreService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genreServiceClient) List(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenresResponse)
+	err := c.cc.Invoke(ctx, GenreService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genreServiceClient) Delete(ctx context.Context, in *GenreGetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GenreService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GenreServiceServer is the server API for GenreService service.
+// All implementations must embed UnimplementedGenreServiceServer
+// for forward compatibility.
+type GenreServiceServer interface {
+	GetByIDs(context.Context, *GenreGetRequest) (*GenresResponse, error)
+	Add(context.Context, *GenreAddRequest) (*Genre, error)
+	Update(context.Context, *GenreUpdateRequest) (*Genre, error)
+	List(context.Context, *empty.Empty) (*GenresResponse, error)
+	Delete(context.Context, *GenreGetRequest) (*empty.Empty, error)
+	mustEmbedUnimplementedGenreServiceServer()
+}
+
+// UnimplementedGenreServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGenreServiceServer struct{}
+
+func (UnimplementedGenreServiceServer) GetByIDs(context.Context, *GenreGetRequest) (*GenresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByIDs not implemented")
+}
+func (UnimplementedGenreServiceServer) Add(context.Context, *GenreAddRequest) (*Genre, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedGenreServiceServer) Update(context.Context, *GenreUpdateRequest) (*Genre, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedGenreServiceServer) List(context.Context, *empty.Empty) (*GenresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGenreServiceServer) Delete(context.Context, *GenreGetRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGenreServiceServer) mustEmbedUnimplementedGenreServiceServer() {}
+func (UnimplementedGenreServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeGenreServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GenreServiceServer will
+// result in compilation errors.
+type UnsafeGenreServiceServer interface {
+	mustEmbedUnimplementedGenreServiceServer()
+}
+
+func RegisterGenreServiceServer(s grpc.ServiceRegistrar, srv GenreServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGenreServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GenreService_ServiceDesc, srv)
+}
+
+func _GenreService_GetByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenreGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenreServiceServer).GetByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenreService_GetByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenreServiceServer).GetByIDs(ctx, req.(*GenreGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenreService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenreAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenreServiceServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenreService_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenreServiceServer).Add(ctx, req.(*GenreAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenreService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenreUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenreServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenreService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenreServiceServer).Update(ctx, req.(*GenreUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenreService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenreServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenreService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenreServiceServer).List(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenreService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenreGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenreServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenreService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenreServiceServer).Delete(ctx, req.(*GenreGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GenreService_ServiceDesc is the grpc.ServiceDesc for GenreService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GenreService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mathbdw.grpc.v1.GenreService",
+	HandlerType: (*GenreServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetByIDs",
+			Handler:    _GenreService_GetByIDs_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _GenreService_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GenreService_Update_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _GenreService_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GenreService_Delete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/book.proto",
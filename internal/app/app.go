@@ -22,7 +22,9 @@ import (
 	status_controller "github.com/mathbdw/book/internal/interfaces/controllers/status"
 	book_bot_handler "github.com/mathbdw/book/internal/interfaces/controllers/telegram_bot/v1/handlers"
 	"github.com/mathbdw/book/internal/interfaces/observability"
+	author_usecase "github.com/mathbdw/book/internal/usecases/author"
 	book_usecase "github.com/mathbdw/book/internal/usecases/book"
+	genre_usecase "github.com/mathbdw/book/internal/usecases/genre"
 	uc_services "github.com/mathbdw/book/internal/usecases/services"
 	"github.com/mathbdw/book/pkg/gateway"
 	"github.com/mathbdw/book/pkg/grpcserver"
@@ -61,6 +63,16 @@ func initPostgres(cfg *config.Config, logger observability.Logger) *pkg_postgres
 	return pg
 }
 
+// initCursorSigner - initializing signing of the pagination cursors
+func initCursorSigner(cfg *config.Config, logger observability.Logger) {
+	signer, err := book_repo.NewCursorSigner(cfg.Cursor.KeyID, cfg.Cursor.Keys, cfg.Cursor.TTL, cfg.Cursor.AcceptUnsigned)
+	if err != nil {
+		logger.Fatal("app.initCursorSigner: cursor signer new", map[string]any{"error": err})
+	}
+
+	book_repo.SetCursorSigner(signer)
+}
+
 // applyMigration - apply migration
 func applyMigration(cfg *config.Config, pg *pkg_postgres.Postgres, logger observability.Logger) {
 	if err := goose.Up(pg.Sqlx.DB, cfg.Database.Migrations); err != nil {
@@ -68,6 +80,18 @@ func applyMigration(cfg *config.Config, pg *pkg_postgres.Postgres, logger observ
 	}
 }
 
+// applySearchConfig - apply the text search config, the search vectors are rebuilt when it is changed
+func applySearchConfig(ctx context.Context, cfg *config.Config, pg *pkg_postgres.Postgres, logger observability.Logger) {
+	rebuilt, err := book_repo.ApplySearchConfig(ctx, pg.Sqlx, pg.Builder, cfg.Database.SearchConfig)
+	if err != nil {
+		logger.Fatal("app.applySearchConfig: failed search config", map[string]any{"err": err})
+	}
+
+	if rebuilt > 0 {
+		logger.Info("app.applySearchConfig: search vectors rebuilt", map[string]any{"config": cfg.Database.SearchConfig, "books": rebuilt})
+	}
+}
+
 // initTracer - initializing tracer
 func initTracer(ctx context.Context, cfg *config.Config, logger observability.Logger) *sdktrace.TracerProvider {
 	tracer, err := pkg_tracer.New(
@@ -197,19 +221,33 @@ func RunPublisher(cfg *config.Config) {
 	if err != nil {
 		logger.Fatal("app.RunPublisher: init publisher", map[string]any{"err": err})
 	}
-	publisherUsecases := uc_services.New(
-		bookEventRepo,
-		publisher,
-		logger,
+	publisherOpts := []uc_services.Option{
 		uc_services.WithBatchSize(cfg.Kafka.Publisher.BatchSize),
 		uc_services.WithInterval(cfg.Kafka.Publisher.Interval),
 		uc_services.WithCountWorkers(cfg.Kafka.Publisher.CountWorkers),
-	)
+		uc_services.WithMaxAttempts(cfg.Kafka.Publisher.MaxAttempts),
+		uc_services.WithRetryBackoff(cfg.Kafka.Publisher.RetryBase, cfg.Kafka.Publisher.RetryMax),
+		uc_services.WithLockLease(cfg.Kafka.Publisher.LockLease),
+		uc_services.WithMetrics(observ.ForRepository()),
+	}
+	if cfg.Kafka.Publisher.Listen {
+		publisherOpts = append(publisherOpts, uc_services.WithListener(book_repo.NewBookEventListener(pg.Connect, logger)))
+	}
+	publisherUsecases := uc_services.New(bookEventRepo, publisher, logger, publisherOpts...)
+
+	err = observ.ForRepository().ObserveOutbox(func() (int64, int64, int64) {
+		stats := publisherUsecases.Stats()
+
+		return int64(stats.Workers), int64(stats.Busy), int64(stats.InFlight)
+	})
+	if err != nil {
+		logger.Error("app.RunPublisher: observe outbox", map[string]any{"error": err.Error()})
+	}
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	errCh := make(chan error)
+	errCh := make(chan error, 1)
 	go func() {
 		errCh <- publisherUsecases.Start(ctx)
 	}()
@@ -221,10 +259,81 @@ func RunPublisher(cfg *config.Config) {
 	select {
 	case s := <-interrupt:
 		logger.Error("app.RunPublisher: ", map[string]any{"signal": s.String()})
+
+		// the workers finish their batches before the producer and the db are closed
+		cancel()
+		<-errCh
 	case <-errCh:
 		logger.Error("app.RunPublisher: publisher shutting down...", nil)
 	}
+}
+
+// RunPurge - run hard purge of removed books, once or by interval
+func RunPurge(cfg *config.Config) {
+	ctx := context.Background()
+
+	logger := initLogger(cfg)
+	pg := initPostgres(cfg, logger)
+	defer pg.Sqlx.Close()
+
+	tp := initTracer(ctx, cfg, logger)
+	mp := initMetric(ctx, cfg, logger)
+	observ := initObservability(ctx, cfg, tp, mp, logger)
+
+	uowRepo := book_repo.NewUnitOfWork(pg.Sqlx, pg.Builder, observ.ForRepository())
+	purgeBookUC := book_usecase.NewPurgeBookUsecase(uowRepo, observ.ForUsecases())
+	purgeIdempotencyUC := book_usecase.NewPurgeIdempotencyUsecase(uowRepo, observ.ForUsecases())
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	purge := func() {
+		count, err := purgeBookUC.Execute(ctx, cfg.Purge.Retention, cfg.Purge.BatchSize, cfg.Purge.DryRun)
+		if err != nil {
+			logger.Error("app.RunPurge: purge", map[string]any{"error": err.Error(), "purged": count})
+
+			return
+		}
+
+		if cfg.Purge.DryRun {
+			logger.Info("app.RunPurge: dry run", map[string]any{"wouldPurge": count})
+
+			return
+		}
+		logger.Info("app.RunPurge: purged", map[string]any{"purged": count})
+
+		keys, err := purgeIdempotencyUC.Execute(ctx, cfg.Purge.BatchSize)
+		if err != nil {
+			logger.Error("app.RunPurge: purge idempotency keys", map[string]any{"error": err.Error(), "purged": keys})
+
+			return
+		}
+		logger.Info("app.RunPurge: purged idempotency keys", map[string]any{"purged": keys})
+	}
+
+	if cfg.Purge.Interval <= 0 {
+		purge()
+
+		return
+	}
+
+	ticker := time.NewTicker(cfg.Purge.Interval)
+	defer ticker.Stop()
+
+	// Waiting signal
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+
+	for {
+		select {
+		case s := <-interrupt:
+			logger.Error("app.RunPurge: ", map[string]any{"signal": s.String()})
+
+			return
+		case <-ticker.C:
+			purge()
+		}
+	}
 }
 
 // RunApp - run bot servic
@@ -239,19 +348,26 @@ func RunBot(cfg *config.Config) {
 	tp := initTracer(ctx, cfg, logger)
 	mp := initMetric(ctx, cfg, logger)
 	observ := initObservability(ctx, cfg, tp, mp, logger)
+	initCursorSigner(cfg, logger)
 
 	bookRepo := book_repo.NewBookRepository(pg.Sqlx, pg.Builder, observ.ForRepository())
 	uowRepo := book_repo.NewUnitOfWork(pg.Sqlx, pg.Builder, observ.ForRepository())
-	addBookUC := book_usecase.NewAddBookUsecase(uowRepo, observ.ForUsecases())
-	getBookUC := book_usecase.NewGetBookUsecase(bookRepo, observ.ForUsecases())
+	addBookUC := book_usecase.NewAddBookUsecase(uowRepo, observ.ForUsecases(), cfg.Idempotency.TTL)
+	getBookUC := book_usecase.NewGetBookUsecase(bookRepo, book_repo.NewBookRedirectRepository(pg.Sqlx, pg.Builder, observ.ForRepository()), observ.ForUsecases())
 	listBookUC := book_usecase.NewListBookUsecase(bookRepo, observ.ForUsecases())
-	removeBookUC := book_usecase.NewRemoveBookUsecase(uowRepo, observ.ForUsecases())
+	removeBookUC := book_usecase.NewRemoveBookUsecase(uowRepo, observ.ForUsecases(), cfg.Idempotency.TTL)
+	updateBookUC := book_usecase.NewUpdateBookUsecase(uowRepo, observ.ForUsecases())
+	restoreBookUC := book_usecase.NewRestoreBookUsecase(uowRepo, observ.ForUsecases())
+	batchAddBookUC := book_usecase.NewBatchAddBookUsecase(uowRepo, observ.ForUsecases())
 
 	uc := book_usecase.New(
 		book_usecase.WithAddBookUsecase(addBookUC),
 		book_usecase.WithGetBookUsecase(getBookUC),
 		book_usecase.WithListBookUsecase(listBookUC),
 		book_usecase.WithRemoveBookUsecase(removeBookUC),
+		book_usecase.WithUpdateBookUsecase(updateBookUC),
+		book_usecase.WithRestoreBookUsecase(restoreBookUC),
+		book_usecase.WithBatchAddBookUsecase(batchAddBookUC),
 	)
 
 	bot, err := pkg_tbot.New(
@@ -295,9 +411,11 @@ func RunApp(cfg *config.Config) {
 	defer pg.Sqlx.Close()
 
 	applyMigration(cfg, pg, logger)
+	applySearchConfig(ctx, cfg, pg, logger)
 	tp := initTracer(ctx, cfg, logger)
 	mp := initMetric(ctx, cfg, logger)
 	observ := initObservability(ctx, cfg, tp, mp, logger)
+	initCursorSigner(cfg, logger)
 
 	gatewayServer := gateway.New(
 		gateway.Address(cfg.Rest.Host, cfg.Rest.Port),
@@ -317,16 +435,28 @@ func RunApp(cfg *config.Config) {
 
 	bookRepo := book_repo.NewBookRepository(pg.Sqlx, pg.Builder, observ.ForRepository())
 	uowRepo := book_repo.NewUnitOfWork(pg.Sqlx, pg.Builder, observ.ForRepository())
-	addBookUC := book_usecase.NewAddBookUsecase(uowRepo, observ.ForUsecases())
-	getBookUC := book_usecase.NewGetBookUsecase(bookRepo, observ.ForUsecases())
+	addBookUC := book_usecase.NewAddBookUsecase(uowRepo, observ.ForUsecases(), cfg.Idempotency.TTL)
+	getBookUC := book_usecase.NewGetBookUsecase(bookRepo, book_repo.NewBookRedirectRepository(pg.Sqlx, pg.Builder, observ.ForRepository()), observ.ForUsecases())
 	listBookUC := book_usecase.NewListBookUsecase(bookRepo, observ.ForUsecases())
-	removeBookUC := book_usecase.NewRemoveBookUsecase(uowRepo, observ.ForUsecases())
+	removeBookUC := book_usecase.NewRemoveBookUsecase(uowRepo, observ.ForUsecases(), cfg.Idempotency.TTL)
+	updateBookUC := book_usecase.NewUpdateBookUsecase(uowRepo, observ.ForUsecases())
+	restoreBookUC := book_usecase.NewRestoreBookUsecase(uowRepo, observ.ForUsecases())
+	batchAddBookUC := book_usecase.NewBatchAddBookUsecase(uowRepo, observ.ForUsecases())
+	searchBookUC := book_usecase.NewSearchBookUsecase(bookRepo, observ.ForUsecases())
+	historyBookUC := book_usecase.NewHistoryBookUsecase(book_repo.NewBookHistoryRepository(pg.Sqlx, pg.Builder, observ.ForRepository()), observ.ForUsecases())
+	mergeBookUC := book_usecase.NewMergeBookUsecase(uowRepo, observ.ForUsecases())
 
 	uc := book_usecase.New(
 		book_usecase.WithAddBookUsecase(addBookUC),
 		book_usecase.WithGetBookUsecase(getBookUC),
 		book_usecase.WithListBookUsecase(listBookUC),
 		book_usecase.WithRemoveBookUsecase(removeBookUC),
+		book_usecase.WithUpdateBookUsecase(updateBookUC),
+		book_usecase.WithRestoreBookUsecase(restoreBookUC),
+		book_usecase.WithBatchAddBookUsecase(batchAddBookUC),
+		book_usecase.WithSearchBookUsecase(searchBookUC),
+		book_usecase.WithHistoryBookUsecase(historyBookUC),
+		book_usecase.WithMergeBookUsecase(mergeBookUC),
 	)
 
 	book_grpc_handler.NewBookHandler(
@@ -335,6 +465,36 @@ func RunApp(cfg *config.Config) {
 		observ.ForHandler(),
 	)
 
+	authorRepo := book_repo.NewAuthorRepository(pg.Sqlx, pg.Builder, observ.ForRepository())
+	authorUC := author_usecase.New(
+		author_usecase.WithAddAuthorUsecase(author_usecase.NewAddAuthorUsecase(authorRepo, observ.ForUsecases())),
+		author_usecase.WithGetAuthorUsecase(author_usecase.NewGetAuthorUsecase(authorRepo, observ.ForUsecases())),
+		author_usecase.WithListAuthorUsecase(author_usecase.NewListAuthorUsecase(authorRepo, observ.ForUsecases())),
+		author_usecase.WithUpdateAuthorUsecase(author_usecase.NewUpdateAuthorUsecase(authorRepo, observ.ForUsecases())),
+		author_usecase.WithRemoveAuthorUsecase(author_usecase.NewRemoveAuthorUsecase(uowRepo, observ.ForUsecases())),
+	)
+
+	book_grpc_handler.NewAuthorHandler(
+		grpcServer.App,
+		authorUC,
+		observ.ForHandler(),
+	)
+
+	genreRepo := book_repo.NewGenreRepository(pg.Sqlx, pg.Builder, observ.ForRepository())
+	genreUC := genre_usecase.New(
+		genre_usecase.WithAddGenreUsecase(genre_usecase.NewAddGenreUsecase(genreRepo, observ.ForUsecases())),
+		genre_usecase.WithGetGenreUsecase(genre_usecase.NewGetGenreUsecase(genreRepo, observ.ForUsecases())),
+		genre_usecase.WithListGenreUsecase(genre_usecase.NewListGenreUsecase(genreRepo, observ.ForUsecases())),
+		genre_usecase.WithUpdateGenreUsecase(genre_usecase.NewUpdateGenreUsecase(uowRepo, observ.ForUsecases())),
+		genre_usecase.WithRemoveGenreUsecase(genre_usecase.NewRemoveGenreUsecase(uowRepo, observ.ForUsecases())),
+	)
+
+	book_grpc_handler.NewGenreHandler(
+		grpcServer.App,
+		genreUC,
+		observ.ForHandler(),
+	)
+
 	// Start servers
 	gatewayServer.Start(logger)
 	grpcServer.Start()
@@ -0,0 +1,27 @@
+package entities
+
+import "time"
+
+type Author struct {
+	ID        int64     `db:"id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// BookAuthor - author linked to the book
+type BookAuthor struct {
+	BookID int64 `db:"book_id"`
+	Author
+}
+
+// AuthorListParams - parameters of the authors list, AfterID is the last ID of the previous page
+type AuthorListParams struct {
+	Limit   uint64
+	AfterID int64
+}
+
+type ResponseAuthors struct {
+	Data   []Author
+	NextID int64
+}
@@ -2,24 +2,147 @@ package entities
 
 import (
 	"fmt"
+	"slices"
 	"time"
 )
 
+type BookField string
+
+const (
+	BookFieldTitle       BookField = "title"
+	BookFieldDescription BookField = "description"
+	BookFieldYear        BookField = "year"
+	BookFieldGenre       BookField = "genre_id"
+	BookFieldAuthors     BookField = "author_ids"
+	BookFieldISBN        BookField = "isbn"
+)
+
+// BookUpdatableFields - fields of the book that can be changed by an update
+var BookUpdatableFields = []BookField{
+	BookFieldTitle,
+	BookFieldDescription,
+	BookFieldYear,
+	BookFieldGenre,
+	BookFieldAuthors,
+	BookFieldISBN,
+}
+
+// IsUpdatable - reports whether the field can be changed by an update
+func (f BookField) IsUpdatable() bool {
+	return slices.Contains(BookUpdatableFields, f)
+}
+
+// IsRequired - reports whether the field must have a value, the other fields are cleared by an empty value
+func (f BookField) IsRequired() bool {
+	return f == BookFieldTitle || f == BookFieldYear || f == BookFieldGenre
+}
+
+// IsColumn - reports whether the field is stored in the book table
+func (f BookField) IsColumn() bool {
+	return f.IsUpdatable() && f != BookFieldAuthors
+}
+
 type Book struct {
 	ID          int64     `db:"id"`
 	Title       string    `db:"title"`
 	Description string    `db:"description"`
 	Year        int       `db:"year"`
-	Genre       string    `db:"genre"`
+	GenreID     int64     `db:"genre_id"`
+	Genre       string    `db:"-"`
+	ISBN        string    `db:"isbn"`
 	Removed     bool      `db:"removed"`
+	Version     int64     `db:"version"` // increases on every write, the expected version in the updates
 	CreatedAt   time.Time `db:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at"`
+	Authors     []Author  `db:"-"`
+}
+
+// AuthorIDs - returns IDs of the book authors in their order
+func (b Book) AuthorIDs() []int64 {
+	IDs := make([]int64, 0, len(b.Authors))
+	for _, author := range b.Authors {
+		IDs = append(IDs, author.ID)
+	}
+
+	return IDs
 }
 
 func (b *Book) String() string {
 	return fmt.Sprintf("%s, %s, %d, %s", b.Title, b.Description, b.Year, b.Genre)
 }
 
+// GetFieldValue - returns the value of the updatable field
+func (b Book) GetFieldValue(field BookField) (any, error) {
+	switch field {
+	case BookFieldTitle:
+		return b.Title, nil
+	case BookFieldDescription:
+		return b.Description, nil
+	case BookFieldYear:
+		return b.Year, nil
+	case BookFieldGenre:
+		return b.GenreID, nil
+	case BookFieldAuthors:
+		return b.AuthorIDs(), nil
+	case BookFieldISBN:
+		return b.ISBN, nil
+	default:
+		return nil, fmt.Errorf("bookEntity.GetFieldValue: unknown field %s", field)
+	}
+}
+
+// ChangedFields - returns the fields whose values in other differ from b
+func (b Book) ChangedFields(other Book, fields []BookField) []BookField {
+	changed := make([]BookField, 0, len(fields))
+	for _, field := range fields {
+		if field == BookFieldAuthors {
+			if !slices.Equal(b.AuthorIDs(), other.AuthorIDs()) {
+				changed = append(changed, field)
+			}
+
+			continue
+		}
+
+		oldValue, err := b.GetFieldValue(field)
+		if err != nil {
+			continue
+		}
+		newValue, _ := other.GetFieldValue(field)
+
+		if oldValue != newValue {
+			changed = append(changed, field)
+		}
+	}
+
+	return changed
+}
+
+// FillMissing - returns b with its empty description, year, ISBN and authors taken from the first of sources having them,
+// and the filled fields
+func (b Book) FillMissing(sources []Book) (Book, []BookField) {
+	filled := make([]BookField, 0, 4)
+	for _, source := range sources {
+		if b.Description == "" && source.Description != "" {
+			b.Description = source.Description
+			filled = append(filled, BookFieldDescription)
+		}
+		if b.Year == 0 && source.Year != 0 {
+			b.Year = source.Year
+			filled = append(filled, BookFieldYear)
+		}
+		if b.ISBN == "" && source.ISBN != "" {
+			b.ISBN = source.ISBN
+			filled = append(filled, BookFieldISBN)
+		}
+		if len(b.Authors) == 0 && len(source.Authors) > 0 {
+			b.Authors = source.Authors
+			filled = append(filled, BookFieldAuthors)
+		}
+	}
+
+	return b, filled
+}
+
 type ResponseBooks struct {
 	Data     []Book
 	PageInfo PageInfo
@@ -36,11 +159,19 @@ func (b Book) GetFieldAsString(field string) (string, error) {
 		return b.Title, nil
 	case string(CursorTypeBookYear):
 		return fmt.Sprint(b.Year), nil
+	case string(CursorTypeBookGenreID):
+		return fmt.Sprint(b.GenreID), nil
+	case string(CursorTypeBookCreatedAt):
+		return b.CreatedAt.UTC().Format(time.RFC3339Nano), nil
 	default:
 		return "", fmt.Errorf("bookEntity.GetFieldAsString: unknown field %s", field)
 	}
 }
 
+func (b Book) GetID() int64 {
+	return b.ID
+}
+
 func (b Book) GetCreatedAt() time.Time {
 	return b.CreatedAt
 }
@@ -11,18 +11,47 @@ const (
 	Created EventType = iota + 1
 	Updated
 	Deleted
+	Restored
+	Purged
+	Merged
 )
 const (
 	EventStatusNew EventStatus = iota + 1
 	EventStatusLock
 	EventStatusUnlock
+	// EventStatusDead - the event is over the max attempts and is not published any more
+	EventStatusDead
 )
 
 type BookEvent struct {
-	ID        int64       `db:"id"`
-	BookId    int64       `db:"book_id"`
-	Type      EventType   `db:"type"`
-	Status    EventStatus `db:"status"`
-	Payload   []byte      `db:"payload"`
-	UpdatedAt time.Time   `db:"updated_at"`
+	ID            int64       `db:"id"`
+	BookId        int64       `db:"book_id"`
+	Type          EventType   `db:"type"`
+	Status        EventStatus `db:"status"`
+	Payload       []byte      `db:"payload"`
+	UpdatedAt     time.Time   `db:"updated_at"`
+	Attempts      int32       `db:"attempts"`
+	NextAttemptAt time.Time   `db:"next_attempt_at"`
+	LastError     string      `db:"last_error"`
+	LockedUntil   *time.Time  `db:"locked_until"`
+	LockedBy      string      `db:"locked_by"`
+}
+
+// BookUpdated - payload of the Updated event: the new state and the changed fields
+type BookUpdated struct {
+	Book
+	ChangedFields []BookField
+}
+
+// BookPurged - payload of the Purged event: the ID of the book deleted for good
+type BookPurged struct {
+	ID int64
+}
+
+// BookMerged - payload of the Merged event of the target: its new state, the fields filled from the sources
+// and the removed sources, their IDs are redirected to the target
+type BookMerged struct {
+	Book
+	ChangedFields []BookField
+	SourceIDs     []int64
 }
@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+// BookHistory - the state of the book after a write to it, one per version of the book
+type BookHistory struct {
+	Book
+	Type      EventType // the write that produced the version
+	Actor     string    // the caller of the write, empty when unknown
+	ChangedAt time.Time
+}
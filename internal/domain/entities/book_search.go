@@ -0,0 +1,26 @@
+package entities
+
+import "strconv"
+
+// CursorTypeBookRank - cursor of the search results, ordered by rank
+const CursorTypeBookRank CursorType = "rank"
+
+// BookSearchResult - book found by the full-text search
+type BookSearchResult struct {
+	Book
+	Rank    float32 `db:"rank"`
+	Snippet string  `db:"snippet"`
+}
+
+type ResponseBookSearch struct {
+	Data     []BookSearchResult
+	PageInfo PageInfo
+}
+
+func (r BookSearchResult) GetFieldAsString(field string) (string, error) {
+	if field == string(CursorTypeBookRank) {
+		return strconv.FormatFloat(float64(r.Rank), 'g', -1, 32), nil
+	}
+
+	return r.Book.GetFieldAsString(field)
+}
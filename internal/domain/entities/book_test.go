@@ -25,7 +25,7 @@ func TestBookPagination_GetFieldAsString_Empty(t *testing.T) {
 }
 
 func TestBookPagination_GetFieldAsString_Success(t *testing.T) {
-	book := Book{ID: 1, Title: "test", Year: 1900, CreatedAt: time.Now()}
+	book := Book{ID: 1, Title: "test", Year: 1900, GenreID: 4, CreatedAt: time.Date(2021, 1, 5, 19, 0, 0, 1000, time.UTC)}
 
 	tests := []struct {
 		name  string
@@ -35,6 +35,8 @@ func TestBookPagination_GetFieldAsString_Success(t *testing.T) {
 		{"ById", "id", fmt.Sprint(book.ID)},
 		{"ByTitle", "title", book.Title},
 		{"ByYear", "year", fmt.Sprint(book.Year)},
+		{"ByGenreID", "genre_id", "4"},
+		{"ByCreatedAt", "created_at", "2021-01-05T19:00:00.000001Z"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -52,3 +54,133 @@ func TestBookPagination_GetCreatedAt(t *testing.T) {
 
 	assert.Equal(t, book.CreatedAt, res)
 }
+
+func TestBook_BookField_IsUpdatable(t *testing.T) {
+	assert.True(t, BookFieldTitle.IsUpdatable())
+	assert.False(t, BookField("removed").IsUpdatable())
+}
+
+func TestBook_BookField_IsRequired(t *testing.T) {
+	assert.True(t, BookFieldGenre.IsRequired())
+	assert.False(t, BookFieldDescription.IsRequired())
+	assert.False(t, BookFieldISBN.IsRequired())
+}
+
+func TestBook_GetFieldValue(t *testing.T) {
+	book := Book{ID: 1, Title: "test", Description: "desc", Year: 1900, GenreID: 3, Genre: "genre", ISBN: "9780306406157"}
+
+	tests := []struct {
+		name  string
+		field BookField
+		value any
+	}{
+		{"Title", BookFieldTitle, book.Title},
+		{"Description", BookFieldDescription, book.Description},
+		{"Year", BookFieldYear, book.Year},
+		{"Genre", BookFieldGenre, book.GenreID},
+		{"ISBN", BookFieldISBN, book.ISBN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := book.GetFieldValue(tt.field)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.value, value)
+		})
+	}
+
+	_, err := book.GetFieldValue("removed")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown field")
+}
+
+func TestBook_ChangedFields(t *testing.T) {
+	stored := Book{ID: 1, Title: "test", Description: "desc", Year: 1900, GenreID: 3, Genre: "genre"}
+	book := Book{ID: 1, Title: "test", Description: "new desc", Year: 1901}
+
+	changed := stored.ChangedFields(book, []BookField{BookFieldTitle, BookFieldDescription, BookFieldYear})
+
+	assert.Equal(t, []BookField{BookFieldDescription, BookFieldYear}, changed)
+}
+
+func TestBook_ChangedFields_Genre(t *testing.T) {
+	stored := Book{ID: 1, Title: "test", GenreID: 3, Genre: "genre"}
+
+	assert.Empty(t, stored.ChangedFields(Book{ID: 1, GenreID: 3}, []BookField{BookFieldGenre}))
+	assert.Equal(t, []BookField{BookFieldGenre}, stored.ChangedFields(Book{ID: 1, GenreID: 4}, []BookField{BookFieldGenre}))
+}
+
+func TestBook_FillMissing(t *testing.T) {
+	target := Book{ID: 1, Title: "War and Peace", Year: 1869, GenreID: 3}
+	sources := []Book{
+		{ID: 2, Title: "War & Peace", Year: 1868, Description: "novel", Authors: []Author{{ID: 7}}},
+		{ID: 3, Title: "War and Pease", Description: "other", ISBN: "9780306406157"},
+	}
+
+	merged, filled := target.FillMissing(sources)
+
+	assert.Equal(t, []BookField{BookFieldDescription, BookFieldAuthors, BookFieldISBN}, filled)
+	assert.Equal(t, "novel", merged.Description)
+	assert.Equal(t, 1869, merged.Year)
+	assert.Equal(t, "9780306406157", merged.ISBN)
+	assert.Equal(t, []int64{7}, merged.AuthorIDs())
+	assert.Empty(t, target.Description)
+
+	_, filled = merged.FillMissing(sources)
+	assert.Empty(t, filled)
+}
+
+func TestBookSearchResult_GetFieldAsString(t *testing.T) {
+	result := BookSearchResult{Book: Book{ID: 3, Title: "test"}, Rank: 0.1}
+
+	rank, err := result.GetFieldAsString("rank")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1", rank)
+
+	id, err := result.GetFieldAsString("id")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", id)
+}
+
+func TestBookFilter_Key(t *testing.T) {
+	filter := BookFilter{GenreID: 2, YearFrom: 1900, YearTo: 2000, TitlePrefix: "war"}
+
+	assert.Len(t, filter.Key(), 16)
+	assert.Equal(t, filter.Key(), BookFilter{GenreID: 2, YearFrom: 1900, YearTo: 2000, TitlePrefix: "war"}.Key())
+	assert.NotEqual(t, filter.Key(), BookFilter{GenreID: 2, YearFrom: 1900, YearTo: 2000}.Key())
+	assert.NotEqual(t, BookFilter{}.Key(), BookFilter{IncludeRemoved: true}.Key())
+
+	asOf := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	assert.NotEqual(t, filter.Key(), BookFilter{GenreID: 2, YearFrom: 1900, YearTo: 2000, TitlePrefix: "war", AsOf: asOf}.Key())
+	assert.Equal(t, BookFilter{AsOf: asOf}.Key(), BookFilter{AsOf: asOf.In(time.FixedZone("MSK", 3*60*60))}.Key())
+}
+
+func TestCursor_MatchesFilter(t *testing.T) {
+	filter := BookFilter{GenreID: 2}
+
+	assert.True(t, Cursor{Version: CursorVersion2, Filter: filter.Key()}.MatchesFilter(filter))
+	assert.False(t, Cursor{Version: CursorVersion2, Filter: filter.Key()}.MatchesFilter(BookFilter{}))
+	assert.True(t, Cursor{Version: CursorVersion1}.MatchesFilter(BookFilter{}))
+	assert.False(t, Cursor{Version: CursorVersion1}.MatchesFilter(filter))
+	assert.True(t, Cursor{Version: CursorVersion1, Filter: filter.Key()}.MatchesFilter(filter))
+}
+
+func TestCursor_MatchesSort(t *testing.T) {
+	sort := PaginationParams{
+		SortBy:    CursorTypeBookGenreID,
+		SortOrder: SortOrderTypeAsc,
+		ThenBy:    []SortField{{Field: CursorTypeBookYear, Order: SortOrderTypeDesc}},
+	}.SortFields()
+
+	assert.True(t, Cursor{Version: CursorVersion2, Sort: sort}.MatchesSort(sort))
+	assert.False(t, Cursor{Version: CursorVersion2, Sort: sort[:1]}.MatchesSort(sort))
+	createdAt := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	idSort := []SortField{{Field: CursorTypeBookID, Order: SortOrderTypeAsc}}
+	rankSort := []SortField{{Field: CursorTypeBookRank, Order: SortOrderTypeDesc}}
+	assert.True(t, Cursor{Version: CursorVersion1, CreatedAt: &createdAt}.MatchesSort(sort[:1]))
+	assert.False(t, Cursor{Version: CursorVersion1, CreatedAt: &createdAt}.MatchesSort(sort))
+	assert.False(t, Cursor{Version: CursorVersion1}.MatchesSort(sort[:1]))
+	assert.True(t, Cursor{Version: CursorVersion1}.MatchesSort(idSort))
+	assert.False(t, Cursor{Version: CursorVersion1, CreatedAt: &createdAt}.MatchesSort(idSort))
+	assert.False(t, Cursor{Version: CursorVersion1, CreatedAt: &createdAt}.MatchesSort(rankSort))
+}
@@ -0,0 +1,20 @@
+package entities
+
+import "time"
+
+type Genre struct {
+	ID        int64     `db:"id"`
+	Name      string    `db:"name"`
+	ParentID  *int64    `db:"parent_id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// GetParentID - returns ID of the parent genre, 0 for top level genre
+func (g Genre) GetParentID() int64 {
+	if g.ParentID == nil {
+		return 0
+	}
+
+	return *g.ParentID
+}
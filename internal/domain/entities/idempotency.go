@@ -0,0 +1,27 @@
+package entities
+
+import "time"
+
+// IdempotencyOperation - the mutating operation protected by the idempotency key
+type IdempotencyOperation string
+
+const (
+	IdempotencyOperationAddBook    IdempotencyOperation = "book.add"
+	IdempotencyOperationRemoveBook IdempotencyOperation = "book.remove"
+)
+
+// IdempotencyKey - the key sent by the client with the fingerprint of its request and the stored response.
+// The repeated request with the same key gets the stored response until the key expires
+type IdempotencyKey struct {
+	Operation   IdempotencyOperation `db:"operation"`
+	Key         string               `db:"key"`
+	RequestHash string               `db:"request_hash"`
+	Response    []byte               `db:"response"`
+	CreatedAt   time.Time            `db:"created_at"`
+	ExpiresAt   time.Time            `db:"expires_at"`
+}
+
+// IsEmpty - reports whether the client sent no key
+func (k IdempotencyKey) IsEmpty() bool {
+	return k.Key == ""
+}
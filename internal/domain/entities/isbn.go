@@ -0,0 +1,79 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrISBNLength   = errors.New("isbn must contain 10 or 13 digits")
+	ErrISBNChecksum = errors.New("isbn checksum mismatch")
+)
+
+// NormalizeISBN - validates the checksum of ISBN-10 or ISBN-13, ignoring hyphens and spaces,
+// and returns it as ISBN-13 digits
+func NormalizeISBN(raw string) (string, error) {
+	isbn := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(raw))
+
+	switch len(isbn) {
+	case 10:
+		if !isDigits(isbn[:9]) || !(isDigits(isbn[9:]) || isbn[9] == 'X') {
+			return "", ErrISBNLength
+		}
+		if isbn10Checksum(isbn) != 0 {
+			return "", ErrISBNChecksum
+		}
+
+		isbn13 := "978" + isbn[:9]
+		return isbn13 + string(rune('0'+isbn13CheckDigit(isbn13))), nil
+	case 13:
+		if !isDigits(isbn) {
+			return "", ErrISBNLength
+		}
+		if isbn13CheckDigit(isbn[:12]) != int(isbn[12]-'0') {
+			return "", ErrISBNChecksum
+		}
+
+		return isbn, nil
+	default:
+		return "", ErrISBNLength
+	}
+}
+
+// isbn10Checksum - returns the weighted sum of ISBN-10 modulo 11, zero for a valid ISBN
+func isbn10Checksum(isbn string) int {
+	sum := 0
+	for i := 0; i < 10; i++ {
+		digit := int(isbn[i] - '0')
+		if isbn[i] == 'X' {
+			digit = 10
+		}
+		sum += (10 - i) * digit
+	}
+
+	return sum % 11
+}
+
+// isbn13CheckDigit - returns the check digit for the first 12 digits of ISBN-13
+func isbn13CheckDigit(digits string) int {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += weight * int(digits[i]-'0')
+	}
+
+	return (10 - sum%10) % 10
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeISBN_Success(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		isbn string
+	}{
+		{"ISBN13", "9780306406157", "9780306406157"},
+		{"ISBN13WithHyphens", "978-0-306-40615-7", "9780306406157"},
+		{"ISBN10", "0306406152", "9780306406157"},
+		{"ISBN10WithSpaces", "0 306 40615 2", "9780306406157"},
+		{"ISBN10CheckDigitX", "080442957x", "9780804429573"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isbn, err := NormalizeISBN(tt.raw)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.isbn, isbn)
+		})
+	}
+}
+
+func TestNormalizeISBN_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		err  error
+	}{
+		{"Empty", "", ErrISBNLength},
+		{"ShortLength", "030640615", ErrISBNLength},
+		{"XNotLast", "03064X6152", ErrISBNLength},
+		{"ISBN13WithX", "978030640615X", ErrISBNLength},
+		{"ISBN10Checksum", "0306406153", ErrISBNChecksum},
+		{"ISBN13Checksum", "9780306406158", ErrISBNChecksum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isbn, err := NormalizeISBN(tt.raw)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Empty(t, isbn)
+		})
+	}
+}
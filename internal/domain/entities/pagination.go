@@ -1,6 +1,10 @@
 package entities
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
 	"time"
 )
 
@@ -10,27 +14,108 @@ type (
 )
 
 const (
-	CursorTypeBookID    CursorType = "id"
-	CursorTypeBookTitle CursorType = "title"
-	CursorTypeBookYear  CursorType = "year"
+	CursorTypeBookID        CursorType = "id"
+	CursorTypeBookTitle     CursorType = "title"
+	CursorTypeBookYear      CursorType = "year"
+	CursorTypeBookGenreID   CursorType = "genre_id"
+	CursorTypeBookCreatedAt CursorType = "created_at"
 
 	SortOrderTypeAsc  SortOrderType = "asc"
 	SortOrderTypeDesc SortOrderType = "desc"
 )
 
-// SortTypesUnique - unique fields that do not require created_at for cursorPagination
+const (
+	// CursorVersion1 - "value[:createdAt]" cursor of a single sort field with the created_at tiebreaker, only decoded
+	CursorVersion1 = 1
+	// CursorVersion2 - cursor of a multi-column sort with the id tiebreaker
+	CursorVersion2 = 2
+)
+
+// SortTypesUnique - unique fields that do not require a tiebreaker for cursorPagination
 var SortTypesUnique = map[string]bool{
 	string(CursorTypeBookID): true,
 }
 
+// SortField - column of the sort and its order
+type SortField struct {
+	Field CursorType
+	Order SortOrderType
+}
+
+// Cursor - position of the row the page continues from
 type Cursor struct {
-	Value     any
-	CreatedAt *time.Time
+	Version   int
+	Sort      []SortField // sort the cursor was created for, empty in CursorVersion1
+	Keys      []any       // values of the sort fields of the row
+	ID        int64       // id of the row, the tiebreaker of CursorVersion2
+	CreatedAt *time.Time  // created_at of the row, the tiebreaker of CursorVersion1
+	Filter    string      // BookFilter.Key of the list the cursor was created for
+	Backward  bool        // the page before the cursor is requested
+}
+
+// MatchesSort - checks that the cursor was created for the sort.
+// A CursorVersion1 cursor carries created_at unless it was created for the id, the search rank has no such cursors
+func (c Cursor) MatchesSort(sort []SortField) bool {
+	if c.Version == CursorVersion1 {
+		if len(sort) != 1 || sort[0].Field == CursorTypeBookRank {
+			return false
+		}
+
+		return (c.CreatedAt == nil) == SortTypesUnique[string(sort[0].Field)]
+	}
+
+	return slices.Equal(c.Sort, sort)
+}
+
+// MatchesFilter - checks that the cursor was created for the filter,
+// a CursorVersion1 cursor without a filter was created for the default list
+func (c Cursor) MatchesFilter(filter BookFilter) bool {
+	if c.Version == CursorVersion1 && c.Filter == "" {
+		return filter.Key() == BookFilter{}.Key()
+	}
+
+	return c.Filter == filter.Key()
 }
 
 // PageInfo информация о странице
 type PageInfo struct {
-	NextCursor string `json:"next_cursor,omitempty"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	PrevCursor  string `json:"prev_cursor,omitempty"`
+	HasNext     bool   `json:"has_next"`
+	HasPrevious bool   `json:"has_previous"`
+	TotalCount  *int64 `json:"total_count,omitempty"` // set only when PaginationParams.WithTotalCount
+}
+
+// BookFilter - conditions of the books list, zero values are not applied.
+// GenreID also matches books of the child genres, YearFrom and YearTo are inclusive.
+// Removed books are excluded unless IncludeRemoved is set.
+// AsOf lists the books in the state they had at that moment, zero - the current state.
+// Query is the full-text query of the search, it only binds the search cursors to the text.
+type BookFilter struct {
+	AuthorID       int64
+	GenreID        int64
+	YearFrom       int
+	YearTo         int
+	TitlePrefix    string
+	IncludeRemoved bool
+	AsOf           time.Time
+	Query          string
+}
+
+// Key - returns the fingerprint of the filter, cursors carry it to be bound to the filter they were created for
+func (f BookFilter) Key() string {
+	key := fmt.Sprintf("%d|%d|%d|%d|%t|%s", f.AuthorID, f.GenreID, f.YearFrom, f.YearTo, f.IncludeRemoved, f.TitlePrefix)
+	// the key of the current state is kept as is, so the cursors issued before AsOf stay valid
+	if !f.AsOf.IsZero() {
+		key += "|" + f.AsOf.UTC().Format(time.RFC3339Nano)
+	}
+	if f.Query != "" {
+		key += "|q=" + f.Query
+	}
+
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:8])
 }
 
 // PaginationParams параметры пагинации
@@ -39,9 +124,25 @@ type PaginationParams struct {
 	Cursor    *Cursor
 	SortBy    CursorType
 	SortOrder SortOrderType `json:"sort_order"` // "asc" или "desc"
+	// ThenBy - next columns of a multi-column sort
+	ThenBy []SortField
+	Filter BookFilter
+	// WithTotalCount - also count all rows matching the filter
+	WithTotalCount bool
+}
+
+// SortFields - returns the columns of the sort, SortBy first
+func (p PaginationParams) SortFields() []SortField {
+	return append([]SortField{{Field: p.SortBy, Order: p.SortOrder}}, p.ThenBy...)
+}
+
+// IsBackward - the page before the cursor is requested, rows are read in the reversed order
+func (p PaginationParams) IsBackward() bool {
+	return p.Cursor != nil && p.Cursor.Backward
 }
 
 type Paginatable interface {
+	GetID() int64
 	GetFieldAsString(field string) (string, error)
 	GetCreatedAt() time.Time
 }
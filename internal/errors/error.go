@@ -7,11 +7,13 @@ import (
 )
 
 var (
-	ErrNotFound      = New("not found")
-	ErrAlreadyExists = New("already exists")
-	ErrInvalidInput  = New("invalid input")
-	ErrUnauthorized  = New("unauthorized")
-	ErrInternal      = New("internal error")
+	ErrNotFound        = New("not found")
+	ErrAlreadyExists   = New("already exists")
+	ErrInvalidInput    = New("invalid input")
+	ErrUnauthorized    = New("unauthorized")
+	ErrInternal        = New("internal error")
+	ErrConflict        = New("conflict")
+	ErrVersionMismatch = New("version mismatch")
 )
 
 // Error - represents a domain error
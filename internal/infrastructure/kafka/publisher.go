@@ -30,16 +30,18 @@ func New(topic string, producer sarama.SyncProducer, logger observability.Logger
 	return &KafkaPublisher{topic: topic, producer: producer, logger: logger}, nil
 }
 
+// Publish - sends the event keyed by the book, so the events of one book land on one partition in order
 func (kp *KafkaPublisher) Publish(ctx context.Context, bookEvent *entities.BookEvent) error {
 	buf := make([]byte, 2)
 	binary.BigEndian.PutUint16(buf, uint16(bookEvent.Type))
 
 	message := &sarama.ProducerMessage{
 		Topic: kp.topic,
-		Key:   sarama.StringEncoder(fmt.Sprintf("%d", bookEvent.ID)),
+		Key:   sarama.StringEncoder(fmt.Sprintf("%d", bookEvent.BookId)),
 		Value: sarama.ByteEncoder(bookEvent.Payload),
 		Headers: []sarama.RecordHeader{
 			{Key: []byte("event_type"), Value: buf},
+			{Key: []byte("event_id"), Value: []byte(fmt.Sprintf("%d", bookEvent.ID))},
 		},
 	}
 
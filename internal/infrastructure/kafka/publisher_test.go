@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/IBM/sarama"
 	"github.com/mathbdw/book/internal/domain/entities"
 	"github.com/mathbdw/book/mocks"
 	"github.com/stretchr/testify/assert"
@@ -84,3 +85,34 @@ func TestPulisher_Success(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestPulisher_KeyBookID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	producer := mocks.NewMockSyncProducer(ctrl)
+	logger := mocks.NewMockLogger(ctrl)
+	kp, _ := New("test_topic", producer, logger)
+	ctx := context.Background()
+
+	event := &entities.BookEvent{
+		ID:      1,
+		BookId:  2,
+		Type:    entities.Created,
+		Payload: []byte{},
+	}
+
+	producer.EXPECT().
+		SendMessage(gomock.Any()).
+		DoAndReturn(func(message *sarama.ProducerMessage) (int32, int64, error) {
+			assert.Equal(t, sarama.StringEncoder("2"), message.Key)
+			assert.Contains(t, message.Headers, sarama.RecordHeader{Key: []byte("event_id"), Value: []byte("1")})
+
+			return int32(0), int64(0), nil
+		})
+
+	//Log
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).Times(1)
+
+	err := kp.Publish(ctx, event)
+
+	require.NoError(t, err)
+}
@@ -17,6 +17,10 @@ type opentelemetryRepositoryMetrics struct {
 	// DB metrics
 	dbQueryCounter  metric.Int64Counter
 	dbQueryDuration metric.Float64Histogram
+
+	// outbox metrics
+	eventsReclaimedCounter  metric.Int64Counter
+	eventsLockFailedCounter metric.Int64Counter
 }
 
 // NewOpentelemetryRepositoryMetrics - constructor opentelemetryRepositoryMetrics
@@ -45,10 +49,30 @@ func NewOpentelemetryRepositoryMetrics(mp *sdkmetric.MeterProvider) (observabili
 		return nil, fmt.Errorf("repositoryMetic.New: failed to create duration histogram: %w", err)
 	}
 
+	eventsReclaimedCounter, err := meter.Int64Counter(
+		"outbox.events.reclaimed.total",
+		metric.WithDescription("Total number of book events locked again after the lock lease of another worker expired"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("repositoryMetic.New: failed to create reclaimed counter: %w", err)
+	}
+
+	eventsLockFailedCounter, err := meter.Int64Counter(
+		"outbox.events.lock.failed.total",
+		metric.WithDescription("Total number of the failed locks of book events, the worker waits and locks again"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("repositoryMetic.New: failed to create lock failed counter: %w", err)
+	}
+
 	return &opentelemetryRepositoryMetrics{
-		meter:           meter,
-		dbQueryCounter:  dbQueryCounter,
-		dbQueryDuration: dbQueryDuration,
+		meter:                   meter,
+		dbQueryCounter:          dbQueryCounter,
+		dbQueryDuration:         dbQueryDuration,
+		eventsReclaimedCounter:  eventsReclaimedCounter,
+		eventsLockFailedCounter: eventsLockFailedCounter,
 	}, nil
 }
 
@@ -67,3 +91,61 @@ func (m *opentelemetryRepositoryMetrics) RecordDatabaseQuery(ctx context.Context
 	m.dbQueryCounter.Add(ctx, 1, metric.WithAttributes(attributes...))
 	m.dbQueryDuration.Record(ctx, duration, metric.WithAttributes(attributes...))
 }
+
+// RecordEventsReclaimed - adds the book events taken over from the expired lock leases
+func (m *opentelemetryRepositoryMetrics) RecordEventsReclaimed(ctx context.Context, count int) {
+	if count <= 0 {
+		return
+	}
+
+	m.eventsReclaimedCounter.Add(ctx, int64(count))
+}
+
+// RecordEventsLockFailed - increments the counter of the failed locks of book events
+func (m *opentelemetryRepositoryMetrics) RecordEventsLockFailed(ctx context.Context) {
+	m.eventsLockFailedCounter.Add(ctx, 1)
+}
+
+// ObserveOutbox - registers the gauges of the outbox worker pool, stats is read on every collection
+func (m *opentelemetryRepositoryMetrics) ObserveOutbox(stats func() (workers, busy, inFlight int64)) error {
+	workersGauge, err := m.meter.Int64ObservableGauge(
+		"outbox.workers",
+		metric.WithDescription("Number of the running outbox workers"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return fmt.Errorf("repositoryMetic.ObserveOutbox: failed to create workers gauge: %w", err)
+	}
+
+	busyGauge, err := m.meter.Int64ObservableGauge(
+		"outbox.workers.busy",
+		metric.WithDescription("Number of the outbox workers processing a batch"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return fmt.Errorf("repositoryMetic.ObserveOutbox: failed to create busy gauge: %w", err)
+	}
+
+	inFlightGauge, err := m.meter.Int64ObservableGauge(
+		"outbox.events.in_flight",
+		metric.WithDescription("Number of the locked book events being published"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return fmt.Errorf("repositoryMetic.ObserveOutbox: failed to create in flight gauge: %w", err)
+	}
+
+	_, err = m.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		workers, busy, inFlight := stats()
+		o.ObserveInt64(workersGauge, workers)
+		o.ObserveInt64(busyGauge, busy)
+		o.ObserveInt64(inFlightGauge, inFlight)
+
+		return nil
+	}, workersGauge, busyGauge, inFlightGauge)
+	if err != nil {
+		return fmt.Errorf("repositoryMetic.ObserveOutbox: failed to register callback: %w", err)
+	}
+
+	return nil
+}
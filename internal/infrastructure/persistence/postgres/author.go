@@ -0,0 +1,427 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type authorRepository struct {
+	querier sqlx.ExtContext
+	builder sq.StatementBuilderType
+
+	observ observability.RepositoryObservability
+}
+
+// NewAuthorRepository - Constructor AuthorRepository
+func NewAuthorRepository(querier sqlx.ExtContext, builder sq.StatementBuilderType, observ observability.RepositoryObservability) repositories.AuthorRepository {
+	return &authorRepository{querier: querier, builder: builder, observ: observ}
+}
+
+// Create - Adds row and returns the stored author
+func (r *authorRepository) Create(ctx context.Context, author entities.Author) (entities.Author, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "authorRepository.create")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "insert", "authors", duration, success)
+	}()
+
+	query, args, err := r.builder.Insert("authors").
+		Columns("name").
+		Values(author.Name).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return entities.Author{}, errs.Wrap(err, "authorPostgres.Create: error builder")
+	}
+
+	var created entities.Author
+	err = r.querier.QueryRowxContext(ctx, query, args...).StructScan(&created)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return entities.Author{}, errs.Wrap(err, "authorPostgres.Create: error scanning")
+	}
+
+	success = true
+	return created, nil
+}
+
+// GetByIDs - Returns authors by IDs
+func (r *authorRepository) GetByIDs(ctx context.Context, IDs []int64) ([]entities.Author, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "authorRepository.getByIDs")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "authors", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("*").
+		From("authors").
+		Where(sq.Eq{"id": IDs}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "authorPostgres.GetByIDs: error builder")
+	}
+
+	authors, err := r.selectAuthors(ctx, query, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "queryxContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "authorPostgres.GetByIDs")
+	}
+
+	if len(authors) == 0 {
+		span.SetAttributes([]observability.Attribute{{Key: "len.author.zero", Value: true}})
+
+		return nil, errs.Wrap(errs.ErrNotFound, "authorPostgres.GetByIDs: len authors")
+	}
+
+	success = true
+	return authors, nil
+}
+
+// List - Returns a page of authors ordered by ID
+func (r *authorRepository) List(ctx context.Context, params entities.AuthorListParams) (*entities.ResponseAuthors, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "authorRepository.list")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "authors", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("*").
+		From("authors").
+		Where(sq.Gt{"id": params.AfterID}).
+		OrderBy("id").
+		Limit(params.Limit + 1).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "authorPostgres.List: error builder")
+	}
+
+	authors, err := r.selectAuthors(ctx, query, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "queryxContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "authorPostgres.List")
+	}
+
+	resp := &entities.ResponseAuthors{Data: authors}
+	if uint64(len(authors)) > params.Limit {
+		resp.Data = authors[:params.Limit]
+		resp.NextID = resp.Data[len(resp.Data)-1].ID
+	}
+
+	success = true
+	return resp, nil
+}
+
+// Update - Updates the name of the author and returns its new state
+func (r *authorRepository) Update(ctx context.Context, author entities.Author) (entities.Author, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "authorRepository.update")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "update", "authors", duration, success)
+	}()
+
+	query, args, err := r.builder.Update("authors").
+		Set("name", author.Name).
+		Set("updated_at", time.Now().UTC()).
+		Where(sq.Eq{"id": author.ID}).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return entities.Author{}, errs.Wrap(err, "authorPostgres.Update: error builder")
+	}
+
+	var updated entities.Author
+	err = r.querier.QueryRowxContext(ctx, query, args...).StructScan(&updated)
+	if err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetAttributes([]observability.Attribute{{Key: "len.author.zero", Value: true}})
+
+			return entities.Author{}, errs.Wrap(errs.ErrNotFound, fmt.Sprintf("authorPostgres.Update: author %d", author.ID))
+		}
+
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return entities.Author{}, errs.Wrap(err, "authorPostgres.Update: error scanning")
+	}
+
+	success = true
+	return updated, nil
+}
+
+// Remove - Deletes authors by IDs
+func (r *authorRepository) Remove(ctx context.Context, IDs []int64) error {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "authorRepository.remove")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "delete", "authors", duration, success)
+	}()
+
+	query, args, err := r.builder.Delete("authors").Where(sq.Eq{"id": IDs}).ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "authorPostgres.Remove: error builder")
+	}
+
+	res, err := r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(err, "authorPostgres.Remove: error query")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "rowsAffected.failed", Value: true}})
+
+		return errs.Wrap(err, "authorPostgres.Remove: error get affected rows")
+	}
+
+	if rowsAffected != int64(len(IDs)) {
+		span.SetAttributes([]observability.Attribute{{Key: "len.author.noEqual.failed", Value: true}})
+
+		return errs.Wrap(errs.ErrNotFound, fmt.Sprintf("authorPostgres.Remove: expected rowsAffected %d, actual %d", len(IDs), rowsAffected))
+	}
+
+	success = true
+	return nil
+}
+
+// CountBooks - Returns count of books linked to the authors
+func (r *authorRepository) CountBooks(ctx context.Context, IDs []int64) (int64, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "authorRepository.countBooks")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "book_authors", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("COUNT(DISTINCT book_id)").
+		From("book_authors").
+		Where(sq.Eq{"author_id": IDs}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "authorPostgres.CountBooks: error builder")
+	}
+
+	var count int64
+	err = r.querier.QueryRowxContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "authorPostgres.CountBooks: error scanning")
+	}
+
+	success = true
+	return count, nil
+}
+
+// GetByBookIDs - Returns authors of the books grouped by book ID in their order
+func (r *authorRepository) GetByBookIDs(ctx context.Context, bookIDs []int64) (map[int64][]entities.Author, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "authorRepository.getByBookIDs")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "book_authors", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("ba.book_id", "a.id", "a.name", "a.created_at", "a.updated_at").
+		From("book_authors ba").
+		Join("authors a ON a.id = ba.author_id").
+		Where(sq.Eq{"ba.book_id": bookIDs}).
+		OrderBy("ba.book_id", "ba.position").
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "authorPostgres.GetByBookIDs: error builder")
+	}
+
+	rows, err := r.querier.QueryxContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "queryxContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "authorPostgres.GetByBookIDs: error query")
+	}
+	defer rows.Close()
+
+	authors := make(map[int64][]entities.Author, len(bookIDs))
+	for rows.Next() {
+		var bookAuthor entities.BookAuthor
+		err = rows.StructScan(&bookAuthor)
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+			return nil, errs.Wrap(err, "authorPostgres.GetByBookIDs: error scan")
+		}
+		authors[bookAuthor.BookID] = append(authors[bookAuthor.BookID], bookAuthor.Author)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "iteration.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "authorPostgres.GetByBookIDs: iteration rows")
+	}
+
+	success = true
+	return authors, nil
+}
+
+// SetBookAuthors - Replaces the authors of the book, the order of authorIDs is kept
+func (r *authorRepository) SetBookAuthors(ctx context.Context, bookID int64, authorIDs []int64) error {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "authorRepository.setBookAuthors")
+	span.SetAttributes([]observability.Attribute{{Key: "book.id", Value: bookID}})
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "update", "book_authors", duration, success)
+	}()
+
+	query, args, err := r.builder.Delete("book_authors").Where(sq.Eq{"book_id": bookID}).ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "authorPostgres.SetBookAuthors: error builder delete")
+	}
+
+	_, err = r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(err, "authorPostgres.SetBookAuthors: error query delete")
+	}
+
+	if len(authorIDs) == 0 {
+		success = true
+		return nil
+	}
+
+	builder := r.builder.Insert("book_authors").Columns("book_id", "author_id", "position")
+	for position, authorID := range authorIDs {
+		builder = builder.Values(bookID, authorID, position)
+	}
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "authorPostgres.SetBookAuthors: error builder insert")
+	}
+
+	_, err = r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(err, "authorPostgres.SetBookAuthors: error query insert")
+	}
+
+	success = true
+	return nil
+}
+
+// selectAuthors - runs the select query and scans the authors
+func (r *authorRepository) selectAuthors(ctx context.Context, query string, args []any) ([]entities.Author, error) {
+	rows, err := r.querier.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, errs.Wrap(err, "error query")
+	}
+	defer rows.Close()
+
+	authors := make([]entities.Author, 0)
+	for rows.Next() {
+		var author entities.Author
+		if err := rows.StructScan(&author); err != nil {
+			return nil, errs.Wrap(err, "error scan")
+		}
+		authors = append(authors, author)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errs.Wrap(err, "iteration rows")
+	}
+
+	return authors, nil
+}
@@ -0,0 +1,294 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+func newAuthorRepositoryMock(t *testing.T) (repositories.AuthorRepository, sqlmock.Sqlmock, func()) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+
+	return NewAuthorRepository(sqlxDB, builder, observ), mock, func() { mockDB.Close() }
+}
+
+var authorColumns = []string{"id", "name", "created_at", "updated_at"}
+
+func TestAuthor_Create_ErrorScan(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO authors (name) VALUES ($1) RETURNING *")).
+		WithArgs("Jules Verne").
+		WillReturnError(sql.ErrConnDone)
+
+	author, err := repo.Create(ctx, entities.Author{Name: "Jules Verne"})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Equal(t, entities.Author{}, author)
+	assert.Contains(t, err.Error(), "authorPostgres.Create: error scanning")
+}
+
+func TestAuthor_Create_Success(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+	now := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO authors (name) VALUES ($1) RETURNING *")).
+		WithArgs("Jules Verne").
+		WillReturnRows(sqlmock.NewRows(authorColumns).AddRow(1, "Jules Verne", now, now))
+
+	author, err := repo.Create(ctx, entities.Author{Name: "Jules Verne"})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, entities.Author{ID: 1, Name: "Jules Verne", CreatedAt: now, UpdatedAt: now}, author)
+}
+
+func TestAuthor_GetByIDs_ErrorNotFound(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM authors WHERE id IN ($1,$2) ORDER BY id")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows(authorColumns))
+
+	authors, err := repo.GetByIDs(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Nil(t, authors)
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestAuthor_GetByIDs_Success(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM authors WHERE id IN ($1,$2) ORDER BY id")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows(authorColumns).
+			AddRow(1, "First", time.Now(), time.Now()).
+			AddRow(2, "Second", time.Now(), time.Now()))
+
+	authors, err := repo.GetByIDs(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, authors, 2)
+	assert.Equal(t, "Second", authors[1].Name)
+}
+
+func TestAuthor_List_Success(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM authors WHERE id > $1 ORDER BY id LIMIT 3")).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows(authorColumns).
+			AddRow(5, "First", time.Now(), time.Now()).
+			AddRow(6, "Second", time.Now(), time.Now()).
+			AddRow(7, "Third", time.Now(), time.Now()))
+
+	resp, err := repo.List(ctx, entities.AuthorListParams{Limit: 2, AfterID: 4})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, resp.Data, 2)
+	assert.Equal(t, int64(6), resp.NextID)
+}
+
+func TestAuthor_List_LastPage(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM authors WHERE id > $1 ORDER BY id LIMIT 3")).
+		WithArgs(0).
+		WillReturnRows(sqlmock.NewRows(authorColumns).AddRow(1, "First", time.Now(), time.Now()))
+
+	resp, err := repo.List(ctx, entities.AuthorListParams{Limit: 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, resp.Data, 1)
+	assert.Equal(t, int64(0), resp.NextID)
+}
+
+func TestAuthor_Update_ErrorNotFound(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE authors SET name = $1, updated_at = $2 WHERE id = $3 RETURNING *")).
+		WithArgs("New name", sqlmock.AnyArg(), 1).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.Update(ctx, entities.Author{ID: 1, Name: "New name"})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestAuthor_Update_Success(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE authors SET name = $1, updated_at = $2 WHERE id = $3 RETURNING *")).
+		WithArgs("New name", sqlmock.AnyArg(), 1).
+		WillReturnRows(sqlmock.NewRows(authorColumns).AddRow(1, "New name", time.Now(), time.Now()))
+
+	author, err := repo.Update(ctx, entities.Author{ID: 1, Name: "New name"})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, "New name", author.Name)
+}
+
+func TestAuthor_Remove_ErrorNotEqualRowsAffected(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM authors WHERE id IN ($1,$2)")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Remove(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestAuthor_Remove_Success(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM authors WHERE id IN ($1,$2)")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.Remove(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestAuthor_CountBooks_Success(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(DISTINCT book_id) FROM book_authors WHERE author_id IN ($1,$2)")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	count, err := repo.CountBooks(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+}
+
+func TestAuthor_GetByBookIDs_Success(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	//expectBookAuthors - book_test.go
+	expectBookAuthors(mock,
+		sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}).
+			AddRow(1, 7, "First", time.Now(), time.Now()).
+			AddRow(1, 2, "Second", time.Now(), time.Now()).
+			AddRow(3, 2, "Second", time.Now(), time.Now()),
+		1, 3,
+	)
+
+	authors, err := repo.GetByBookIDs(ctx, []int64{1, 3})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, authors[1], 2)
+	assert.Equal(t, int64(7), authors[1][0].ID)
+	assert.Equal(t, "Second", authors[3][0].Name)
+}
+
+func TestAuthor_SetBookAuthors_ErrorInsert(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_authors WHERE book_id = $1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO book_authors (book_id,author_id,position) VALUES ($1,$2,$3),($4,$5,$6)")).
+		WithArgs(1, 7, 0, 1, 2, 1).
+		WillReturnError(sql.ErrConnDone)
+
+	err := repo.SetBookAuthors(ctx, 1, []int64{7, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "authorPostgres.SetBookAuthors: error query insert")
+}
+
+func TestAuthor_SetBookAuthors_Empty(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_authors WHERE book_id = $1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.SetBookAuthors(ctx, 1, nil)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestAuthor_SetBookAuthors_Success(t *testing.T) {
+	repo, mock, closeDB := newAuthorRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_authors WHERE book_id = $1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO book_authors (book_id,author_id,position) VALUES ($1,$2,$3),($4,$5,$6)")).
+		WithArgs(1, 7, 0, 1, 2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.SetBookAuthors(ctx, 1, []int64{7, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
@@ -2,7 +2,11 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -14,6 +18,12 @@ import (
 	"github.com/mathbdw/book/internal/interfaces/repositories"
 )
 
+// bookColumns - the columns of book read into entities.Book, search_vector is only used by Search
+var bookColumns = []string{"id", "title", "description", "year", "genre_id", "isbn", "removed", "version", "created_at", "updated_at"}
+
+// bookReturning - the RETURNING clause of the writes returning entities.Book
+var bookReturning = "RETURNING " + strings.Join(bookColumns, ", ")
+
 type bookRepository struct {
 	querier sqlx.ExtContext
 	builder sq.StatementBuilderType
@@ -32,8 +42,8 @@ func NewBookRepository(querier sqlx.ExtContext, builder sq.StatementBuilderType,
 	}
 }
 
-// Create - Adds row
-func (r *bookRepository) Create(ctx context.Context, book entities.Book) (int64, error) {
+// Create - Adds row and returns the stored book
+func (r *bookRepository) Create(ctx context.Context, book entities.Book) (entities.Book, error) {
 	var success bool
 	start := time.Now()
 	ctx, span := r.observ.StartSpan(ctx, "bookRepository.create")
@@ -49,32 +59,109 @@ func (r *bookRepository) Create(ctx context.Context, book entities.Book) (int64,
 		"title":       book.Title,
 		"description": book.Description,
 		"year":        book.Year,
-		"genre":       book.Genre,
+		"genre_id":    book.GenreID,
+		"isbn":        book.ISBN,
 	}
 
-	query, args, err := r.builder.Insert("book").SetMap(data).Suffix("RETURNING id").ToSql()
+	query, args, err := r.builder.Insert("book").SetMap(data).Suffix(bookReturning).ToSql()
 	if err != nil {
 		span.RecordError(err)
 		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
 
-		return 0, errs.Wrap(err, "bookPostgres.Create: error builder")
+		return entities.Book{}, errs.Wrap(err, "bookPostgres.Create: error builder")
 	}
 
-	var insertedID int64
-	err = r.querier.QueryRowxContext(ctx, query, args...).Scan(&insertedID)
+	var created entities.Book
+	err = r.querier.QueryRowxContext(ctx, query, args...).StructScan(&created)
 	if err != nil {
 		span.RecordError(err)
 		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
 
-		return 0, errs.Wrap(err, "bookPostgres.Create: error scanning")
+		return entities.Book{}, errs.Wrap(bookConstraintError(err), "bookPostgres.Create: error scanning")
 	}
 
 	success = true
-	return insertedID, nil
+	return created, nil
+}
+
+// CreateBatch - Adds rows with one multi-row insert and returns the stored books in the same order
+func (r *bookRepository) CreateBatch(ctx context.Context, books []entities.Book) ([]entities.Book, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRepository.createBatch")
+	span.SetAttributes([]observability.Attribute{{Key: "books.count", Value: len(books)}})
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "insert", "book", duration, success)
+	}()
+
+	builder := r.builder.Insert("book").Columns("title", "description", "year", "genre_id", "isbn")
+	for _, book := range books {
+		builder = builder.Values(book.Title, book.Description, book.Year, book.GenreID, book.ISBN)
+	}
+
+	query, args, err := builder.Suffix(bookReturning).ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.CreateBatch: error builder")
+	}
+
+	rows, err := r.querier.QueryxContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "queryxContext.failed", Value: true}})
+
+		return nil, errs.Wrap(bookConstraintError(err), "bookPostgres.CreateBatch: error query")
+	}
+	defer rows.Close()
+
+	created := make([]entities.Book, 0, len(books))
+	for rows.Next() {
+		var book entities.Book
+		err = rows.StructScan(&book)
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+			return nil, errs.Wrap(err, "bookPostgres.CreateBatch: error scan")
+		}
+		created = append(created, book)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "iteration.failed", Value: true}})
+
+		return nil, errs.Wrap(bookConstraintError(err), "bookPostgres.CreateBatch: iteration rows")
+	}
+
+	if len(created) != len(books) {
+		span.SetAttributes([]observability.Attribute{{Key: "len.book.noEqual.failed", Value: true}})
+
+		return nil, errs.New(fmt.Sprintf("bookPostgres.CreateBatch: expected %d rows, actual %d", len(books), len(created)))
+	}
+
+	success = true
+	return created, nil
 }
 
 // GetByIDs - Returns books by IDs
 func (r *bookRepository) GetByIDs(ctx context.Context, IDs []int64) ([]entities.Book, error) {
+	return r.getByIDs(ctx, IDs, time.Time{})
+}
+
+// GetByIDsAsOf - Returns books by IDs in the state they had at asOf, the books removed at asOf are not returned
+func (r *bookRepository) GetByIDsAsOf(ctx context.Context, IDs []int64, asOf time.Time) ([]entities.Book, error) {
+	return r.getByIDs(ctx, IDs, asOf)
+}
+
+// getByIDs - Returns not removed books by IDs, the current state or the state at asOf when it is set
+func (r *bookRepository) getByIDs(ctx context.Context, IDs []int64, asOf time.Time) ([]entities.Book, error) {
 	var success bool
 	start := time.Now()
 	ctx, span := r.observ.StartSpan(ctx, "bookRepository.getByIDs")
@@ -86,8 +173,7 @@ func (r *bookRepository) GetByIDs(ctx context.Context, IDs []int64) ([]entities.
 		r.observ.RecordDatabaseQuery(ctx, "select", "book", duration, success)
 	}()
 
-	query, args, err := r.builder.Select("*").
-		From("book").
+	query, args, err := selectBooks(r.builder, entities.BookFilter{AsOf: asOf}, bookColumns...).
 		Where(sq.And{sq.Eq{"id": IDs}, sq.Eq{"removed": false}}).
 		ToSql()
 	if err != nil {
@@ -104,7 +190,7 @@ func (r *bookRepository) GetByIDs(ctx context.Context, IDs []int64) ([]entities.
 
 		return []entities.Book{}, errs.Wrap(err, "bookPostgres.GetByIds: error query")
 	}
-	rows.Close()
+	defer rows.Close()
 
 	books := make([]entities.Book, 0, len(IDs))
 	for rows.Next() {
@@ -132,10 +218,134 @@ func (r *bookRepository) GetByIDs(ctx context.Context, IDs []int64) ([]entities.
 		return []entities.Book{}, errs.Wrap(errs.ErrNotFound, "bookPostgres.GetByIds: len books")
 	}
 
+	err = r.attachBookAuthors(ctx, books, asOf)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "authors.failed", Value: true}})
+
+		return []entities.Book{}, errs.Wrap(err, "bookPostgres.GetByIds")
+	}
+
+	err = r.attachGenres(ctx, books)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "genres.failed", Value: true}})
+
+		return []entities.Book{}, errs.Wrap(err, "bookPostgres.GetByIds")
+	}
+
 	success = true
 	return books, nil
 }
 
+// FindDuplicates - Returns IDs of the not removed books of the same year and genre whose title is similar to the title of the book,
+// the most similar first. The trigram similarity of the titles is at least minSimilarity,
+// the index prefilters them by pg_trgm.similarity_threshold so minSimilarity below it has no effect
+func (r *bookRepository) FindDuplicates(ctx context.Context, book entities.Book, minSimilarity float64, limit uint64) ([]int64, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRepository.findDuplicates")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "book", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("id").
+		From("book").
+		Where(sq.And{
+			sq.Eq{"removed": false},
+			sq.Eq{"year": book.Year},
+			sq.Eq{"genre_id": book.GenreID},
+			sq.Expr("title % ?", book.Title),
+			sq.Expr("similarity(title, ?) >= ?", book.Title, minSimilarity),
+		}).
+		OrderByClause("similarity(title, ?) DESC, id", book.Title).
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.FindDuplicates: error builder")
+	}
+
+	IDs := make([]int64, 0, limit)
+	err = sqlx.SelectContext(ctx, r.querier, &IDs, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "selectContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.FindDuplicates: error query")
+	}
+
+	success = true
+	return IDs, nil
+}
+
+// GetByISBN - Returns a not removed book by ISBN
+func (r *bookRepository) GetByISBN(ctx context.Context, isbn string) (entities.Book, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRepository.getByISBN")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "book", duration, success)
+	}()
+
+	query, args, err := r.builder.Select(bookColumns...).
+		From("book").
+		Where(sq.And{sq.Eq{"isbn": isbn}, sq.Eq{"removed": false}}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return entities.Book{}, errs.Wrap(err, "bookPostgres.GetByISBN: error builder")
+	}
+
+	var book entities.Book
+	err = r.querier.QueryRowxContext(ctx, query, args...).StructScan(&book)
+	if err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetAttributes([]observability.Attribute{{Key: "len.book.zero", Value: true}})
+
+			return entities.Book{}, errs.Wrap(errs.ErrNotFound, fmt.Sprintf("bookPostgres.GetByISBN: book %s", isbn))
+		}
+
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return entities.Book{}, errs.Wrap(err, "bookPostgres.GetByISBN: error scanning")
+	}
+
+	books := []entities.Book{book}
+	err = r.attachAuthors(ctx, books)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "authors.failed", Value: true}})
+
+		return entities.Book{}, errs.Wrap(err, "bookPostgres.GetByISBN")
+	}
+
+	err = r.attachGenres(ctx, books)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "genres.failed", Value: true}})
+
+		return entities.Book{}, errs.Wrap(err, "bookPostgres.GetByISBN")
+	}
+
+	success = true
+	return books[0], nil
+}
+
 // List - Returns a list of books using pagination
 func (r *bookRepository) List(ctx context.Context, params entities.PaginationParams) (*entities.ResponseBooks, error) {
 	var success bool
@@ -151,7 +361,7 @@ func (r *bookRepository) List(ctx context.Context, params entities.PaginationPar
 
 	limit := params.Limit + 1
 
-	query := r.builder.Select("*").From("book")
+	query := selectBooks(r.builder, params.Filter, bookColumns...)
 	query = conditionBuilder(query, params)
 	query = orderByBuilder(query, params)
 	query = query.Limit(limit)
@@ -171,7 +381,7 @@ func (r *bookRepository) List(ctx context.Context, params entities.PaginationPar
 
 		return nil, errs.Wrap(err, "bookPostgres.List: error query")
 	}
-	rows.Close()
+	defer rows.Close()
 
 	books := make([]entities.Book, 0, limit)
 	for rows.Next() {
@@ -215,6 +425,36 @@ func (r *bookRepository) List(ctx context.Context, params entities.PaginationPar
 	if uint64(len(books)) > params.Limit {
 		books = books[:params.Limit]
 	}
+	if params.IsBackward() {
+		slices.Reverse(books)
+	}
+
+	if params.WithTotalCount {
+		totalCount, err := r.countFiltered(ctx, params.Filter)
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes([]observability.Attribute{{Key: "count.failed", Value: true}})
+
+			return nil, errs.Wrap(err, "bookPostgres.List")
+		}
+		pageInfo.TotalCount = &totalCount
+	}
+
+	err = r.attachBookAuthors(ctx, books, params.Filter.AsOf)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "authors.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.List")
+	}
+
+	err = r.attachGenres(ctx, books)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "genres.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.List")
+	}
 
 	success = true
 	return &entities.ResponseBooks{
@@ -223,8 +463,224 @@ func (r *bookRepository) List(ctx context.Context, params entities.PaginationPar
 	}, nil
 }
 
-// Remove - Sets the field removed to true
-func (r *bookRepository) Remove(ctx context.Context, IDs []int64) error {
+// countFiltered - Returns the number of books matching the filter
+func (r *bookRepository) countFiltered(ctx context.Context, filter entities.BookFilter) (int64, error) {
+	query, args, err := filterBuilder(selectBooks(r.builder, filter, "COUNT(*)"), filter).ToSql()
+	if err != nil {
+		return 0, errs.Wrap(err, "count: error builder")
+	}
+
+	var count int64
+	err = r.querier.QueryRowxContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, errs.Wrap(err, "count: error scanning")
+	}
+
+	return count, nil
+}
+
+// searchHeadlineOptions - options of ts_headline for the snippets of the search results
+const searchHeadlineOptions = "StartSel=<b>, StopSel=</b>, MaxWords=35, MinWords=15, MaxFragments=2"
+
+// Search - Returns not removed books matching the full-text query ordered by rank, using pagination
+func (r *bookRepository) Search(ctx context.Context, text string, params entities.PaginationParams) (*entities.ResponseBookSearch, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRepository.search")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "book", duration, success)
+	}()
+
+	params.SortBy = entities.CursorTypeBookRank
+	params.SortOrder = entities.SortOrderTypeDesc
+	params.Filter = entities.BookFilter{Query: text}
+	limit := params.Limit + 1
+
+	// book_search_config() is the config the search_vector of book is written with
+	found := r.builder.Select(bookColumns...).
+		Column("ts_rank_cd(b.search_vector, q.query) AS rank").
+		From("book b").
+		JoinClause("CROSS JOIN websearch_to_tsquery(book_search_config(), ?) AS q(query)", text).
+		Where("b.search_vector @@ q.query").
+		Where(sq.Eq{"b.removed": false})
+
+	query := r.builder.Select("found.*").
+		Column(sq.Expr(
+			"ts_headline(book_search_config(), found.title || ' ' || COALESCE(found.description, ''), websearch_to_tsquery(book_search_config(), ?), ?) AS snippet",
+			text, searchHeadlineOptions,
+		)).
+		FromSelect(found, "found")
+	query = cursorBuilder(query, params)
+	query = orderByBuilder(query, params).Limit(limit)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.Search: error builder")
+	}
+
+	rows, err := r.querier.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "queryxContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.Search: error query")
+	}
+	defer rows.Close()
+
+	results := make([]entities.BookSearchResult, 0, limit)
+	for rows.Next() {
+		var result entities.BookSearchResult
+		err = rows.StructScan(&result)
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+			return nil, errs.Wrap(err, "bookPostgres.Search: error scan")
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "iteration.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.Search: iteration rows")
+	}
+
+	paginatable := make([]entities.Paginatable, len(results))
+	for i := range results {
+		paginatable[i] = results[i]
+	}
+
+	pageInfo, err := r.service.CreateForwardPageInfo(paginatable, params)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "createPageInfo.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.Search: error createPageInfo")
+	}
+
+	if uint64(len(results)) > params.Limit {
+		results = results[:params.Limit]
+	}
+
+	books := make([]entities.Book, len(results))
+	for i := range results {
+		books[i] = results[i].Book
+	}
+
+	err = r.attachAuthors(ctx, books)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "authors.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.Search")
+	}
+
+	err = r.attachGenres(ctx, books)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "genres.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.Search")
+	}
+
+	for i := range results {
+		results[i].Book = books[i]
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "books.count", Value: len(results)}})
+
+	success = true
+	return &entities.ResponseBookSearch{
+		Data:     results,
+		PageInfo: pageInfo,
+	}, nil
+}
+
+// Update - Updates the listed fields of a not removed book and returns its new state,
+// book.Version > 0 updates the book only when it is at the version
+func (r *bookRepository) Update(ctx context.Context, book entities.Book, fields []entities.BookField) (entities.Book, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRepository.update")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "update", "book", duration, success)
+	}()
+
+	data := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		if !field.IsColumn() {
+			continue
+		}
+
+		value, err := book.GetFieldValue(field)
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes([]observability.Attribute{{Key: "field.failed", Value: true}})
+
+			return entities.Book{}, errs.Wrap(err, "bookPostgres.Update: error field")
+		}
+		data[string(field)] = value
+	}
+
+	where := sq.And{sq.Eq{"id": book.ID}, sq.Eq{"removed": false}}
+	if book.Version > 0 {
+		where = append(where, sq.Eq{"version": book.Version})
+	}
+
+	query, args, err := r.builder.Update("book").
+		SetMap(data).
+		Set("updated_at", time.Now().UTC()).
+		Set("version", sq.Expr("version + 1")).
+		Where(where).
+		Suffix(bookReturning).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return entities.Book{}, errs.Wrap(err, "bookPostgres.Update: error builder")
+	}
+
+	var updated entities.Book
+	err = r.querier.QueryRowxContext(ctx, query, args...).StructScan(&updated)
+	if err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetAttributes([]observability.Attribute{{Key: "len.book.zero", Value: true}})
+
+			if book.Version > 0 {
+				return entities.Book{}, errs.Wrap(r.versionError(ctx, book.ID, book.Version), "bookPostgres.Update")
+			}
+
+			return entities.Book{}, errs.Wrap(errs.ErrNotFound, fmt.Sprintf("bookPostgres.Update: book %d", book.ID))
+		}
+
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return entities.Book{}, errs.Wrap(bookConstraintError(err), "bookPostgres.Update: error scanning")
+	}
+
+	success = true
+	return updated, nil
+}
+
+// Remove - Sets the field removed to true for not removed books,
+// expectedVersion > 0 removes the only book when it is at the version
+func (r *bookRepository) Remove(ctx context.Context, IDs []int64, expectedVersion int64) error {
 	var success bool
 	start := time.Now()
 	ctx, span := r.observ.StartSpan(ctx, "bookRepository.remove")
@@ -236,10 +692,22 @@ func (r *bookRepository) Remove(ctx context.Context, IDs []int64) error {
 		r.observ.RecordDatabaseQuery(ctx, "delete", "book", duration, success)
 	}()
 
+	if expectedVersion > 0 && len(IDs) != 1 {
+		span.SetAttributes([]observability.Attribute{{Key: "expectedVersion.failed", Value: true}})
+
+		return errs.Wrap(errs.ErrInvalidInput, fmt.Sprintf("bookPostgres.Remove: expected version needs one book, got %d", len(IDs)))
+	}
+
+	where := sq.And{sq.Eq{"id": IDs}, sq.Eq{"removed": false}}
+	if expectedVersion > 0 {
+		where = append(where, sq.Eq{"version": expectedVersion})
+	}
+
 	query, args, err := r.builder.Update("book").
-		Where(sq.Eq{"id": IDs}).
+		Where(where).
 		Set("removed", true).
 		Set("updated_at", time.Now().UTC()).
+		Set("version", sq.Expr("version + 1")).
 		ToSql()
 
 	if err != nil {
@@ -268,9 +736,359 @@ func (r *bookRepository) Remove(ctx context.Context, IDs []int64) error {
 	if rowsAffected != int64(len(IDs)) {
 		span.SetAttributes([]observability.Attribute{{Key: "len.book.noEqual.failed", Value: true}})
 
+		if expectedVersion > 0 {
+			return errs.Wrap(r.versionError(ctx, IDs[0], expectedVersion), "bookPostgres.Remove")
+		}
+
 		return errs.Wrap(errs.ErrNotFound, fmt.Sprintf("bookPostgres.Remove: expected rowsAffected %d, actual %d", len(IDs), rowsAffected))
 	}
 
 	success = true
 	return nil
 }
+
+// Restore - Sets the field removed to false for removed books,
+// expectedVersion > 0 restores the only book when it is at the version
+func (r *bookRepository) Restore(ctx context.Context, IDs []int64, expectedVersion int64) error {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRepository.restore")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "update", "book", duration, success)
+	}()
+
+	if expectedVersion > 0 && len(IDs) != 1 {
+		span.SetAttributes([]observability.Attribute{{Key: "expectedVersion.failed", Value: true}})
+
+		return errs.Wrap(errs.ErrInvalidInput, fmt.Sprintf("bookPostgres.Restore: expected version needs one book, got %d", len(IDs)))
+	}
+
+	where := sq.And{sq.Eq{"id": IDs}, sq.Eq{"removed": true}}
+	if expectedVersion > 0 {
+		where = append(where, sq.Eq{"version": expectedVersion})
+	}
+
+	query, args, err := r.builder.Update("book").
+		Where(where).
+		Set("removed", false).
+		Set("updated_at", time.Now().UTC()).
+		Set("version", sq.Expr("version + 1")).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "bookPostgres.Restore: error builder")
+	}
+
+	res, err := r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(bookConstraintError(err), "bookPostgres.Restore: error query")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "rowsAffected.failed", Value: true}})
+
+		return errs.Wrap(err, "bookPostgres.Restore: error get affected rows")
+	}
+
+	if rowsAffected != int64(len(IDs)) {
+		span.SetAttributes([]observability.Attribute{{Key: "len.book.noEqual.failed", Value: true}})
+
+		if expectedVersion > 0 {
+			return errs.Wrap(r.versionError(ctx, IDs[0], expectedVersion), "bookPostgres.Restore")
+		}
+
+		return errs.Wrap(errs.ErrNotFound, fmt.Sprintf("bookPostgres.Restore: expected rowsAffected %d, actual %d", len(IDs), rowsAffected))
+	}
+
+	success = true
+	return nil
+}
+
+// CountPurgeable - Returns count of books removed before removedBefore
+func (r *bookRepository) CountPurgeable(ctx context.Context, removedBefore time.Time) (int64, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRepository.countPurgeable")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "book", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("COUNT(*)").
+		From("book").
+		Where(sq.And{sq.Eq{"removed": true}, sq.Lt{"updated_at": removedBefore}}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "bookPostgres.CountPurgeable: error builder")
+	}
+
+	var count int64
+	err = r.querier.QueryRowxContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "bookPostgres.CountPurgeable: error scanning")
+	}
+
+	success = true
+	return count, nil
+}
+
+// LockPurgeable - Locks up to limit books removed before removedBefore till the end of the transaction and returns their IDs,
+// the books locked by another purge are skipped
+func (r *bookRepository) LockPurgeable(ctx context.Context, removedBefore time.Time, limit uint64) ([]int64, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRepository.lockPurgeable")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "book", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("id").
+		From("book").
+		Where(sq.And{sq.Eq{"removed": true}, sq.Lt{"updated_at": removedBefore}}).
+		OrderBy("id").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.LockPurgeable: error builder")
+	}
+
+	IDs := make([]int64, 0, limit)
+	err = sqlx.SelectContext(ctx, r.querier, &IDs, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "selectContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookPostgres.LockPurgeable: error query")
+	}
+
+	success = true
+	return IDs, nil
+}
+
+// Purge - Deletes the removed books by IDs
+func (r *bookRepository) Purge(ctx context.Context, IDs []int64) error {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRepository.purge")
+	span.SetAttributes([]observability.Attribute{{Key: "book.ids", Value: IDs}})
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "delete", "book", duration, success)
+	}()
+
+	query, args, err := r.builder.Delete("book").
+		Where(sq.And{sq.Eq{"id": IDs}, sq.Eq{"removed": true}}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "bookPostgres.Purge: error builder")
+	}
+
+	res, err := r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(err, "bookPostgres.Purge: error query")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "rowsAffected.failed", Value: true}})
+
+		return errs.Wrap(err, "bookPostgres.Purge: error get affected rows")
+	}
+
+	if rowsAffected != int64(len(IDs)) {
+		span.SetAttributes([]observability.Attribute{{Key: "len.book.noEqual.failed", Value: true}})
+
+		return errs.Wrap(errs.ErrNotFound, fmt.Sprintf("bookPostgres.Purge: removed books %v", IDs))
+	}
+
+	success = true
+	return nil
+}
+
+// attachAuthors - sets authors of the books
+func (r *bookRepository) attachAuthors(ctx context.Context, books []entities.Book) error {
+	if len(books) == 0 {
+		return nil
+	}
+
+	IDs := make([]int64, 0, len(books))
+	for _, book := range books {
+		IDs = append(IDs, book.ID)
+	}
+
+	authors, err := NewAuthorRepository(r.querier, r.builder, r.observ).GetByBookIDs(ctx, IDs)
+	if err != nil {
+		return errs.Wrap(err, "error authors")
+	}
+
+	for i := range books {
+		books[i].Authors = authors[books[i].ID]
+	}
+
+	return nil
+}
+
+// attachBookAuthors - sets authors of the books, the authors stored in their versions when asOf is set
+func (r *bookRepository) attachBookAuthors(ctx context.Context, books []entities.Book, asOf time.Time) error {
+	if asOf.IsZero() {
+		return r.attachAuthors(ctx, books)
+	}
+
+	return r.attachAuthorsAsOf(ctx, books)
+}
+
+// attachAuthorsAsOf - sets authors of the books from book_history, the version of every book is the one read at as_of.
+// The author names are the current ones
+func (r *bookRepository) attachAuthorsAsOf(ctx context.Context, books []entities.Book) error {
+	if len(books) == 0 {
+		return nil
+	}
+
+	versions := make(sq.Or, 0, len(books))
+	for _, book := range books {
+		versions = append(versions, sq.Eq{"h.book_id": book.ID, "h.version": book.Version})
+	}
+
+	query, args, err := r.builder.Select("h.book_id", "a.id", "a.name", "a.created_at", "a.updated_at").
+		From("book_history h").
+		JoinClause("CROSS JOIN LATERAL jsonb_array_elements_text(h.author_ids) WITH ORDINALITY AS ha(author_id, position)").
+		Join("authors a ON a.id = ha.author_id::bigint").
+		Where(versions).
+		OrderBy("h.book_id", "ha.position").
+		ToSql()
+	if err != nil {
+		return errs.Wrap(err, "error authors: error builder")
+	}
+
+	rows, err := r.querier.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return errs.Wrap(err, "error authors: error query")
+	}
+	defer rows.Close()
+
+	authors := make(map[int64][]entities.Author, len(books))
+	for rows.Next() {
+		var bookAuthor entities.BookAuthor
+		err = rows.StructScan(&bookAuthor)
+		if err != nil {
+			return errs.Wrap(err, "error authors: error scan")
+		}
+		authors[bookAuthor.BookID] = append(authors[bookAuthor.BookID], bookAuthor.Author)
+	}
+
+	if err := rows.Err(); err != nil {
+		return errs.Wrap(err, "error authors: iteration rows")
+	}
+
+	for i := range books {
+		books[i].Authors = authors[books[i].ID]
+	}
+
+	return nil
+}
+
+// attachGenres - sets genre names of the books
+func (r *bookRepository) attachGenres(ctx context.Context, books []entities.Book) error {
+	IDs := make([]int64, 0, len(books))
+	for _, book := range books {
+		if book.GenreID > 0 && !slices.Contains(IDs, book.GenreID) {
+			IDs = append(IDs, book.GenreID)
+		}
+	}
+
+	if len(IDs) == 0 {
+		return nil
+	}
+
+	genres, err := NewGenreRepository(r.querier, r.builder, r.observ).GetByIDs(ctx, IDs)
+	if err != nil {
+		return errs.Wrap(err, "error genres")
+	}
+
+	names := make(map[int64]string, len(genres))
+	for _, genre := range genres {
+		names[genre.ID] = genre.Name
+	}
+
+	for i := range books {
+		books[i].Genre = names[books[i].GenreID]
+	}
+
+	return nil
+}
+
+// versionError - explains why the write guarded by the expected version changed nothing:
+// the book does not exist, it is at another version or it is in another state
+func (r *bookRepository) versionError(ctx context.Context, id int64, expectedVersion int64) error {
+	query, args, err := r.builder.Select("version").From("book").Where(sq.Eq{"id": id}).ToSql()
+	if err != nil {
+		return errs.Wrap(err, "error builder version")
+	}
+
+	var version int64
+	err = r.querier.QueryRowxContext(ctx, query, args...).Scan(&version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errs.Wrap(errs.ErrNotFound, fmt.Sprintf("book %d", id))
+		}
+
+		return errs.Wrap(err, "error scanning version")
+	}
+
+	if version != expectedVersion {
+		return errs.Wrap(errs.ErrVersionMismatch, fmt.Sprintf("book %d is at version %d, expected %d", id, version, expectedVersion))
+	}
+
+	return errs.Wrap(errs.ErrNotFound, fmt.Sprintf("book %d", id))
+}
+
+// bookConstraintError - maps violations of the book constraints to domain errors
+func bookConstraintError(err error) error {
+	switch {
+	case hasPgCode(err, pgCodeUniqueViolation):
+		return errs.Wrap(errs.ErrAlreadyExists, "book isbn")
+	case hasPgCode(err, pgCodeForeignKeyViolation):
+		return errs.Wrap(errs.ErrInvalidInput, "book genre not found")
+	default:
+		return err
+	}
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -70,12 +71,79 @@ func (r *bookEventRepository) Create(ctx context.Context, bookEvent entities.Boo
 	return id, nil
 }
 
-// Lock - Sets status lock
-func (r *bookEventRepository) Lock(ctx context.Context, batchSize uint64) ([]entities.BookEvent, error) {
+// CreateBatch - Adds rows with one multi-row insert and returns their IDs in the same order
+func (r *bookEventRepository) CreateBatch(ctx context.Context, bookEvents []entities.BookEvent) ([]int64, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookEventRepository.createBatch")
+	span.SetAttributes([]observability.Attribute{{Key: "bookEvents.count", Value: len(bookEvents)}})
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "insert", "book_event", duration, success)
+	}()
+
+	builder := r.builder.Insert("book_event").Columns("book_id", "type", "status", "payload")
+	for _, bookEvent := range bookEvents {
+		builder = builder.Values(bookEvent.BookId, bookEvent.Type, bookEvent.Status, bookEvent.Payload)
+	}
+
+	query, args, err := builder.Suffix("RETURNING id").ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errors.Wrap(err, "bookEventPostgres.CreateBatch: building query")
+	}
+
+	rows, err := r.querier.QueryxContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "queryxContext.failed", Value: true}})
+
+		return nil, errors.Wrap(err, "bookEventPostgres.CreateBatch: executing query")
+	}
+	defer rows.Close()
+
+	IDs := make([]int64, 0, len(bookEvents))
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			span.RecordError(err)
+			span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+			return nil, errors.Wrap(err, "bookEventPostgres.CreateBatch: scanning query")
+		}
+		IDs = append(IDs, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "iteration.failed", Value: true}})
+
+		return nil, errors.Wrap(err, "bookEventPostgres.CreateBatch: iteration rows")
+	}
+
+	success = true
+	return IDs, nil
+}
+
+// lockedBookEvent - the row locked by Lock, reclaimed - it was locked by another owner with an expired lease
+type lockedBookEvent struct {
+	entities.BookEvent
+	Reclaimed bool `db:"reclaimed"`
+}
+
+// Lock - Sets status lock for owner till the lease ends, the rows with an expired lease are locked again
+func (r *bookEventRepository) Lock(ctx context.Context, batchSize uint64, owner string, lease time.Duration) ([]entities.BookEvent, error) {
 	var success bool
 	start := time.Now()
 	ctx, span := r.observ.StartSpan(ctx, "bookEventRepository.look")
-	span.SetAttributes([]observability.Attribute{{Key: "batchSize", Value: batchSize}})
+	span.SetAttributes([]observability.Attribute{
+		{Key: "batchSize", Value: batchSize},
+		{Key: "owner", Value: owner},
+	})
 
 	defer span.End()
 
@@ -84,10 +152,24 @@ func (r *bookEventRepository) Lock(ctx context.Context, batchSize uint64) ([]ent
 		r.observ.RecordDatabaseQuery(ctx, "update", "book_event", duration, success)
 	}()
 
-	// 1. SELECT с блокировкой
-	lockQuery := r.builder.Select("id").
+	// 1. SELECT с блокировкой, the failed events wait for their next attempt, the dead ones are skipped.
+	// Only the oldest pending event of the book is locked, so the events of one book are published one by one in order.
+	lockQuery := r.builder.Select("id", "status").
 		From("book_event").
-		Where(sq.NotEq{"status": entities.EventStatusLock}).
+		Where(sq.Or{
+			sq.And{
+				sq.Eq{"status": []entities.EventStatus{entities.EventStatusNew, entities.EventStatusUnlock}},
+				sq.Expr("next_attempt_at <= NOW()"),
+			},
+			sq.And{
+				sq.Eq{"status": entities.EventStatusLock},
+				sq.Expr("locked_until <= NOW()"),
+			},
+		}).
+		Where(sq.Expr(
+			"NOT EXISTS (SELECT 1 FROM book_event AS earlier WHERE earlier.book_id = book_event.book_id AND earlier.id < book_event.id AND earlier.status IN (?,?,?))",
+			entities.EventStatusNew, entities.EventStatusLock, entities.EventStatusUnlock,
+		)).
 		OrderBy("id ASC").
 		Limit(batchSize).
 		Suffix("FOR UPDATE SKIP LOCKED")
@@ -102,14 +184,17 @@ func (r *bookEventRepository) Lock(ctx context.Context, batchSize uint64) ([]ent
 	}
 
 	// 2. Ручной CTE запрос
-	query := `
-        WITH locked_event AS (` + lockSQL + `)
+	n := len(lockArgs)
+	query := fmt.Sprintf(`
+        WITH locked_event AS (%s)
         UPDATE book_event 
-        SET status = $2
-        WHERE id IN (SELECT id FROM locked_event)
-        RETURNING id, book_id, type, payload
-    `
-	args := append([]any{entities.EventStatusLock}, lockArgs...)
+        SET status = $%d, locked_by = $%d, locked_until = NOW() + make_interval(secs => $%d)
+        FROM locked_event
+        WHERE book_event.id = locked_event.id
+        RETURNING book_event.id, book_event.book_id, book_event.type, book_event.payload, book_event.attempts,
+            book_event.locked_until, book_event.locked_by, locked_event.status = $%d AS reclaimed
+    `, lockSQL, n+1, n+2, n+3, n+4)
+	args := append(lockArgs, entities.EventStatusLock, owner, lease.Seconds(), entities.EventStatusLock)
 	rows, err := r.querier.QueryxContext(ctx, query, args...)
 	if err != nil {
 		span.RecordError(err)
@@ -120,8 +205,9 @@ func (r *bookEventRepository) Lock(ctx context.Context, batchSize uint64) ([]ent
 	defer rows.Close()
 
 	events := make([]entities.BookEvent, 0, batchSize)
+	var reclaimed int
 	for rows.Next() {
-		var event entities.BookEvent
+		var event lockedBookEvent
 		err = rows.StructScan(&event)
 		if err != nil {
 			span.RecordError(err)
@@ -129,7 +215,11 @@ func (r *bookEventRepository) Lock(ctx context.Context, batchSize uint64) ([]ent
 
 			return nil, errors.Wrap(err, "bookEventPostgres.Lock: scanning row")
 		}
-		events = append(events, event)
+
+		if event.Reclaimed {
+			reclaimed++
+		}
+		events = append(events, event.BookEvent)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -140,6 +230,11 @@ func (r *bookEventRepository) Lock(ctx context.Context, batchSize uint64) ([]ent
 	}
 
 	success = true
+	if reclaimed > 0 {
+		span.SetAttributes([]observability.Attribute{{Key: "bookEvent.reclaimed", Value: reclaimed}})
+		r.observ.RecordEventsReclaimed(ctx, reclaimed)
+	}
+
 	if len(events) == 0 {
 		span.SetAttributes([]observability.Attribute{{Key: "len.bookEvent.zero.failed", Value: true}})
 
@@ -149,8 +244,8 @@ func (r *bookEventRepository) Lock(ctx context.Context, batchSize uint64) ([]ent
 	return events, nil
 }
 
-// Unlock - Sets the unlock status for locked rows.
-func (r *bookEventRepository) Unlock(ctx context.Context, eventIDs []int64) error {
+// Unlock - Sets the unlock status for the rows locked by owner.
+func (r *bookEventRepository) Unlock(ctx context.Context, eventIDs []int64, owner string) error {
 	var success bool
 	start := time.Now()
 	ctx, span := r.observ.StartSpan(ctx, "bookEventRepository.unlook")
@@ -165,7 +260,9 @@ func (r *bookEventRepository) Unlock(ctx context.Context, eventIDs []int64) erro
 
 	query, args, err := r.builder.Update("book_event").
 		Set("status", entities.EventStatusUnlock).
-		Where(sq.And{sq.Eq{"id": eventIDs}, sq.Eq{"status": entities.EventStatusLock}}).
+		Set("locked_until", nil).
+		Set("locked_by", "").
+		Where(lockedBy(eventIDs, owner)).
 		ToSql()
 
 	if err != nil {
@@ -194,15 +291,86 @@ func (r *bookEventRepository) Unlock(ctx context.Context, eventIDs []int64) erro
 	if rowsAffected != int64(len(eventIDs)) {
 		span.SetAttributes([]observability.Attribute{{Key: "len.bookEvent.noEqual.failed", Value: true}})
 
-		return errors.New(fmt.Sprintf("bookEventPostgres.Unlock: expected rowsAffected %d, actual %d", len(eventIDs), rowsAffected))
+		return errors.Wrap(errors.ErrNotFound, fmt.Sprintf("bookEventPostgres.Unlock: expected rowsAffected %d, actual %d", len(eventIDs), rowsAffected))
 	}
 
 	success = true
 	return nil
 }
 
-// Remove - Removes locked rows.
-func (r *bookEventRepository) Remove(ctx context.Context, eventIDs []int64) error {
+// Retry - Returns the row locked by owner to the unlock status after a failed attempt, it is locked again after delay
+func (r *bookEventRepository) Retry(ctx context.Context, eventID int64, owner string, delay time.Duration, lastError string) error {
+	nextAttemptAt := sq.Expr("NOW() + make_interval(secs => ?)", delay.Seconds())
+
+	return r.fail(ctx, "Retry", eventID, owner, entities.EventStatusUnlock, nextAttemptAt, lastError)
+}
+
+// Bury - Moves the row locked by owner to the dead status after its last failed attempt
+func (r *bookEventRepository) Bury(ctx context.Context, eventID int64, owner string, lastError string) error {
+	return r.fail(ctx, "Bury", eventID, owner, entities.EventStatusDead, sq.Expr("NOW()"), lastError)
+}
+
+// fail - Counts the failed attempt of the row locked by owner and sets its status
+func (r *bookEventRepository) fail(ctx context.Context, method string, eventID int64, owner string, status entities.EventStatus, nextAttemptAt sq.Sqlizer, lastError string) error {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookEventRepository."+strings.ToLower(method))
+	span.SetAttributes([]observability.Attribute{
+		{Key: "eventID", Value: eventID},
+		{Key: "status", Value: int(status)},
+	})
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "update", "book_event", duration, success)
+	}()
+
+	query, args, err := r.builder.Update("book_event").
+		Set("status", status).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("next_attempt_at", nextAttemptAt).
+		Set("last_error", lastError).
+		Set("locked_until", nil).
+		Set("locked_by", "").
+		Where(lockedBy([]int64{eventID}, owner)).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errors.Wrap(err, "bookEventPostgres."+method+": building query")
+	}
+
+	res, err := r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errors.Wrap(err, "bookEventPostgres."+method+": executing query")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "rowsAffected.failed", Value: true}})
+
+		return errors.Wrap(err, "bookEventPostgres."+method+": getting rows affected")
+	}
+
+	if rowsAffected != 1 {
+		span.SetAttributes([]observability.Attribute{{Key: "len.bookEvent.noEqual.failed", Value: true}})
+
+		return errors.Wrap(errors.ErrNotFound, fmt.Sprintf("bookEventPostgres.%s: locked event %d", method, eventID))
+	}
+
+	success = true
+	return nil
+}
+
+// Remove - Removes the rows locked by owner.
+func (r *bookEventRepository) Remove(ctx context.Context, eventIDs []int64, owner string) error {
 	var success bool
 	start := time.Now()
 	ctx, span := r.observ.StartSpan(ctx, "bookEventRepository.remove")
@@ -216,7 +384,7 @@ func (r *bookEventRepository) Remove(ctx context.Context, eventIDs []int64) erro
 	}()
 
 	query, args, err := r.builder.Delete("book_event").
-		Where(sq.And{sq.Eq{"id": eventIDs}, sq.Eq{"status": entities.EventStatusLock}}).
+		Where(lockedBy(eventIDs, owner)).
 		ToSql()
 	if err != nil {
 		span.RecordError(err)
@@ -244,9 +412,14 @@ func (r *bookEventRepository) Remove(ctx context.Context, eventIDs []int64) erro
 	if rowsAffected != int64(len(eventIDs)) {
 		span.SetAttributes([]observability.Attribute{{Key: "len.bookEvent.noEqual.failed", Value: true}})
 
-		return errors.New(fmt.Sprintf("bookEventPostgres.Remove: expected rowsAffected %d, actual %d", len(eventIDs), rowsAffected))
+		return errors.Wrap(errors.ErrNotFound, fmt.Sprintf("bookEventPostgres.Remove: expected rowsAffected %d, actual %d", len(eventIDs), rowsAffected))
 	}
 
 	success = true
 	return nil
 }
+
+// lockedBy - the condition of the rows locked by owner, the rows reclaimed by another owner do not match
+func lockedBy(eventIDs []int64, owner string) sq.And {
+	return sq.And{sq.Eq{"id": eventIDs}, sq.Eq{"status": entities.EventStatusLock}, sq.Eq{"locked_by": owner}}
+}
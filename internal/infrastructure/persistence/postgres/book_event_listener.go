@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+// BookEventChannel - the channel notified by the book_event_notify trigger
+const BookEventChannel = "book_event"
+
+const _defaultReconnectDelay = 5 * time.Second
+
+// listenConn - the part of *pgx.Conn used by the listener
+type listenConn interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	WaitForNotification(ctx context.Context) (*pgconn.Notification, error)
+	Close(ctx context.Context) error
+}
+
+type bookEventListener struct {
+	connect        func(ctx context.Context) (listenConn, error)
+	reconnectDelay time.Duration
+
+	logger observability.Logger
+}
+
+// NewBookEventListener - Constructor BookEventListener, connect opens the dedicated connection holding LISTEN
+func NewBookEventListener(connect func(ctx context.Context) (*pgx.Conn, error), logger observability.Logger) repositories.BookEventListener {
+	return &bookEventListener{
+		connect: func(ctx context.Context) (listenConn, error) {
+			return connect(ctx)
+		},
+		reconnectDelay: _defaultReconnectDelay,
+		logger:         logger,
+	}
+}
+
+// Listen - Listens to the book_event channel on its own connection, the connection is opened again after it is lost.
+// The notifications received before the value is read are merged into one.
+func (l *bookEventListener) Listen(ctx context.Context) <-chan struct{} {
+	wake := make(chan struct{}, 1)
+
+	go func() {
+		defer close(wake)
+
+		for {
+			err := l.listen(ctx, wake)
+			if ctx.Err() != nil {
+				return
+			}
+
+			l.logger.Error("bookEventListenerPostgres.Listen: connection lost", map[string]any{
+				"error":     err.Error(),
+				"reconnect": l.reconnectDelay.String(),
+			})
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(l.reconnectDelay):
+			}
+		}
+	}()
+
+	return wake
+}
+
+// listen - holds one connection until it fails or the context is done
+func (l *bookEventListener) listen(ctx context.Context, wake chan<- struct{}) error {
+	conn, err := l.connect(ctx)
+	if err != nil {
+		return errors.Wrap(err, "bookEventListenerPostgres.listen: connect")
+	}
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{BookEventChannel}.Sanitize())
+	if err != nil {
+		return errors.Wrap(err, "bookEventListenerPostgres.listen: listen")
+	}
+
+	// the events committed while nobody listened are picked up at once
+	notify(wake)
+
+	for {
+		_, err = conn.WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrap(err, "bookEventListenerPostgres.listen: wait for notification")
+		}
+
+		notify(wake)
+	}
+}
+
+// notify - sends the value unless one is already waiting
+func notify(wake chan<- struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
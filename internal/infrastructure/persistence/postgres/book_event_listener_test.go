@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/mocks"
+)
+
+type fakeListenConn struct {
+	execs         []string
+	execErr       error
+	notifications chan error
+	closed        bool
+}
+
+func (c *fakeListenConn) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	c.execs = append(c.execs, sql)
+
+	return pgconn.CommandTag{}, c.execErr
+}
+
+func (c *fakeListenConn) WaitForNotification(ctx context.Context) (*pgconn.Notification, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case err := <-c.notifications:
+		if err != nil {
+			return nil, err
+		}
+
+		return &pgconn.Notification{Channel: BookEventChannel}, nil
+	}
+}
+
+func (c *fakeListenConn) Close(ctx context.Context) error {
+	c.closed = true
+
+	return nil
+}
+
+func receive(t *testing.T, wake <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case _, ok := <-wake:
+		require.True(t, ok, "wake is closed")
+	case <-time.After(time.Second):
+		t.Fatal("no wake")
+	}
+}
+
+func TestBookEventListener_Listen_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocks.NewMockLogger(ctrl)
+	conn := &fakeListenConn{notifications: make(chan error)}
+	listener := &bookEventListener{
+		connect:        func(ctx context.Context) (listenConn, error) { return conn, nil },
+		reconnectDelay: time.Millisecond,
+		logger:         logger,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	wake := listener.Listen(ctx)
+
+	// the wake after LISTEN
+	receive(t, wake)
+
+	conn.notifications <- nil
+	receive(t, wake)
+
+	cancel()
+	_, ok := <-wake
+	assert.False(t, ok)
+	assert.Equal(t, []string{`LISTEN "book_event"`}, conn.execs)
+	assert.True(t, conn.closed)
+}
+
+func TestBookEventListener_Listen_Reconnect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocks.NewMockLogger(ctrl)
+	lost := &fakeListenConn{execErr: errors.New("conn closed")}
+	conn := &fakeListenConn{notifications: make(chan error)}
+	var connects int
+	listener := &bookEventListener{
+		connect: func(ctx context.Context) (listenConn, error) {
+			connects++
+			switch connects {
+			case 1:
+				return lost, nil
+			case 2:
+				return nil, errors.New("connection refused")
+			default:
+				return conn, nil
+			}
+		},
+		reconnectDelay: time.Millisecond,
+		logger:         logger,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	//Log - listen failed, connect failed
+	logger.EXPECT().Error("bookEventListenerPostgres.Listen: connection lost", gomock.Any()).Times(2)
+
+	wake := listener.Listen(ctx)
+
+	receive(t, wake)
+
+	assert.True(t, lost.closed)
+	assert.Equal(t, []string{`LISTEN "book_event"`}, conn.execs)
+}
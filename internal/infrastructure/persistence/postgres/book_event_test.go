@@ -102,7 +102,7 @@ func TestBookEvent_Lock_ErrorExecQury(t *testing.T) {
 		WithArgs(entities.EventStatusLock, 2, 2).
 		WillReturnError(sql.ErrNoRows)
 
-	_, err = repo.Lock(ctx, 2)
+	_, err = repo.Lock(ctx, 2, "outbox/1", time.Minute)
 
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "bookEventPostgres.Lock: executing query")
@@ -121,16 +121,22 @@ func TestBookEvent_Lock_ErrorNoRows(t *testing.T) {
 	ctx := context.Background()
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		WITH locked_event AS (SELECT id FROM book_event WHERE status <> $1 ORDER BY id ASC LIMIT 2 FOR UPDATE SKIP LOCKED)
+		WITH locked_event AS (SELECT id, status FROM book_event WHERE ((status IN ($1,$2) AND next_attempt_at <= NOW()) OR (status = $3 AND locked_until <= NOW())) AND
+			NOT EXISTS (SELECT 1 FROM book_event AS earlier WHERE earlier.book_id = book_event.book_id AND earlier.id < book_event.id AND earlier.status IN ($4,$5,$6))
+			ORDER BY id ASC LIMIT 2 FOR UPDATE SKIP LOCKED)
 		UPDATE book_event 
-		SET status = $2
-		WHERE id IN (SELECT id FROM locked_event)
-		RETURNING id, book_id, type, payload
+		SET status = $7, locked_by = $8, locked_until = NOW() + make_interval(secs => $9)
+		FROM locked_event
+		WHERE book_event.id = locked_event.id
+		RETURNING book_event.id, book_event.book_id, book_event.type, book_event.payload, book_event.attempts,
+			book_event.locked_until, book_event.locked_by, locked_event.status = $10 AS reclaimed
 	`)).
-		WithArgs(entities.EventStatusLock, 2).
+		WithArgs(entities.EventStatusNew, entities.EventStatusUnlock, entities.EventStatusLock,
+			entities.EventStatusNew, entities.EventStatusLock, entities.EventStatusUnlock,
+			entities.EventStatusLock, "outbox/1", float64(60), entities.EventStatusLock).
 		WillReturnError(sql.ErrNoRows)
 
-	_, err = repo.Lock(ctx, 2)
+	_, err = repo.Lock(ctx, 2, "outbox/1", time.Minute)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.NotNil(t, err)
@@ -154,19 +160,25 @@ func TestBookEvent_Lock_ErrorScanQuery(t *testing.T) {
 	}
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		WITH locked_event AS (SELECT id FROM book_event WHERE status <> $1 ORDER BY id ASC LIMIT 2 FOR UPDATE SKIP LOCKED)
+		WITH locked_event AS (SELECT id, status FROM book_event WHERE ((status IN ($1,$2) AND next_attempt_at <= NOW()) OR (status = $3 AND locked_until <= NOW())) AND
+			NOT EXISTS (SELECT 1 FROM book_event AS earlier WHERE earlier.book_id = book_event.book_id AND earlier.id < book_event.id AND earlier.status IN ($4,$5,$6))
+			ORDER BY id ASC LIMIT 2 FOR UPDATE SKIP LOCKED)
 		UPDATE book_event 
-		SET status = $2
-		WHERE id IN (SELECT id FROM locked_event)
-		RETURNING id, book_id, type, payload
+		SET status = $7, locked_by = $8, locked_until = NOW() + make_interval(secs => $9)
+		FROM locked_event
+		WHERE book_event.id = locked_event.id
+		RETURNING book_event.id, book_event.book_id, book_event.type, book_event.payload, book_event.attempts,
+			book_event.locked_until, book_event.locked_by, locked_event.status = $10 AS reclaimed
 	`)).
-		WithArgs(entities.EventStatusLock, 2).
+		WithArgs(entities.EventStatusNew, entities.EventStatusUnlock, entities.EventStatusLock,
+			entities.EventStatusNew, entities.EventStatusLock, entities.EventStatusUnlock,
+			entities.EventStatusLock, "outbox/1", float64(60), entities.EventStatusLock).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"id", "book_id", "type", "status", "payload", "updated_at"}).
 				AddRow(testSlice[0]...),
 		)
 
-	_, err = repo.Lock(ctx, 2)
+	_, err = repo.Lock(ctx, 2, "outbox/1", time.Minute)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
@@ -191,13 +203,19 @@ func TestBookEvent_Lock_ErrorIteration(t *testing.T) {
 	}
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		WITH locked_event AS (SELECT id FROM book_event WHERE status <> $1 ORDER BY id ASC LIMIT 2 FOR UPDATE SKIP LOCKED)
+		WITH locked_event AS (SELECT id, status FROM book_event WHERE ((status IN ($1,$2) AND next_attempt_at <= NOW()) OR (status = $3 AND locked_until <= NOW())) AND
+			NOT EXISTS (SELECT 1 FROM book_event AS earlier WHERE earlier.book_id = book_event.book_id AND earlier.id < book_event.id AND earlier.status IN ($4,$5,$6))
+			ORDER BY id ASC LIMIT 2 FOR UPDATE SKIP LOCKED)
 		UPDATE book_event 
-		SET status = $2
-		WHERE id IN (SELECT id FROM locked_event)
-		RETURNING id, book_id, type, payload
+		SET status = $7, locked_by = $8, locked_until = NOW() + make_interval(secs => $9)
+		FROM locked_event
+		WHERE book_event.id = locked_event.id
+		RETURNING book_event.id, book_event.book_id, book_event.type, book_event.payload, book_event.attempts,
+			book_event.locked_until, book_event.locked_by, locked_event.status = $10 AS reclaimed
 	`)).
-		WithArgs(entities.EventStatusLock, 2).
+		WithArgs(entities.EventStatusNew, entities.EventStatusUnlock, entities.EventStatusLock,
+			entities.EventStatusNew, entities.EventStatusLock, entities.EventStatusUnlock,
+			entities.EventStatusLock, "outbox/1", float64(60), entities.EventStatusLock).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"id", "book_id", "type", "status", "payload", "updated_at"}).
 				AddRow(testSlice[0]...).
@@ -205,11 +223,11 @@ func TestBookEvent_Lock_ErrorIteration(t *testing.T) {
 				RowError(1, fmt.Errorf("iteration error")),
 		)
 
-	_, err = repo.Lock(ctx, 2)
+	_, err = repo.Lock(ctx, 2, "outbox/1", time.Minute)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "bookEventPostgres.Lock: errors during iteration")
+	assert.Contains(t, err.Error(), "bookEventPostgres.Lock: iteration rows")
 }
 
 func TestBookEvent_Lock_ErrorExpectLen(t *testing.T) {
@@ -225,16 +243,22 @@ func TestBookEvent_Lock_ErrorExpectLen(t *testing.T) {
 	ctx := context.Background()
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		WITH locked_event AS (SELECT id FROM book_event WHERE status <> $1 ORDER BY id ASC LIMIT 2 FOR UPDATE SKIP LOCKED)
+		WITH locked_event AS (SELECT id, status FROM book_event WHERE ((status IN ($1,$2) AND next_attempt_at <= NOW()) OR (status = $3 AND locked_until <= NOW())) AND
+			NOT EXISTS (SELECT 1 FROM book_event AS earlier WHERE earlier.book_id = book_event.book_id AND earlier.id < book_event.id AND earlier.status IN ($4,$5,$6))
+			ORDER BY id ASC LIMIT 2 FOR UPDATE SKIP LOCKED)
 		UPDATE book_event 
-		SET status = $2
-		WHERE id IN (SELECT id FROM locked_event)
-		RETURNING id, book_id, type, payload
+		SET status = $7, locked_by = $8, locked_until = NOW() + make_interval(secs => $9)
+		FROM locked_event
+		WHERE book_event.id = locked_event.id
+		RETURNING book_event.id, book_event.book_id, book_event.type, book_event.payload, book_event.attempts,
+			book_event.locked_until, book_event.locked_by, locked_event.status = $10 AS reclaimed
 	`)).
-		WithArgs(entities.EventStatusLock, 2).
+		WithArgs(entities.EventStatusNew, entities.EventStatusUnlock, entities.EventStatusLock,
+			entities.EventStatusNew, entities.EventStatusLock, entities.EventStatusUnlock,
+			entities.EventStatusLock, "outbox/1", float64(60), entities.EventStatusLock).
 		WillReturnError(errs.ErrNotFound)
 
-	_, err = repo.Lock(ctx, 2)
+	_, err = repo.Lock(ctx, 2, "outbox/1", time.Minute)
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "not found")
@@ -258,26 +282,66 @@ func TestBookEvent_Lock_Success(t *testing.T) {
 	}
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		WITH locked_event AS (SELECT id FROM book_event WHERE status <> $1 ORDER BY id ASC LIMIT 2 FOR UPDATE SKIP LOCKED)
+		WITH locked_event AS (SELECT id, status FROM book_event WHERE ((status IN ($1,$2) AND next_attempt_at <= NOW()) OR (status = $3 AND locked_until <= NOW())) AND
+			NOT EXISTS (SELECT 1 FROM book_event AS earlier WHERE earlier.book_id = book_event.book_id AND earlier.id < book_event.id AND earlier.status IN ($4,$5,$6))
+			ORDER BY id ASC LIMIT 2 FOR UPDATE SKIP LOCKED)
 		UPDATE book_event 
-		SET status = $2
-		WHERE id IN (SELECT id FROM locked_event)
-		RETURNING id, book_id, type, payload
+		SET status = $7, locked_by = $8, locked_until = NOW() + make_interval(secs => $9)
+		FROM locked_event
+		WHERE book_event.id = locked_event.id
+		RETURNING book_event.id, book_event.book_id, book_event.type, book_event.payload, book_event.attempts,
+			book_event.locked_until, book_event.locked_by, locked_event.status = $10 AS reclaimed
 	`)).
-		WithArgs(entities.EventStatusLock, 2).
+		WithArgs(entities.EventStatusNew, entities.EventStatusUnlock, entities.EventStatusLock,
+			entities.EventStatusNew, entities.EventStatusLock, entities.EventStatusUnlock,
+			entities.EventStatusLock, "outbox/1", float64(60), entities.EventStatusLock).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"id", "book_id", "type", "status", "payload", "updated_at"}).
 				AddRow(testSlice[0]...).
 				AddRow(testSlice[1]...),
 		)
 
-	models, err := repo.Lock(ctx, 2)
+	models, err := repo.Lock(ctx, 2, "outbox/1", time.Minute)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.NoError(t, err)
 	assert.Equal(t, len(testSlice), len(models))
 }
 
+func TestBookEvent_Lock_SuccessReclaimed(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookEventRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+	lockedUntil := time.Now().Add(time.Minute)
+
+	observ.EXPECT().RecordEventsReclaimed(gomock.Any(), 1).Times(1)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WITH locked_event AS (SELECT id, status FROM book_event`)).
+		WithArgs(entities.EventStatusNew, entities.EventStatusUnlock, entities.EventStatusLock,
+			entities.EventStatusNew, entities.EventStatusLock, entities.EventStatusUnlock,
+			entities.EventStatusLock, "outbox/1", float64(60), entities.EventStatusLock).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "book_id", "type", "payload", "attempts", "locked_until", "locked_by", "reclaimed"}).
+				AddRow(1, 32, entities.Created, "{}", 0, lockedUntil, "outbox/1", true).
+				AddRow(2, 82, entities.Updated, "{}", 1, lockedUntil, "outbox/1", false),
+		)
+
+	models, err := repo.Lock(ctx, 2, "outbox/1", time.Minute)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, models, 2)
+	assert.Equal(t, "outbox/1", models[0].LockedBy)
+	assert.Equal(t, int32(1), models[1].Attempts)
+}
+
 func TestBookEvent_Unlock_ErrorExecuting(t *testing.T) {
 	mockDB, mock, err := sqlmock.New()
 	require.NoError(t, err, "Error create mock")
@@ -290,11 +354,11 @@ func TestBookEvent_Unlock_ErrorExecuting(t *testing.T) {
 	repo := NewBookEventRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1 WHERE (id IN ($2,$3) AND status = $4)")).
-		WithArgs(entities.EventStatusUnlock, 1, 2, entities.EventStatusLock).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1, locked_until = $2, locked_by = $3 WHERE (id IN ($4,$5) AND status = $6 AND locked_by = $7)")).
+		WithArgs(entities.EventStatusUnlock, nil, "", 1, 2, entities.EventStatusLock, "outbox/1").
 		WillReturnError(fmt.Errorf("row error"))
 
-	err = repo.Unlock(ctx, []int64{1, 2})
+	err = repo.Unlock(ctx, []int64{1, 2}, "outbox/1")
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
@@ -323,11 +387,11 @@ func TestBookEvent_Unlock_ErrorGetRowsAffected(t *testing.T) {
 	repo := NewBookEventRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1 WHERE (id IN ($2,$3) AND status = $4)")).
-		WithArgs(entities.EventStatusUnlock, 1, 2, entities.EventStatusLock).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1, locked_until = $2, locked_by = $3 WHERE (id IN ($4,$5) AND status = $6 AND locked_by = $7)")).
+		WithArgs(entities.EventStatusUnlock, nil, "", 1, 2, entities.EventStatusLock, "outbox/1").
 		WillReturnResult(&ErrorResultBookEvent{})
 
-	err = repo.Unlock(ctx, []int64{1, 2})
+	err = repo.Unlock(ctx, []int64{1, 2}, "outbox/1")
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
@@ -346,11 +410,11 @@ func TestBookEvent_Unlock_ErrorRowsAffected(t *testing.T) {
 	repo := NewBookEventRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1 WHERE (id IN ($2,$3) AND status = $4)")).
-		WithArgs(entities.EventStatusUnlock, 1, 2, entities.EventStatusLock).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1, locked_until = $2, locked_by = $3 WHERE (id IN ($4,$5) AND status = $6 AND locked_by = $7)")).
+		WithArgs(entities.EventStatusUnlock, nil, "", 1, 2, entities.EventStatusLock, "outbox/1").
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err = repo.Unlock(ctx, []int64{1, 2})
+	err = repo.Unlock(ctx, []int64{1, 2}, "outbox/1")
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
@@ -369,16 +433,109 @@ func TestBookEvent_Unlock_Success(t *testing.T) {
 	repo := NewBookEventRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1 WHERE (id IN ($2,$3) AND status = $4)")).
-		WithArgs(entities.EventStatusUnlock, 1, 2, entities.EventStatusLock).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1, locked_until = $2, locked_by = $3 WHERE (id IN ($4,$5) AND status = $6 AND locked_by = $7)")).
+		WithArgs(entities.EventStatusUnlock, nil, "", 1, 2, entities.EventStatusLock, "outbox/1").
 		WillReturnResult(sqlmock.NewResult(1, 2))
 
-	err = repo.Unlock(ctx, []int64{1, 2})
+	err = repo.Unlock(ctx, []int64{1, 2}, "outbox/1")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestBookEvent_Retry_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookEventRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1, attempts = attempts + 1, next_attempt_at = NOW() + make_interval(secs => $2), last_error = $3, "+
+		"locked_until = $4, locked_by = $5 WHERE (id IN ($6) AND status = $7 AND locked_by = $8)")).
+		WithArgs(entities.EventStatusUnlock, float64(1.5), "kafka down", nil, "", 1, entities.EventStatusLock, "outbox/1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Retry(ctx, 1, "outbox/1", 1500*time.Millisecond, "kafka down")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestBookEvent_Retry_ErrorNotLocked(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookEventRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1, attempts = attempts + 1, next_attempt_at = NOW() + make_interval(secs => $2), last_error = $3, "+
+		"locked_until = $4, locked_by = $5 WHERE (id IN ($6) AND status = $7 AND locked_by = $8)")).
+		WithArgs(entities.EventStatusUnlock, float64(1), "kafka down", nil, "", 1, entities.EventStatusLock, "outbox/1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Retry(ctx, 1, "outbox/1", time.Second, "kafka down")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Contains(t, err.Error(), "bookEventPostgres.Retry: locked event 1")
+}
+
+func TestBookEvent_Bury_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookEventRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1, attempts = attempts + 1, next_attempt_at = NOW(), last_error = $2, "+
+		"locked_until = $3, locked_by = $4 WHERE (id IN ($5) AND status = $6 AND locked_by = $7)")).
+		WithArgs(entities.EventStatusDead, "kafka down", nil, "", 1, entities.EventStatusLock, "outbox/1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Bury(ctx, 1, "outbox/1", "kafka down")
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.NoError(t, err)
 }
 
+func TestBookEvent_Bury_ErrorExecuting(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookEventRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_event SET status = $1, attempts = attempts + 1, next_attempt_at = NOW(), last_error = $2, "+
+		"locked_until = $3, locked_by = $4 WHERE (id IN ($5) AND status = $6 AND locked_by = $7)")).
+		WithArgs(entities.EventStatusDead, "kafka down", nil, "", 1, entities.EventStatusLock, "outbox/1").
+		WillReturnError(errors.New("db error"))
+
+	err = repo.Bury(ctx, 1, "outbox/1", "kafka down")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Contains(t, err.Error(), "bookEventPostgres.Bury: executing query")
+}
+
 func TestBookEvent_Remove_ErrorExecuting(t *testing.T) {
 	mockDB, mock, err := sqlmock.New()
 	require.NoError(t, err, "Error create mock")
@@ -391,11 +548,11 @@ func TestBookEvent_Remove_ErrorExecuting(t *testing.T) {
 	repo := NewBookEventRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_event WHERE (id IN ($1,$2) AND status = $3)")).
-		WithArgs(1, 2, entities.EventStatusLock).
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_event WHERE (id IN ($1,$2) AND status = $3 AND locked_by = $4)")).
+		WithArgs(1, 2, entities.EventStatusLock, "outbox/1").
 		WillReturnError(fmt.Errorf("row error"))
 
-	err = repo.Remove(ctx, []int64{1, 2})
+	err = repo.Remove(ctx, []int64{1, 2}, "outbox/1")
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
@@ -414,11 +571,11 @@ func TestBookEvent_Remove_ErrorGetRowsAffected(t *testing.T) {
 	repo := NewBookEventRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_event WHERE (id IN ($1,$2) AND status = $3)")).
-		WithArgs(1, 2, entities.EventStatusLock).
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_event WHERE (id IN ($1,$2) AND status = $3 AND locked_by = $4)")).
+		WithArgs(1, 2, entities.EventStatusLock, "outbox/1").
 		WillReturnResult(&ErrorResultBookEvent{})
 
-	err = repo.Remove(ctx, []int64{1, 2})
+	err = repo.Remove(ctx, []int64{1, 2}, "outbox/1")
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
@@ -437,11 +594,11 @@ func TestBookEvent_Remove_ErrorRowsAffected(t *testing.T) {
 	repo := NewBookEventRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_event WHERE (id IN ($1,$2) AND status = $3)")).
-		WithArgs(1, 2, entities.EventStatusLock).
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_event WHERE (id IN ($1,$2) AND status = $3 AND locked_by = $4)")).
+		WithArgs(1, 2, entities.EventStatusLock, "outbox/1").
 		WillReturnResult(sqlmock.NewResult(1, 0))
 
-	err = repo.Remove(ctx, []int64{1, 2})
+	err = repo.Remove(ctx, []int64{1, 2}, "outbox/1")
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
@@ -460,12 +617,65 @@ func TestBookEvent_Remove_Success(t *testing.T) {
 	repo := NewBookEventRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_event WHERE (id IN ($1,$2) AND status = $3)")).
-		WithArgs(1, 2, entities.EventStatusLock).
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_event WHERE (id IN ($1,$2) AND status = $3 AND locked_by = $4)")).
+		WithArgs(1, 2, entities.EventStatusLock, "outbox/1").
 		WillReturnResult(sqlmock.NewResult(1, 2))
 
-	err = repo.Remove(ctx, []int64{1, 2})
+	err = repo.Remove(ctx, []int64{1, 2}, "outbox/1")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestBookEvent_CreateBatch_ErrorExecutingQuery(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookEventRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO book_event (book_id,type,status,payload) VALUES ($1,$2,$3,$4),($5,$6,$7,$8) RETURNING id")).
+		WithArgs(1, entities.Created, entities.EventStatusNew, []byte("{}"), 2, entities.Created, entities.EventStatusNew, []byte("{}")).
+		WillReturnError(sql.ErrConnDone)
+
+	IDs, err := repo.CreateBatch(ctx, []entities.BookEvent{
+		{BookId: 1, Type: entities.Created, Status: entities.EventStatusNew, Payload: []byte("{}")},
+		{BookId: 2, Type: entities.Created, Status: entities.EventStatusNew, Payload: []byte("{}")},
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Nil(t, IDs)
+	assert.Contains(t, err.Error(), "bookEventPostgres.CreateBatch: executing query")
+}
+
+func TestBookEvent_CreateBatch_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookEventRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO book_event (book_id,type,status,payload) VALUES ($1,$2,$3,$4),($5,$6,$7,$8) RETURNING id")).
+		WithArgs(1, entities.Created, entities.EventStatusNew, []byte("{}"), 2, entities.Created, entities.EventStatusNew, []byte("{}")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10).AddRow(11))
+
+	IDs, err := repo.CreateBatch(ctx, []entities.BookEvent{
+		{BookId: 1, Type: entities.Created, Status: entities.EventStatusNew, Payload: []byte("{}")},
+		{BookId: 2, Type: entities.Created, Status: entities.EventStatusNew, Payload: []byte("{}")},
+	})
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.NoError(t, err)
+	assert.Equal(t, []int64{10, 11}, IDs)
 }
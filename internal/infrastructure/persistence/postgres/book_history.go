@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+// bookHistoryAuthorIDs - the author IDs of the book in their order as a JSON array
+const bookHistoryAuthorIDs = "COALESCE((SELECT jsonb_agg(ba.author_id ORDER BY ba.position) FROM book_authors ba WHERE ba.book_id = book.id), '[]')"
+
+type bookHistoryRepository struct {
+	querier sqlx.ExtContext
+	builder sq.StatementBuilderType
+
+	observ observability.RepositoryObservability
+}
+
+// bookHistoryRow - the row of book_history, the authors are stored as a JSON array of IDs
+type bookHistoryRow struct {
+	entities.Book
+	Type      entities.EventType `db:"type"`
+	AuthorIDs []byte             `db:"author_ids"`
+	Actor     string             `db:"actor"`
+	ChangedAt time.Time          `db:"changed_at"`
+}
+
+// NewBookHistoryRepository - Constructor BookHistoryRepository
+func NewBookHistoryRepository(querier sqlx.ExtContext, builder sq.StatementBuilderType, observ observability.RepositoryObservability) repositories.BookHistoryRepository {
+	return &bookHistoryRepository{querier: querier, builder: builder, observ: observ}
+}
+
+// Record - Appends the current state of the books to the history,
+// called in the transaction of the write after the book and its authors are stored.
+// The purge does not write the book, it is appended as the version after the last one, called before the delete.
+func (r *bookHistoryRepository) Record(ctx context.Context, bookIDs []int64, eventType entities.EventType, actor string) error {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookHistoryRepository.record")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "insert", "book_history", duration, success)
+	}()
+
+	version, changedAt := "version", "updated_at"
+	if eventType == entities.Purged {
+		version, changedAt = "version + 1", "NOW()"
+	}
+
+	snapshot := sq.Select("id", version).
+		Column("?", eventType).
+		Columns("title", "description", "year", "genre_id", "isbn", "removed").
+		Column(bookHistoryAuthorIDs).
+		Column("?", actor).
+		Columns("created_at", changedAt).
+		From("book").
+		Where(sq.Eq{"id": bookIDs})
+
+	query, args, err := r.builder.Insert("book_history").
+		Columns("book_id", "version", "type", "title", "description", "year", "genre_id", "isbn", "removed", "author_ids", "actor", "created_at", "changed_at").
+		Select(snapshot).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "bookHistoryPostgres.Record: error builder")
+	}
+
+	res, err := r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(err, "bookHistoryPostgres.Record: error query")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "rowsAffected.failed", Value: true}})
+
+		return errs.Wrap(err, "bookHistoryPostgres.Record: error get affected rows")
+	}
+
+	if rowsAffected != int64(len(bookIDs)) {
+		span.SetAttributes([]observability.Attribute{{Key: "len.book.noEqual.failed", Value: true}})
+
+		return errs.Wrap(errs.ErrNotFound, fmt.Sprintf("bookHistoryPostgres.Record: expected rowsAffected %d, actual %d", len(bookIDs), rowsAffected))
+	}
+
+	success = true
+	return nil
+}
+
+// GetByBookID - Returns the versions of the book from the oldest one, the purged books keep their history
+func (r *bookHistoryRepository) GetByBookID(ctx context.Context, bookID int64) ([]entities.BookHistory, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookHistoryRepository.getByBookID")
+	span.SetAttributes([]observability.Attribute{{Key: "book.id", Value: bookID}})
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "book_history", duration, success)
+	}()
+
+	query, args, err := r.builder.Select(bookHistoryColumns...).
+		From("book_history").
+		Where(sq.Eq{"book_id": bookID}).
+		OrderBy("version").
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookHistoryPostgres.GetByBookID: error builder")
+	}
+
+	var rows []bookHistoryRow
+	err = sqlx.SelectContext(ctx, r.querier, &rows, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "selectContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookHistoryPostgres.GetByBookID: error query")
+	}
+
+	if len(rows) == 0 {
+		span.SetAttributes([]observability.Attribute{{Key: "len.history.zero", Value: true}})
+
+		return nil, errs.Wrap(errs.ErrNotFound, fmt.Sprintf("bookHistoryPostgres.GetByBookID: book %d", bookID))
+	}
+
+	history, err := bookHistoryFromRows(rows)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "json.unmarshal.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookHistoryPostgres.GetByBookID")
+	}
+
+	success = true
+	return history, nil
+}
+
+// bookHistoryColumns - columns of book_history named as the columns of book
+var bookHistoryColumns = []string{
+	"book_id AS id", "version", "type", "title", "description", "year", "genre_id", "isbn", "removed",
+	"author_ids", "actor", "created_at", "changed_at AS updated_at", "changed_at",
+}
+
+// bookHistoryFromRows - converts the rows, the authors get only their IDs
+func bookHistoryFromRows(rows []bookHistoryRow) ([]entities.BookHistory, error) {
+	history := make([]entities.BookHistory, 0, len(rows))
+	for _, row := range rows {
+		var authorIDs []int64
+		if err := json.Unmarshal(row.AuthorIDs, &authorIDs); err != nil {
+			return nil, errs.Wrap(err, fmt.Sprintf("error author ids of book %d version %d", row.ID, row.Version))
+		}
+
+		book := row.Book
+		book.Authors = make([]entities.Author, 0, len(authorIDs))
+		for _, id := range authorIDs {
+			book.Authors = append(book.Authors, entities.Author{ID: id})
+		}
+
+		history = append(history, entities.BookHistory{Book: book, Type: row.Type, Actor: row.Actor, ChangedAt: row.ChangedAt})
+	}
+
+	return history, nil
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+func newBookHistoryRepositoryMock(t *testing.T) (repositories.BookHistoryRepository, sqlmock.Sqlmock, func()) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+
+	return NewBookHistoryRepository(sqlxDB, builder, observ), mock, func() { mockDB.Close() }
+}
+
+const bookHistoryRecordQuery = "INSERT INTO book_history (book_id,version,type,title,description,year,genre_id,isbn,removed,author_ids,actor,created_at,changed_at) " +
+	"SELECT id, version, $1, title, description, year, genre_id, isbn, removed, " + bookHistoryAuthorIDs + ", $2, created_at, updated_at FROM book WHERE id IN ($3,$4)"
+
+var bookHistoryColumnsScan = []string{"id", "version", "type", "title", "description", "year", "genre_id", "isbn", "removed", "author_ids", "actor", "created_at", "updated_at", "changed_at"}
+
+func TestBookHistory_Record_Success(t *testing.T) {
+	repo, mock, closeDB := newBookHistoryRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta(bookHistoryRecordQuery)).
+		WithArgs(entities.Deleted, "telegram:42", int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.Record(ctx, []int64{1, 2}, entities.Deleted, "telegram:42")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestBookHistory_Record_SuccessPurged(t *testing.T) {
+	repo, mock, closeDB := newBookHistoryRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO book_history (book_id,version,type,title,description,year,genre_id,isbn,removed,author_ids,actor,created_at,changed_at) "+
+		"SELECT id, version + 1, $1, title, description, year, genre_id, isbn, removed, "+bookHistoryAuthorIDs+", $2, created_at, NOW() FROM book WHERE id IN ($3)")).
+		WithArgs(entities.Purged, "", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Record(ctx, []int64{1}, entities.Purged, "")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestBookHistory_Record_ErrorNotFound(t *testing.T) {
+	repo, mock, closeDB := newBookHistoryRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta(bookHistoryRecordQuery)).
+		WithArgs(entities.Deleted, "", int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Record(ctx, []int64{1, 2}, entities.Deleted, "")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestBookHistory_GetByBookID_Success(t *testing.T) {
+	repo, mock, closeDB := newBookHistoryRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+	createdAt := time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC)
+	removedAt := createdAt.Add(time.Hour)
+
+	rows := sqlmock.NewRows(bookHistoryColumnsScan).
+		AddRow(5, 1, entities.Created, "Dune", "", 1965, 3, "", false, []byte(`[7,2]`), "", createdAt, createdAt, createdAt).
+		AddRow(5, 2, entities.Deleted, "Dune", "", 1965, 3, "", true, []byte(`[7,2]`), "telegram:42", createdAt, removedAt, removedAt)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT book_id AS id, version, type, title, description, year, genre_id, isbn, removed, author_ids, actor, created_at, changed_at AS updated_at, changed_at FROM book_history WHERE book_id = $1 ORDER BY version")).
+		WithArgs(int64(5)).
+		WillReturnRows(rows)
+
+	history, err := repo.GetByBookID(ctx, 5)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, entities.BookHistory{
+		Book: entities.Book{
+			ID: 5, Title: "Dune", Year: 1965, GenreID: 3, Removed: true, Version: 2, CreatedAt: createdAt, UpdatedAt: removedAt,
+			Authors: []entities.Author{{ID: 7}, {ID: 2}},
+		},
+		Type:      entities.Deleted,
+		Actor:     "telegram:42",
+		ChangedAt: removedAt,
+	}, history[1])
+}
+
+func TestBookHistory_GetByBookID_ErrorNotFound(t *testing.T) {
+	repo, mock, closeDB := newBookHistoryRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM book_history WHERE book_id = $1 ORDER BY version")).
+		WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows(bookHistoryColumnsScan))
+
+	history, err := repo.GetByBookID(ctx, 5)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Nil(t, history)
+}
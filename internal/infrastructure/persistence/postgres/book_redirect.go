@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type bookRedirectRepository struct {
+	querier sqlx.ExtContext
+	builder sq.StatementBuilderType
+
+	observ observability.RepositoryObservability
+}
+
+// bookRedirect - the row of book_redirect
+type bookRedirect struct {
+	SourceID int64 `db:"source_id"`
+	TargetID int64 `db:"target_id"`
+}
+
+// NewBookRedirectRepository - Constructor BookRedirectRepository
+func NewBookRedirectRepository(querier sqlx.ExtContext, builder sq.StatementBuilderType, observ observability.RepositoryObservability) repositories.BookRedirectRepository {
+	return &bookRedirectRepository{querier: querier, builder: builder, observ: observ}
+}
+
+// Add - Redirects the source books to the target, the books redirected to the sources are redirected to the target too
+// so a redirect never leads to another one
+func (r *bookRedirectRepository) Add(ctx context.Context, sourceIDs []int64, targetID int64) error {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRedirectRepository.add")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "insert", "book_redirect", duration, success)
+	}()
+
+	query, args, err := r.builder.Update("book_redirect").
+		Set("target_id", targetID).
+		Where(sq.Eq{"target_id": sourceIDs}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "bookRedirectPostgres.Add: error builder repoint")
+	}
+
+	_, err = r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(err, "bookRedirectPostgres.Add: error query repoint")
+	}
+
+	now := time.Now().UTC()
+	insert := r.builder.Insert("book_redirect").Columns("source_id", "target_id", "created_at")
+	for _, sourceID := range sourceIDs {
+		insert = insert.Values(sourceID, targetID, now)
+	}
+
+	query, args, err = insert.
+		Suffix("ON CONFLICT (source_id) DO UPDATE SET target_id = EXCLUDED.target_id, created_at = EXCLUDED.created_at").
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "bookRedirectPostgres.Add: error builder")
+	}
+
+	_, err = r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(err, "bookRedirectPostgres.Add: error query")
+	}
+
+	success = true
+	return nil
+}
+
+// GetTargets - Returns the targets of the redirected books by their IDs, the IDs without a redirect are absent
+func (r *bookRedirectRepository) GetTargets(ctx context.Context, IDs []int64) (map[int64]int64, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRedirectRepository.getTargets")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "book_redirect", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("source_id", "target_id").
+		From("book_redirect").
+		Where(sq.Eq{"source_id": IDs}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookRedirectPostgres.GetTargets: error builder")
+	}
+
+	var redirects []bookRedirect
+	err = sqlx.SelectContext(ctx, r.querier, &redirects, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "selectContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "bookRedirectPostgres.GetTargets: error query")
+	}
+
+	targets := make(map[int64]int64, len(redirects))
+	for _, redirect := range redirects {
+		targets[redirect.SourceID] = redirect.TargetID
+	}
+
+	success = true
+	return targets, nil
+}
+
+// Delete - Drops the redirects of the source books
+func (r *bookRedirectRepository) Delete(ctx context.Context, sourceIDs []int64) error {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "bookRedirectRepository.delete")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "delete", "book_redirect", duration, success)
+	}()
+
+	query, args, err := r.builder.Delete("book_redirect").
+		Where(sq.Eq{"source_id": sourceIDs}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "bookRedirectPostgres.Delete: error builder")
+	}
+
+	_, err = r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(err, "bookRedirectPostgres.Delete: error query")
+	}
+
+	success = true
+	return nil
+}
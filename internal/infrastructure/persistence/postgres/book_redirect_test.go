@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+func newBookRedirectRepositoryMock(t *testing.T) (repositories.BookRedirectRepository, sqlmock.Sqlmock, func()) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+
+	return NewBookRedirectRepository(sqlxDB, builder, observ), mock, func() { mockDB.Close() }
+}
+
+func TestBookRedirect_Add_Success(t *testing.T) {
+	repo, mock, closeDB := newBookRedirectRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_redirect SET target_id = $1 WHERE target_id IN ($2,$3)")).
+		WithArgs(int64(1), int64(2), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO book_redirect (source_id,target_id,created_at) VALUES ($1,$2,$3),($4,$5,$6) "+
+		"ON CONFLICT (source_id) DO UPDATE SET target_id = EXCLUDED.target_id, created_at = EXCLUDED.created_at")).
+		WithArgs(int64(2), int64(1), sqlmock.AnyArg(), int64(3), int64(1), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.Add(ctx, []int64{2, 3}, 1)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestBookRedirect_Add_ErrorRepoint(t *testing.T) {
+	repo, mock, closeDB := newBookRedirectRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_redirect SET target_id = $1 WHERE target_id IN ($2)")).
+		WithArgs(int64(1), int64(2)).
+		WillReturnError(errors.New("db error"))
+
+	err := repo.Add(ctx, []int64{2}, 1)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "bookRedirectPostgres.Add: error query repoint")
+}
+
+func TestBookRedirect_GetTargets_Success(t *testing.T) {
+	repo, mock, closeDB := newBookRedirectRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	rows := sqlmock.NewRows([]string{"source_id", "target_id"}).AddRow(2, 1)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT source_id, target_id FROM book_redirect WHERE source_id IN ($1,$2)")).
+		WithArgs(int64(1), int64(2)).
+		WillReturnRows(rows)
+
+	targets, err := repo.GetTargets(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int64{2: 1}, targets)
+}
+
+func TestBookRedirect_GetTargets_Error(t *testing.T) {
+	repo, mock, closeDB := newBookRedirectRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT source_id, target_id FROM book_redirect WHERE source_id IN ($1)")).
+		WithArgs(int64(1)).
+		WillReturnError(errors.New("db error"))
+
+	targets, err := repo.GetTargets(ctx, []int64{1})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Nil(t, targets)
+	assert.Contains(t, err.Error(), "bookRedirectPostgres.GetTargets: error query")
+}
+
+func TestBookRedirect_Delete_Success(t *testing.T) {
+	repo, mock, closeDB := newBookRedirectRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book_redirect WHERE source_id IN ($1,$2)")).
+		WithArgs(int64(2), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Delete(ctx, []int64{2, 3})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
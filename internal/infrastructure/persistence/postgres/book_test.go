@@ -7,11 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,6 +23,40 @@ import (
 	errs "github.com/mathbdw/book/internal/errors"
 )
 
+// expectBookAuthors - expects the query of the books authors made by attachAuthors
+func expectBookAuthors(mock sqlmock.Sqlmock, rows *sqlmock.Rows, bookIDs ...int64) {
+	placeholders := make([]string, 0, len(bookIDs))
+	args := make([]driver.Value, 0, len(bookIDs))
+	for i, id := range bookIDs {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", i+1))
+		args = append(args, id)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(
+		"SELECT ba.book_id, a.id, a.name, a.created_at, a.updated_at FROM book_authors ba JOIN authors a ON a.id = ba.author_id WHERE ba.book_id IN (%s) ORDER BY ba.book_id, ba.position",
+		strings.Join(placeholders, ","),
+	))).
+		WithArgs(args...).
+		WillReturnRows(rows)
+}
+
+// expectBookGenres - expects the query of the books genres made by attachGenres
+func expectBookGenres(mock sqlmock.Sqlmock, rows *sqlmock.Rows, genreIDs ...int64) {
+	placeholders := make([]string, 0, len(genreIDs))
+	args := make([]driver.Value, 0, len(genreIDs))
+	for i, id := range genreIDs {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", i+1))
+		args = append(args, id)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(
+		"SELECT * FROM genres WHERE id IN (%s) ORDER BY id",
+		strings.Join(placeholders, ","),
+	))).
+		WithArgs(args...).
+		WillReturnRows(rows)
+}
+
 func TestBook_Create_ErrorScan(t *testing.T) {
 	mockDB, mock, err := sqlmock.New()
 	require.NoError(t, err, "Error create mock")
@@ -34,26 +70,27 @@ func TestBook_Create_ErrorScan(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO book (description,genre,title,year) VALUES ($1,$2,$3,$4) RETURNING id")).
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO book (description,genre_id,isbn,title,year) VALUES ($1,$2,$3,$4,$5) RETURNING "+bookColumnsSQL)).
 		WithArgs(
 			"Test Description",
-			"Test genre",
+			3,
+			"",
 			"Test Book",
 			2021,
 		).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("not_an_integer"))
 
-	bookId, err := repo.Create(ctx, entities.Book{
+	book, err := repo.Create(ctx, entities.Book{
 		Title:       "Test Book",
 		Description: "Test Description",
 		Year:        2021,
-		Genre:       "Test genre",
+		GenreID:     3,
 	})
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "bookPostgres.Create: error scanning")
-	assert.Equal(t, int64(0), bookId)
+	assert.Equal(t, entities.Book{}, book)
 }
 
 func TestBook_Create_Success(t *testing.T) {
@@ -68,26 +105,30 @@ func TestBook_Create_Success(t *testing.T) {
 	observ := createMockMockRepositoryObservability(ctrl)
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
+	createdAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
 
-	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO book (description,genre,title,year) VALUES ($1,$2,$3,$4) RETURNING id")).
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO book (description,genre_id,isbn,title,year) VALUES ($1,$2,$3,$4,$5) RETURNING "+bookColumnsSQL)).
 		WithArgs(
 			"Test Description",
-			"Test genre",
+			3,
+			"",
 			"Test Book",
 			2021,
 		).
-		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "year", "genre_id", "removed", "created_at", "updated_at"}).
+			AddRow(100, "Test Book", "Test Description", 2021, 3, false, createdAt, createdAt))
 
-	bookId, err := repo.Create(ctx, entities.Book{
+	book, err := repo.Create(ctx, entities.Book{
 		Title:       "Test Book",
 		Description: "Test Description",
 		Year:        2021,
-		Genre:       "Test genre",
+		GenreID:     3,
 	})
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Nil(t, err)
-	assert.Equal(t, bookId, int64(100))
+	assert.Equal(t, int64(100), book.ID)
+	assert.Equal(t, createdAt, book.CreatedAt)
 }
 
 func TestBook_GetById_ErrorQuery(t *testing.T) {
@@ -103,7 +144,7 @@ func TestBook_GetById_ErrorQuery(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
 		WithArgs(1, 2, false).
 		WillReturnError(sql.ErrNoRows)
 
@@ -128,10 +169,10 @@ func TestBook_GetById_ErrorScan(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
 		WithArgs(1, 2, false).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"id", "title", "genre", "description", "year"}).
+			sqlmock.NewRows([]string{"id", "title", "genre_id", "description", "year"}).
 				AddRow("", "Test Title", "Test Description", "Test Book", 2021),
 		)
 
@@ -156,10 +197,10 @@ func TestBook_GetById_NotFound(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
 		WithArgs(1, 2, false).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"id", "title", "genre", "description", "year"}),
+			sqlmock.NewRows([]string{"id", "title", "genre_id", "description", "year"}),
 		)
 
 	books, err := repo.GetByIDs(ctx, []int64{1, 2})
@@ -183,17 +224,28 @@ func TestBook_GetById_Success(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
 		WithArgs(1, 2, false).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"id", "title", "description", "year", "genre"}).
-				AddRow(1, "Test Book", "Test Description", 2021, "Test genre").
-				AddRow(2, "Test Book2", "Test Description2", 2022, "Test genre2"),
+			sqlmock.NewRows([]string{"id", "title", "description", "year", "genre_id"}).
+				AddRow(1, "Test Book", "Test Description", 2021, 3).
+				AddRow(2, "Test Book2", "Test Description2", 2022, 4),
 		)
+	expectBookAuthors(mock,
+		sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}).
+			AddRow(1, 5, "First Author", time.Now(), time.Now()).
+			AddRow(1, 3, "Second Author", time.Now(), time.Now()),
+		1, 2,
+	)
+	expectBookGenres(mock, sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "Test genre").AddRow(4, "Test genre2"), 3, 4)
 
 	models, err := repo.GetByIDs(ctx, []int64{1, 2})
 
+	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Nil(t, err)
+	assert.Equal(t, []int64{5, 3}, models[0].AuthorIDs())
+	assert.Equal(t, "Second Author", models[0].Authors[1].Name)
+	assert.Empty(t, models[1].Authors)
 	assert.Equal(t, len(models), 2, "Expected models to be equal")
 	assert.Equal(t, "Test Book2", (models)[1].Title)
 	assert.Equal(t, "Test Description", (models)[0].Description)
@@ -201,6 +253,220 @@ func TestBook_GetById_Success(t *testing.T) {
 	assert.Equal(t, "Test genre", (models)[0].Genre)
 }
 
+// bookColumnsSQL - the columns of book read into entities.Book
+const bookColumnsSQL = "id, title, description, year, genre_id, isbn, removed, version, created_at, updated_at"
+
+// bookAsOfQuery - the state of the books at as_of rebuilt from book_history, the as_of is $1
+const bookAsOfQuery = "SELECT " + bookColumnsSQL + " FROM (SELECT DISTINCT ON (book_id) book_id AS id, title, description, year, genre_id, isbn, removed, version, created_at, changed_at AS updated_at " +
+	"FROM book_history WHERE changed_at <= $1 ORDER BY book_id, version DESC) AS book"
+
+func TestBook_GetByIDsAsOf_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+	asOf := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(bookAsOfQuery+" WHERE (id IN ($2,$3) AND removed = $4)")).
+		WithArgs(asOf, 1, 2, false).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "title", "description", "year", "genre_id", "version"}).
+				AddRow(1, "Old Title", "Test Description", 2021, 3, 2).
+				AddRow(2, "Test Book2", "Test Description2", 2022, 3, 1),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT h.book_id, a.id, a.name, a.created_at, a.updated_at FROM book_history h " +
+		"CROSS JOIN LATERAL jsonb_array_elements_text(h.author_ids) WITH ORDINALITY AS ha(author_id, position) " +
+		"JOIN authors a ON a.id = ha.author_id::bigint " +
+		"WHERE (h.book_id = $1 AND h.version = $2 OR h.book_id = $3 AND h.version = $4) ORDER BY h.book_id, ha.position")).
+		WithArgs(1, 2, 2, 1).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}).
+				AddRow(1, 5, "First Author", time.Now(), time.Now()).
+				AddRow(2, 3, "Second Author", time.Now(), time.Now()),
+		)
+	expectBookGenres(mock, sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "Test genre"), 3)
+
+	models, err := repo.GetByIDsAsOf(ctx, []int64{1, 2}, asOf)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, models, 2)
+	assert.Equal(t, "Old Title", models[0].Title)
+	assert.Equal(t, []int64{5}, models[0].AuthorIDs())
+	assert.Equal(t, []int64{3}, models[1].AuthorIDs())
+	assert.Equal(t, "Test genre", models[1].Genre)
+}
+
+func TestBook_GetByIDsAsOf_NotFound(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+	asOf := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(bookAsOfQuery+" WHERE (id IN ($2) AND removed = $3)")).
+		WithArgs(asOf, 1, false).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}))
+
+	books, err := repo.GetByIDsAsOf(ctx, []int64{1}, asOf)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Empty(t, books)
+}
+
+func TestBook_FindDuplicates_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM book WHERE (removed = $1 AND year = $2 AND genre_id = $3 AND title % $4 AND similarity(title, $5) >= $6) " +
+		"ORDER BY similarity(title, $7) DESC, id LIMIT 5")).
+		WithArgs(false, 1869, 3, "War and Pease", "War and Pease", 0.5, "War and Pease").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4).AddRow(2))
+
+	IDs, err := repo.FindDuplicates(ctx, entities.Book{Title: "War and Pease", Year: 1869, GenreID: 3}, 0.5, 5)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 2}, IDs)
+}
+
+func TestBook_FindDuplicates_ErrorQuery(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM book")).
+		WillReturnError(errors.New("query error"))
+
+	IDs, err := repo.FindDuplicates(ctx, entities.Book{Title: "War and Pease", Year: 1869, GenreID: 3}, 0.5, 5)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Contains(t, err.Error(), "bookPostgres.FindDuplicates: error query")
+	assert.Nil(t, IDs)
+}
+
+func TestBook_Create_ErrorISBNExists(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO book (description,genre_id,isbn,title,year) VALUES ($1,$2,$3,$4,$5) RETURNING "+bookColumnsSQL)).
+		WithArgs("Test Description", 3, "9780306406157", "Test Book", 2021).
+		WillReturnError(&pgconn.PgError{Code: pgCodeUniqueViolation})
+
+	book, err := repo.Create(ctx, entities.Book{
+		Title:       "Test Book",
+		Description: "Test Description",
+		Year:        2021,
+		GenreID:     3,
+		ISBN:        "9780306406157",
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, errors.Is(err, errs.ErrAlreadyExists))
+	assert.Equal(t, entities.Book{}, book)
+}
+
+func TestBook_GetByISBN_NotFound(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE (isbn = $1 AND removed = $2)")).
+		WithArgs("9780306406157", false).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "isbn"}))
+
+	book, err := repo.GetByISBN(ctx, "9780306406157")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+	assert.Equal(t, entities.Book{}, book)
+}
+
+func TestBook_GetByISBN_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE (isbn = $1 AND removed = $2)")).
+		WithArgs("9780306406157", false).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "title", "description", "year", "genre_id", "isbn"}).
+				AddRow(1, "Test Book", "Test Description", 2021, 3, "9780306406157"),
+		)
+	expectBookAuthors(mock,
+		sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}).
+			AddRow(1, 5, "First Author", time.Now(), time.Now()),
+		1,
+	)
+	expectBookGenres(mock, sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "Test genre"), 3)
+
+	book, err := repo.GetByISBN(ctx, "9780306406157")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), book.ID)
+	assert.Equal(t, "9780306406157", book.ISBN)
+	assert.Equal(t, []int64{5}, book.AuthorIDs())
+	assert.Equal(t, "Test genre", book.Genre)
+}
+
 func TestBook_List_ErrorQuery(t *testing.T) {
 	mockDB, mock, err := sqlmock.New()
 	require.NoError(t, err, "Error create mock")
@@ -214,8 +480,8 @@ func TestBook_List_ErrorQuery(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM book ORDER BY id asc LIMIT 2")).
-		WithoutArgs().
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT " + bookColumnsSQL + " FROM book WHERE removed = $1 ORDER BY id asc LIMIT 2")).
+		WithArgs(false).
 		WillReturnError(sql.ErrNoRows)
 
 	respBooks, err := repo.List(ctx, entities.PaginationParams{
@@ -243,10 +509,10 @@ func TestBook_List_ErrorScan(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM book ORDER BY id asc LIMIT 2")).
-		WithoutArgs().
-		WillReturnRows(mock.NewRows([]string{"id", "title", "genre", "description", "year"}).
-			AddRow("", "Test Book", "Test Description", 2021, "Test genre"),
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT " + bookColumnsSQL + " FROM book WHERE removed = $1 ORDER BY id asc LIMIT 2")).
+		WithArgs(false).
+		WillReturnRows(mock.NewRows([]string{"id", "title", "genre_id", "description", "year"}).
+			AddRow("", "Test Book", "Test Description", 2021, 3),
 		)
 
 	respBooks, err := repo.List(ctx, entities.PaginationParams{
@@ -274,9 +540,9 @@ func TestBook_List_EmptyScan(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM book ORDER BY id asc LIMIT 2")).
-		WithoutArgs().
-		WillReturnRows(mock.NewRows([]string{"id", "title", "genre", "description", "year"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT " + bookColumnsSQL + " FROM book WHERE removed = $1 ORDER BY id asc LIMIT 2")).
+		WithArgs(false).
+		WillReturnRows(mock.NewRows([]string{"id", "title", "genre_id", "description", "year"}))
 
 	respBooks, err := repo.List(ctx, entities.PaginationParams{
 		Limit:     1,
@@ -303,16 +569,16 @@ func TestBook_List_ErrorCreatePageInfo(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM book WHERE title > $1 ORDER BY title asc LIMIT 2")).
-		WithArgs("title").
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE removed = $1 AND (title > $2 OR (title = $3 AND id > $4)) ORDER BY title asc, id asc LIMIT 2")).
+		WithArgs(false, "title", "title", 1).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"id", "title"}).
-				AddRow(1, "").
-				AddRow(1, "Test title2"),
+				AddRow(0, "Test title1").
+				AddRow(2, "Test title2"),
 		)
 
 	respBooks, err := repo.List(ctx, entities.PaginationParams{
-		Cursor:    &entities.Cursor{Value: "title"},
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"title"}, ID: 1},
 		Limit:     1,
 		SortBy:    entities.CursorTypeBookTitle,
 		SortOrder: entities.SortOrderTypeAsc,
@@ -324,11 +590,11 @@ func TestBook_List_ErrorCreatePageInfo(t *testing.T) {
 }
 
 var multipleRows = [][]driver.Value{
-	{1, "Book 1", "Desc 1", "Genre 1", 2021, time.Date(2021, time.January, 1, 8, 0, 0, 0, time.UTC)},
-	{2, "Book 2", "Desc 2", "Genre 2", 2022, time.Date(2021, time.January, 1, 9, 0, 0, 0, time.UTC)},
-	{3, "Book 3", "Desc 3", "Genre 3", 2022, time.Date(2021, time.January, 1, 10, 0, 0, 0, time.UTC)},
-	{4, "Book 4", "Desc 4", "Genre 4", 2023, time.Date(2021, time.January, 1, 11, 0, 0, 0, time.UTC)},
-	{5, "Book 5", "Desc 5", "Genre 5", 2024, time.Date(2021, time.January, 1, 12, 0, 0, 0, time.UTC)},
+	{1, "Book 1", "Desc 1", 1, 2021, time.Date(2021, time.January, 1, 8, 0, 0, 0, time.UTC)},
+	{2, "Book 2", "Desc 2", 2, 2022, time.Date(2021, time.January, 1, 9, 0, 0, 0, time.UTC)},
+	{3, "Book 3", "Desc 3", 3, 2022, time.Date(2021, time.January, 1, 10, 0, 0, 0, time.UTC)},
+	{4, "Book 4", "Desc 4", 4, 2023, time.Date(2021, time.January, 1, 11, 0, 0, 0, time.UTC)},
+	{5, "Book 5", "Desc 5", 5, 2024, time.Date(2021, time.January, 1, 12, 0, 0, 0, time.UTC)},
 }
 
 func TestBook_List(t *testing.T) {
@@ -344,17 +610,19 @@ func TestBook_List(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM book WHERE id > $1 ORDER BY id asc LIMIT 3")).
-		WithArgs(1).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE removed = $1 AND id > $2 ORDER BY id asc LIMIT 3")).
+		WithArgs(false, 1).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"id", "title", "description", "genre", "year", "created_at"}).
+			sqlmock.NewRows([]string{"id", "title", "description", "genre_id", "year", "created_at"}).
 				AddRow(multipleRows[1]...).
 				AddRow(multipleRows[2]...).
 				AddRow(multipleRows[3]...),
 		)
+	expectBookAuthors(mock, sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}), 2, 3)
+	expectBookGenres(mock, sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "Genre 2").AddRow(3, "Genre 3"), 2, 3)
 
 	responseBook, err := repo.List(ctx, entities.PaginationParams{
-		Cursor:    &entities.Cursor{Value: 1},
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{1}, ID: 1},
 		Limit:     2,
 		SortBy:    entities.CursorTypeBookID,
 		SortOrder: entities.SortOrderTypeAsc,
@@ -380,17 +648,19 @@ func TestBook_ListLess(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM book WHERE id > $1 ORDER BY id asc LIMIT 5")).
-		WithArgs(1).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE removed = $1 AND id > $2 ORDER BY id asc LIMIT 5")).
+		WithArgs(false, 1).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"id", "title", "description", "genre", "year", "created_at"}).
+			sqlmock.NewRows([]string{"id", "title", "description", "genre_id", "year", "created_at"}).
 				AddRow(multipleRows[1]...).
 				AddRow(multipleRows[2]...).
 				AddRow(multipleRows[3]...),
 		)
+	expectBookAuthors(mock, sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}), 2, 3, 4)
+	expectBookGenres(mock, sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "Genre 2").AddRow(3, "Genre 3").AddRow(4, "Genre 4"), 2, 3, 4)
 
 	responseBook, err := repo.List(ctx, entities.PaginationParams{
-		Cursor:    &entities.Cursor{Value: 1},
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{1}, ID: 1},
 		Limit:     4,
 		SortBy:    entities.CursorTypeBookID,
 		SortOrder: entities.SortOrderTypeAsc,
@@ -416,11 +686,11 @@ func TestBook_Remove_ErrorNoRows(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2 WHERE id IN ($3,$4)")).
-		WithArgs(true, sqlmock.AnyArg(), 1, 2).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2, version = version + 1 WHERE (id IN ($3,$4) AND removed = $5)")).
+		WithArgs(true, sqlmock.AnyArg(), 1, 2, false).
 		WillReturnError(sql.ErrNoRows)
 
-	err = repo.Remove(ctx, []int64{1, 2})
+	err = repo.Remove(ctx, []int64{1, 2}, 0)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
@@ -451,11 +721,11 @@ func TestBook_Remove_ErrorGetAffectedRows(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2 WHERE id IN ($3,$4)")).
-		WithArgs(true, sqlmock.AnyArg(), 1, 2).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2, version = version + 1 WHERE (id IN ($3,$4) AND removed = $5)")).
+		WithArgs(true, sqlmock.AnyArg(), 1, 2, false).
 		WillReturnResult(&ErrorResult{})
 
-	err = repo.Remove(ctx, []int64{1, 2})
+	err = repo.Remove(ctx, []int64{1, 2}, 0)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
@@ -475,15 +745,16 @@ func TestBook_Remove_ErrorNotEquilRowsAffected(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2 WHERE id IN ($3,$4)")).
-		WithArgs(true, sqlmock.AnyArg(), 1, 2).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2, version = version + 1 WHERE (id IN ($3,$4) AND removed = $5)")).
+		WithArgs(true, sqlmock.AnyArg(), 1, 2, false).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
-	err = repo.Remove(ctx, []int64{1, 2})
+	err = repo.Remove(ctx, []int64{1, 2}, 0)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "bookPostgres.Remove: expected rowsAffected")
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
 }
 
 func TestBook_Remove_Success(t *testing.T) {
@@ -499,12 +770,910 @@ func TestBook_Remove_Success(t *testing.T) {
 	repo := NewBookRepository(sqlxDB, builder, observ)
 	ctx := context.Background()
 
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2 WHERE id IN ($3,$4)")).
-		WithArgs(true, sqlmock.AnyArg(), 1, 2).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2, version = version + 1 WHERE (id IN ($3,$4) AND removed = $5)")).
+		WithArgs(true, sqlmock.AnyArg(), 1, 2, false).
 		WillReturnResult(sqlmock.NewResult(0, 2))
 
-	err = repo.Remove(ctx, []int64{1, 2})
+	err = repo.Remove(ctx, []int64{1, 2}, 0)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestBook_Remove_ErrorExpectedVersionSeveralBooks(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	err = repo.Remove(ctx, []int64{1, 2}, 3)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+}
+
+func TestBook_Remove_ExpectedVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		rows      int64
+		version   *sqlmock.Rows
+		wantError error
+	}{
+		{"Success", 1, nil, nil},
+		{"VersionMismatch", 0, sqlmock.NewRows([]string{"version"}).AddRow(4), errs.ErrVersionMismatch},
+		{"NotFound", 0, sqlmock.NewRows([]string{"version"}), errs.ErrNotFound},
+		// the book is already removed at the version
+		{"AlreadyRemoved", 0, sqlmock.NewRows([]string{"version"}).AddRow(3), errs.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			require.NoError(t, err, "Error create mock")
+			defer mockDB.Close()
+
+			ctrl := gomock.NewController(t)
+			sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+			builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+			//createMockMockRepositoryObservability - book_event_postgres_test.go
+			observ := createMockMockRepositoryObservability(ctrl)
+			repo := NewBookRepository(sqlxDB, builder, observ)
+			ctx := context.Background()
+
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2, version = version + 1 WHERE (id IN ($3) AND removed = $4 AND version = $5)")).
+				WithArgs(true, sqlmock.AnyArg(), 1, false, 3).
+				WillReturnResult(sqlmock.NewResult(0, tt.rows))
+			if tt.version != nil {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT version FROM book WHERE id = $1")).
+					WithArgs(1).
+					WillReturnRows(tt.version)
+			}
+
+			err = repo.Remove(ctx, []int64{1}, 3)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+			if tt.wantError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestBook_Update_ErrorScan(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE book SET description = $1, genre_id = $2, isbn = $3, title = $4, year = $5, updated_at = $6, version = version + 1 WHERE (id = $7 AND removed = $8) RETURNING "+bookColumnsSQL)).
+		WithArgs("Test Description", 3, "9780306406157", "Test Book", 2021, sqlmock.AnyArg(), 1, false).
+		WillReturnError(errors.New("error query"))
+
+	book, err := repo.Update(ctx, entities.Book{
+		ID:          1,
+		Title:       "Test Book",
+		Description: "Test Description",
+		Year:        2021,
+		GenreID:     3,
+		ISBN:        "9780306406157",
+	}, entities.BookUpdatableFields)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "bookPostgres.Update: error scanning")
+	assert.Empty(t, book)
+}
+
+func TestBook_Update_NotFound(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE book SET description = $1, genre_id = $2, isbn = $3, title = $4, year = $5, updated_at = $6, version = version + 1 WHERE (id = $7 AND removed = $8) RETURNING "+bookColumnsSQL)).
+		WithArgs("Test Description", 3, "9780306406157", "Test Book", 2021, sqlmock.AnyArg(), 1, false).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "year", "genre_id"}))
+
+	book, err := repo.Update(ctx, entities.Book{
+		ID:          1,
+		Title:       "Test Book",
+		Description: "Test Description",
+		Year:        2021,
+		GenreID:     3,
+		ISBN:        "9780306406157",
+	}, entities.BookUpdatableFields)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, errors.Is(err, errs.ErrNotFound), fmt.Sprintf("Expected errs.ErrNotFound, got: %v", err))
+	assert.Empty(t, book)
+}
+
+func TestBook_Update_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE book SET description = $1, genre_id = $2, isbn = $3, title = $4, year = $5, updated_at = $6, version = version + 1 WHERE (id = $7 AND removed = $8) RETURNING "+bookColumnsSQL)).
+		WithArgs("Test Description", 3, "9780306406157", "Test Book", 2021, sqlmock.AnyArg(), 1, false).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "title", "description", "year", "genre_id", "created_at"}).
+				AddRow(1, "Test Book", "Test Description", 2021, 3, time.Date(2021, time.January, 1, 8, 0, 0, 0, time.UTC)),
+		)
+
+	book, err := repo.Update(ctx, entities.Book{
+		ID:          1,
+		Title:       "Test Book",
+		Description: "Test Description",
+		Year:        2021,
+		GenreID:     3,
+		ISBN:        "9780306406157",
+	}, entities.BookUpdatableFields)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), book.ID)
+	assert.Equal(t, "Test Book", book.Title)
+	assert.Equal(t, time.Date(2021, time.January, 1, 8, 0, 0, 0, time.UTC), book.CreatedAt)
+}
+
+func TestBook_Update_PartialFields(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE book SET description = $1, updated_at = $2, version = version + 1 WHERE (id = $3 AND removed = $4) RETURNING "+bookColumnsSQL)).
+		WithArgs("New Description", sqlmock.AnyArg(), 1, false).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "title", "description", "year", "genre_id"}).
+				AddRow(1, "Test Book", "New Description", 2021, 3),
+		)
+
+	book, err := repo.Update(ctx, entities.Book{ID: 1, Description: "New Description"}, []entities.BookField{entities.BookFieldDescription})
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 	assert.NoError(t, err)
+	assert.Equal(t, "Test Book", book.Title)
+	assert.Equal(t, "New Description", book.Description)
+}
+
+func TestBook_Update_ExpectedVersion(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE book SET description = $1, updated_at = $2, version = version + 1 WHERE (id = $3 AND removed = $4 AND version = $5) RETURNING "+bookColumnsSQL)).
+		WithArgs("Test Description", sqlmock.AnyArg(), 1, false, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "description", "version"}).AddRow(1, "Test Description", 4))
+
+	book, err := repo.Update(ctx, entities.Book{ID: 1, Description: "Test Description", Version: 3}, []entities.BookField{entities.BookFieldDescription})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), book.Version)
+}
+
+func TestBook_Update_ErrorVersionMismatch(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE book SET description = $1, updated_at = $2, version = version + 1 WHERE (id = $3 AND removed = $4 AND version = $5) RETURNING "+bookColumnsSQL)).
+		WithArgs("Test Description", sqlmock.AnyArg(), 1, false, 3).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version FROM book WHERE id = $1")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+
+	book, err := repo.Update(ctx, entities.Book{ID: 1, Description: "Test Description", Version: 3}, []entities.BookField{entities.BookFieldDescription})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, errs.ErrVersionMismatch)
+	assert.Contains(t, err.Error(), "book 1 is at version 5, expected 3")
+	assert.Empty(t, book)
+}
+
+func TestBook_Restore_ExpectedVersionRemovedBook(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2, version = version + 1 WHERE (id IN ($3) AND removed = $4 AND version = $5)")).
+		WithArgs(false, sqlmock.AnyArg(), 1, true, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version FROM book WHERE id = $1")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(3))
+
+	err = repo.Restore(ctx, []int64{1}, 3)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestBook_Restore_ErrorNoRows(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2, version = version + 1 WHERE (id IN ($3,$4) AND removed = $5)")).
+		WithArgs(false, sqlmock.AnyArg(), 1, 2, true).
+		WillReturnError(sql.ErrNoRows)
+
+	err = repo.Restore(ctx, []int64{1, 2}, 0)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, sql.ErrNoRows), fmt.Sprintf("Expected sql.ErrNoRows, got: %v", err))
+}
+
+func TestBook_Restore_ErrorGetAffectedRows(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2, version = version + 1 WHERE (id IN ($3,$4) AND removed = $5)")).
+		WithArgs(false, sqlmock.AnyArg(), 1, 2, true).
+		WillReturnResult(&ErrorResult{})
+
+	err = repo.Restore(ctx, []int64{1, 2}, 0)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "bookPostgres.Restore: error get affected rows")
+}
+
+func TestBook_Restore_ErrorNotEquilRowsAffected(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2, version = version + 1 WHERE (id IN ($3,$4) AND removed = $5)")).
+		WithArgs(false, sqlmock.AnyArg(), 1, 2, true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Restore(ctx, []int64{1, 2}, 0)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "bookPostgres.Restore: expected rowsAffected")
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestBook_Restore_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book SET removed = $1, updated_at = $2, version = version + 1 WHERE (id IN ($3,$4) AND removed = $5)")).
+		WithArgs(false, sqlmock.AnyArg(), 1, 2, true).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.Restore(ctx, []int64{1, 2}, 0)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestBook_CountPurgeable_ErrorScan(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+	before := time.Now().UTC()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM book WHERE (removed = $1 AND updated_at < $2)")).
+		WithArgs(true, before).
+		WillReturnError(sql.ErrConnDone)
+
+	count, err := repo.CountPurgeable(ctx, before)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), count)
+	assert.Contains(t, err.Error(), "bookPostgres.CountPurgeable: error scanning")
+}
+
+func TestBook_CountPurgeable_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+	before := time.Now().UTC()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM book WHERE (removed = $1 AND updated_at < $2)")).
+		WithArgs(true, before).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+	count, err := repo.CountPurgeable(ctx, before)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), count)
+}
+
+func TestBook_LockPurgeable_ErrorQuery(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+	before := time.Now().UTC()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM book WHERE (removed = $1 AND updated_at < $2) ORDER BY id LIMIT 2 FOR UPDATE SKIP LOCKED")).
+		WithArgs(true, before).
+		WillReturnError(sql.ErrConnDone)
+
+	IDs, err := repo.LockPurgeable(ctx, before, 2)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Nil(t, IDs)
+	assert.Contains(t, err.Error(), "bookPostgres.LockPurgeable: error query")
+}
+
+func TestBook_LockPurgeable_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+	before := time.Now().UTC()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM book WHERE (removed = $1 AND updated_at < $2) ORDER BY id LIMIT 2 FOR UPDATE SKIP LOCKED")).
+		WithArgs(true, before).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+
+	IDs, err := repo.LockPurgeable(ctx, before, 2)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, IDs)
+}
+
+func TestBook_Purge_ErrorQuery(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
+		WithArgs(int64(1), int64(2), true).
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.Purge(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "bookPostgres.Purge: error query")
+}
+
+func TestBook_Purge_ErrorNotFound(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
+		WithArgs(int64(1), int64(2), true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Purge(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestBook_Purge_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM book WHERE (id IN ($1,$2) AND removed = $3)")).
+		WithArgs(int64(1), int64(2), true).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.Purge(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestBook_CreateBatch_ErrorQuery(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO book (title,description,year,genre_id,isbn) VALUES ($1,$2,$3,$4,$5),($6,$7,$8,$9,$10) RETURNING "+bookColumnsSQL)).
+		WithArgs("First", "First desc", 2001, 5, "9780306406157", "Second", "Second desc", 2002, 5, "").
+		WillReturnError(sql.ErrConnDone)
+
+	books, err := repo.CreateBatch(ctx, []entities.Book{
+		{Title: "First", Description: "First desc", Year: 2001, GenreID: 5, ISBN: "9780306406157"},
+		{Title: "Second", Description: "Second desc", Year: 2002, GenreID: 5},
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Nil(t, books)
+	assert.Contains(t, err.Error(), "bookPostgres.CreateBatch: error query")
+}
+
+func TestBook_CreateBatch_ErrorNotEqualRows(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO book (title,description,year,genre_id,isbn) VALUES ($1,$2,$3,$4,$5),($6,$7,$8,$9,$10) RETURNING "+bookColumnsSQL)).
+		WithArgs("First", "First desc", 2001, 5, "9780306406157", "Second", "Second desc", 2002, 5, "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "year", "genre_id"}).
+			AddRow(1, "First", "First desc", 2001, 5))
+
+	books, err := repo.CreateBatch(ctx, []entities.Book{
+		{Title: "First", Description: "First desc", Year: 2001, GenreID: 5, ISBN: "9780306406157"},
+		{Title: "Second", Description: "Second desc", Year: 2002, GenreID: 5},
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Nil(t, books)
+	assert.Contains(t, err.Error(), "bookPostgres.CreateBatch: expected 2 rows, actual 1")
+}
+
+func TestBook_CreateBatch_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO book (title,description,year,genre_id,isbn) VALUES ($1,$2,$3,$4,$5),($6,$7,$8,$9,$10) RETURNING "+bookColumnsSQL)).
+		WithArgs("First", "First desc", 2001, 5, "9780306406157", "Second", "Second desc", 2002, 5, "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "year", "genre_id"}).
+			AddRow(1, "First", "First desc", 2001, 5).
+			AddRow(2, "Second", "Second desc", 2002, 5))
+
+	books, err := repo.CreateBatch(ctx, []entities.Book{
+		{Title: "First", Description: "First desc", Year: 2001, GenreID: 5, ISBN: "9780306406157"},
+		{Title: "Second", Description: "Second desc", Year: 2002, GenreID: 5},
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, books, 2)
+	assert.Equal(t, int64(1), books[0].ID)
+	assert.Equal(t, "Second", books[1].Title)
+}
+
+func TestBook_List_FilterAuthor(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE id IN (SELECT book_id FROM book_authors WHERE author_id = $1) AND removed = $2 AND id > $3 ORDER BY id asc LIMIT 3")).
+		WithArgs(7, false, 1).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "title", "description", "genre_id", "year", "created_at"}).
+				AddRow(multipleRows[1]...),
+		)
+	expectBookAuthors(mock,
+		sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}).
+			AddRow(2, 7, "Author", time.Now(), time.Now()),
+		2,
+	)
+	expectBookGenres(mock, sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "Genre 2"), 2)
+
+	responseBook, err := repo.List(ctx, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{1}, ID: 1},
+		Limit:     2,
+		SortBy:    entities.CursorTypeBookID,
+		SortOrder: entities.SortOrderTypeAsc,
+		Filter:    entities.BookFilter{AuthorID: 7},
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, responseBook.Data, 1)
+	assert.Equal(t, []int64{7}, responseBook.Data[0].AuthorIDs())
+}
+
+func TestBook_List_AsOfWithTotalCount(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+	asOf := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(bookAsOfQuery + " WHERE year >= $2 AND removed = $3 ORDER BY id asc LIMIT 3")).
+		WithArgs(asOf, 2000, false).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "title", "description", "genre_id", "year", "version"}).
+				AddRow(2, "Removed later", "Description", 2, 2001, 1),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta(strings.Replace(bookAsOfQuery, "SELECT "+bookColumnsSQL, "SELECT COUNT(*)", 1)+" WHERE year >= $2 AND removed = $3")).
+		WithArgs(asOf, 2000, false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT h.book_id, a.id, a.name, a.created_at, a.updated_at FROM book_history h")).
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}))
+	expectBookGenres(mock, sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "Genre 2"), 2)
+
+	responseBook, err := repo.List(ctx, entities.PaginationParams{
+		Limit:          2,
+		SortBy:         entities.CursorTypeBookID,
+		SortOrder:      entities.SortOrderTypeAsc,
+		Filter:         entities.BookFilter{YearFrom: 2000, AsOf: asOf},
+		WithTotalCount: true,
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, responseBook.Data, 1)
+	assert.Equal(t, "Removed later", responseBook.Data[0].Title)
+	assert.Equal(t, int64(1), *responseBook.PageInfo.TotalCount)
+}
+
+func TestBook_List_FilterGenre(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE genre_id IN (WITH RECURSIVE subtree AS (SELECT id FROM genres WHERE id = $1 ")).
+		WithArgs(2, false, 1).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "title", "description", "genre_id", "year", "created_at"}).
+				AddRow(multipleRows[1]...),
+		)
+	expectBookAuthors(mock, sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}), 2)
+	expectBookGenres(mock, sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "Genre 2"), 2)
+
+	responseBook, err := repo.List(ctx, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{1}, ID: 1},
+		Limit:     2,
+		SortBy:    entities.CursorTypeBookID,
+		SortOrder: entities.SortOrderTypeAsc,
+		Filter:    entities.BookFilter{GenreID: 2},
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, responseBook.Data, 1)
+	assert.Equal(t, "Genre 2", responseBook.Data[0].Genre)
+}
+
+func TestBook_List_BackwardWithTotalCount(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT "+bookColumnsSQL+" FROM book WHERE removed = $1 AND id < $2 ORDER BY id desc LIMIT 3")).
+		WithArgs(false, 5).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "title", "description", "genre_id", "year", "created_at"}).
+				AddRow(multipleRows[3]...).
+				AddRow(multipleRows[2]...).
+				AddRow(multipleRows[1]...),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM book WHERE removed = $1")).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	expectBookAuthors(mock, sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}), 3, 4)
+	expectBookGenres(mock, sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "Genre 3").AddRow(4, "Genre 4"), 3, 4)
+
+	responseBook, err := repo.List(ctx, entities.PaginationParams{
+		Cursor:         &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{5}, ID: 5, Backward: true},
+		Limit:          2,
+		SortBy:         entities.CursorTypeBookID,
+		SortOrder:      entities.SortOrderTypeAsc,
+		WithTotalCount: true,
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 4}, []int64{responseBook.Data[0].ID, responseBook.Data[1].ID})
+	assert.True(t, responseBook.PageInfo.HasPrevious)
+	assert.True(t, responseBook.PageInfo.HasNext)
+	assert.Equal(t, int64(5), *responseBook.PageInfo.TotalCount)
+}
+
+func TestBook_List_ErrorTotalCount(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT " + bookColumnsSQL + " FROM book WHERE removed = $1 ORDER BY id asc LIMIT 3")).
+		WithArgs(false).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "title", "description", "genre_id", "year", "created_at"}).
+				AddRow(multipleRows[0]...),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM book WHERE removed = $1")).
+		WithArgs(false).
+		WillReturnError(sql.ErrConnDone)
+
+	responseBook, err := repo.List(ctx, entities.PaginationParams{
+		Limit:          2,
+		SortBy:         entities.CursorTypeBookID,
+		SortOrder:      entities.SortOrderTypeAsc,
+		WithTotalCount: true,
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+	assert.Contains(t, err.Error(), "bookPostgres.List: count: error scanning")
+	assert.Nil(t, responseBook)
+}
+
+func TestBook_Search_ErrorQuery(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT found.*, ts_headline(")).
+		WillReturnError(sql.ErrConnDone)
+
+	resp, err := repo.Search(ctx, "sea", entities.PaginationParams{Limit: 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "bookPostgres.Search: error query")
+}
+
+func TestBook_Search_Success(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	defer mockDB.Close()
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+	repo := NewBookRepository(sqlxDB, builder, observ)
+	ctx := context.Background()
+	createdAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT found.*, ts_headline(book_search_config(), found.title || ' ' || COALESCE(found.description, ''), websearch_to_tsquery(book_search_config(), $1), $2) AS snippet " +
+			"FROM (SELECT "+bookColumnsSQL+", ts_rank_cd(b.search_vector, q.query) AS rank FROM book b "+
+			"CROSS JOIN websearch_to_tsquery(book_search_config(), $3) AS q(query) WHERE b.search_vector @@ q.query AND b.removed = $4) AS found " +
+			"WHERE (rank < $5 OR (rank = $6 AND id < $7)) ORDER BY rank desc, id desc LIMIT 3",
+	)).
+		WithArgs("sea", searchHeadlineOptions, "sea", false, "0.5", "0.5", 9).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "title", "description", "year", "genre_id", "created_at", "rank", "snippet"}).
+				AddRow(1, "The Sea", "Desc", 1900, 3, createdAt, 0.4, "The <b>Sea</b>").
+				AddRow(2, "Sea Wolf", "Desc", 1904, 3, createdAt, 0.3, "<b>Sea</b> Wolf").
+				AddRow(3, "Sea", "Desc", 1910, 3, createdAt, 0.2, "<b>Sea</b>"),
+		)
+	expectBookAuthors(mock, sqlmock.NewRows([]string{"book_id", "id", "name", "created_at", "updated_at"}), 1, 2)
+	expectBookGenres(mock, sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "Adventure"), 3)
+
+	resp, err := repo.Search(ctx, "sea", entities.PaginationParams{
+		Limit:  2,
+		Cursor: &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"0.5"}, ID: 9},
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, resp.Data, 2)
+	assert.Equal(t, "Sea Wolf", resp.Data[1].Title)
+	assert.Equal(t, "Adventure", resp.Data[1].Genre)
+	assert.Equal(t, float32(0.3), resp.Data[1].Rank)
+	assert.Equal(t, "<b>Sea</b> Wolf", resp.Data[1].Snippet)
+
+	cursor, err := DecodeCursor(resp.PageInfo.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"0.3"}, cursor.Keys)
+	assert.Equal(t, int64(2), cursor.ID)
 }
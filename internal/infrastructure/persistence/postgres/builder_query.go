@@ -2,55 +2,177 @@ package postgres
 
 import (
 	"fmt"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/mathbdw/book/internal/domain/entities"
 )
 
-// conditionBuilder - SelectBuilder query condition builder
-func conditionBuilder(query sq.SelectBuilder, params entities.PaginationParams) sq.SelectBuilder {
-	if params.Cursor != nil {
-		if params.Cursor.CreatedAt == nil {
-			if params.SortOrder == entities.SortOrderTypeAsc {
-				return query.Where(sq.Gt{string(params.SortBy): params.Cursor.Value})
-			} else {
-				return query.Where(sq.Lt{string(params.SortBy): params.Cursor.Value})
-			}
+// bookHistoryAuthorQuery - matches the version of the book whose stored authors contain the author
+const bookHistoryAuthorQuery = "EXISTS (SELECT 1 FROM book_history h " +
+	"WHERE h.book_id = book.id AND h.version = book.version AND h.author_ids @> jsonb_build_array(?::bigint))"
+
+// selectBooks - selects the columns from book, or from the state of the books at filter.AsOf.
+// The state is rebuilt from the last version of every book in book_history not after AsOf,
+// it has the columns of book so the filter, the cursor and the order apply to it unchanged.
+// The history of the books written before book_history was added starts at their state of that moment.
+// changed_at is TIMESTAMPTZ, AsOf is compared with it as an instant in any time zone of the session
+func selectBooks(builder sq.StatementBuilderType, filter entities.BookFilter, columns ...string) sq.SelectBuilder {
+	query := builder.Select(columns...)
+	if filter.AsOf.IsZero() {
+		return query.From("book")
+	}
+
+	asOf := sq.Select("book_id AS id", "title", "description", "year", "genre_id", "isbn", "removed", "version", "created_at", "changed_at AS updated_at").
+		Options("DISTINCT ON (book_id)").
+		From("book_history").
+		Where(sq.LtOrEq{"changed_at": filter.AsOf.UTC()}).
+		OrderBy("book_id", "version DESC")
+
+	return query.FromSelect(asOf, "book")
+}
+
+// filterBuilder - SelectBuilder query filter builder
+func filterBuilder(query sq.SelectBuilder, filter entities.BookFilter) sq.SelectBuilder {
+	if filter.AuthorID > 0 {
+		if filter.AsOf.IsZero() {
+			query = query.Where(sq.Expr("id IN (SELECT book_id FROM book_authors WHERE author_id = ?)", filter.AuthorID))
 		} else {
-			if params.SortOrder == entities.SortOrderTypeAsc {
-				return query.Where(
-					sq.Or{
-						sq.Gt{string(params.SortBy): params.Cursor.Value},
-						sq.And{
-							sq.Eq{string(params.SortBy): params.Cursor.Value},
-							sq.Gt{"created_at": params.Cursor.CreatedAt.UTC()},
-						},
-					},
-				)
-			} else {
-				return query.Where(
-					sq.Or{
-						sq.Lt{string(params.SortBy): params.Cursor.Value},
-						sq.And{
-							sq.Eq{string(params.SortBy): params.Cursor.Value},
-							sq.Lt{"created_at": params.Cursor.CreatedAt.UTC()},
-						},
-					},
-				)
-			}
+			query = query.Where(sq.Expr(bookHistoryAuthorQuery, filter.AuthorID))
 		}
 	}
 
+	if filter.GenreID > 0 {
+		query = query.Where(sq.Expr("genre_id IN ("+genreSubtreeQuery+")", filter.GenreID))
+	}
+
+	if filter.YearFrom > 0 {
+		query = query.Where(sq.GtOrEq{"year": filter.YearFrom})
+	}
+
+	if filter.YearTo > 0 {
+		query = query.Where(sq.LtOrEq{"year": filter.YearTo})
+	}
+
+	if filter.TitlePrefix != "" {
+		query = query.Where(sq.ILike{"title": likeEscaper.Replace(filter.TitlePrefix) + "%"})
+	}
+
+	if !filter.IncludeRemoved {
+		query = query.Where(sq.Eq{"removed": false})
+	}
+
 	return query
 }
 
-// orderByBuilder - SelectBuilder Query Sort Builder
+// likeEscaper - escapes the LIKE wildcards so the value is matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// conditionBuilder - SelectBuilder query condition builder, applies the filter and the cursor of params
+func conditionBuilder(query sq.SelectBuilder, params entities.PaginationParams) sq.SelectBuilder {
+	query = filterBuilder(query, params.Filter)
+
+	return cursorBuilder(query, params)
+}
+
+// keysetColumn - column of the keyset pagination, its order of reading and the value of the cursor
+type keysetColumn struct {
+	name  string
+	order entities.SortOrderType
+	value any
+}
+
+// keysetColumns - returns the sort columns of params followed by the tiebreaker: id, or created_at of a v1 cursor.
+// A unique sort column needs no tiebreaker. The columns of a backward page are read in the reversed order.
+func keysetColumns(params entities.PaginationParams) []keysetColumn {
+	sort := params.SortFields()
+	columns := make([]keysetColumn, 0, len(sort)+1)
+	unique := false
+	for i, field := range sort {
+		column := keysetColumn{name: string(field.Field), order: readOrder(field.Order, params)}
+		if params.Cursor != nil && i < len(params.Cursor.Keys) {
+			column.value = params.Cursor.Keys[i]
+		}
+		columns = append(columns, column)
+		unique = unique || entities.SortTypesUnique[string(field.Field)]
+	}
+
+	tiebreakerOrder := readOrder(params.SortOrder, params)
+	switch {
+	case unique:
+	case params.Cursor != nil && params.Cursor.Version == entities.CursorVersion1:
+		if params.Cursor.CreatedAt != nil {
+			columns = append(columns, keysetColumn{name: "created_at", order: tiebreakerOrder, value: params.Cursor.CreatedAt.UTC()})
+		}
+	case params.Cursor != nil:
+		columns = append(columns, keysetColumn{name: "id", order: tiebreakerOrder, value: params.Cursor.ID})
+	default:
+		columns = append(columns, keysetColumn{name: "id", order: tiebreakerOrder})
+	}
+
+	return columns
+}
+
+// readOrder - returns the order of reading the field, reversed for a backward page
+func readOrder(order entities.SortOrderType, params entities.PaginationParams) entities.SortOrderType {
+	if params.IsBackward() {
+		return reverseSortOrder(order)
+	}
+
+	return order
+}
+
+// cursorBuilder - SelectBuilder query condition builder of the keyset cursor,
+// selects the rows after the cursor in the order of reading:
+// (a > x) OR (a = x AND b > y) OR ... with < for the descending columns
+func cursorBuilder(query sq.SelectBuilder, params entities.PaginationParams) sq.SelectBuilder {
+	if params.Cursor == nil {
+		return query
+	}
+
+	columns := keysetColumns(params)
+	terms := make(sq.Or, 0, len(columns))
+	for i, column := range columns {
+		var after sq.Sqlizer = sq.Gt{column.name: column.value}
+		if column.order == entities.SortOrderTypeDesc {
+			after = sq.Lt{column.name: column.value}
+		}
+
+		if i == 0 {
+			terms = append(terms, after)
+			continue
+		}
+
+		term := make(sq.And, 0, i+1)
+		for _, prev := range columns[:i] {
+			term = append(term, sq.Eq{prev.name: prev.value})
+		}
+		terms = append(terms, append(term, after))
+	}
+
+	if len(terms) == 1 {
+		return query.Where(terms[0])
+	}
+
+	return query.Where(terms)
+}
+
+// orderByBuilder - SelectBuilder Query Sort Builder, orders by the keyset columns
 func orderByBuilder(query sq.SelectBuilder, params entities.PaginationParams) sq.SelectBuilder {
-	var strOrder string
+	columns := keysetColumns(params)
+	orderBy := make([]string, 0, len(columns))
+	for _, column := range columns {
+		orderBy = append(orderBy, fmt.Sprintf("%s %s", column.name, column.order))
+	}
+
+	return query.OrderBy(orderBy...)
+}
 
-	if params.Cursor != nil && params.Cursor.CreatedAt != nil {
-		strOrder = fmt.Sprintf(", created_at %s", params.SortOrder)
+// reverseSortOrder - returns the opposite sort order
+func reverseSortOrder(order entities.SortOrderType) entities.SortOrderType {
+	if order == entities.SortOrderTypeAsc {
+		return entities.SortOrderTypeDesc
 	}
 
-	return query.OrderBy(fmt.Sprintf("%s %s%s", params.SortBy, params.SortOrder, strOrder))
+	return entities.SortOrderTypeAsc
 }
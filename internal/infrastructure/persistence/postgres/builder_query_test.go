@@ -9,21 +9,21 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestBuilderQuery_ConditionBuilder_CursorNil(t *testing.T) {
+func TestBuilderQuery_CursorBuilder_CursorNil(t *testing.T) {
 	builder := sq.Select("*").From("test")
 
-	builder = conditionBuilder(builder, entities.PaginationParams{})
+	builder = cursorBuilder(builder, entities.PaginationParams{})
 
 	sql, _, _ := builder.ToSql()
 
 	assert.Equal(t, "SELECT * FROM test", sql)
 }
 
-func TestBuilderQuery_ConditionBuilder_CursorCreatedNil(t *testing.T) {
+func TestBuilderQuery_CursorBuilder_CursorV1CreatedNil(t *testing.T) {
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("*").From("test")
 
-	builder = conditionBuilder(builder, entities.PaginationParams{
-		Cursor:    &entities.Cursor{Value: "titleTest"},
+	builder = cursorBuilder(builder, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion1, Keys: []any{"titleTest"}},
 		SortBy:    entities.CursorTypeBookTitle,
 		SortOrder: entities.SortOrderTypeDesc,
 	})
@@ -33,12 +33,12 @@ func TestBuilderQuery_ConditionBuilder_CursorCreatedNil(t *testing.T) {
 	assert.Equal(t, "SELECT * FROM test WHERE title < $1", sql)
 }
 
-func TestBuilderQuery_ConditionBuilder_CursorCreatedSortOrderTypeDesc(t *testing.T) {
+func TestBuilderQuery_CursorBuilder_CursorV1CreatedSortOrderTypeDesc(t *testing.T) {
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("*").From("test")
 
 	date := time.Now()
-	builder = conditionBuilder(builder, entities.PaginationParams{
-		Cursor:    &entities.Cursor{Value: "titleTest", CreatedAt: &date},
+	builder = cursorBuilder(builder, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion1, Keys: []any{"titleTest"}, CreatedAt: &date},
 		SortBy:    entities.CursorTypeBookTitle,
 		SortOrder: entities.SortOrderTypeDesc,
 	})
@@ -48,12 +48,12 @@ func TestBuilderQuery_ConditionBuilder_CursorCreatedSortOrderTypeDesc(t *testing
 	assert.Equal(t, "SELECT * FROM test WHERE (title < $1 OR (title = $2 AND created_at < $3))", sql)
 }
 
-func TestBuilderQuery_ConditionBuilder_CursorCreated(t *testing.T) {
+func TestBuilderQuery_CursorBuilder_CursorV1Created(t *testing.T) {
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("*").From("test")
 
 	date := time.Now()
-	builder = conditionBuilder(builder, entities.PaginationParams{
-		Cursor:    &entities.Cursor{Value: "titleTest", CreatedAt: &date},
+	builder = cursorBuilder(builder, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion1, Keys: []any{"titleTest"}, CreatedAt: &date},
 		SortBy:    entities.CursorTypeBookTitle,
 		SortOrder: entities.SortOrderTypeAsc,
 	})
@@ -63,6 +63,86 @@ func TestBuilderQuery_ConditionBuilder_CursorCreated(t *testing.T) {
 	assert.Equal(t, "SELECT * FROM test WHERE (title > $1 OR (title = $2 AND created_at > $3))", sql)
 }
 
+func TestBuilderQuery_ConditionBuilder_ExcludesRemoved(t *testing.T) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("*").From("test")
+
+	builder = conditionBuilder(builder, entities.PaginationParams{})
+
+	sql, args, _ := builder.ToSql()
+
+	assert.Equal(t, "SELECT * FROM test WHERE removed = $1", sql)
+	assert.Equal(t, []any{false}, args)
+}
+
+func TestBuilderQuery_ConditionBuilder_FilterAndCursor(t *testing.T) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("*").From("test")
+
+	builder = conditionBuilder(builder, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"10"}, ID: 10},
+		SortBy:    entities.CursorTypeBookID,
+		SortOrder: entities.SortOrderTypeAsc,
+		Filter: entities.BookFilter{
+			YearFrom:       1900,
+			YearTo:         2000,
+			TitlePrefix:    `50%_off\`,
+			IncludeRemoved: true,
+		},
+	})
+
+	sql, args, _ := builder.ToSql()
+
+	assert.Equal(t, "SELECT * FROM test WHERE year >= $1 AND year <= $2 AND title ILIKE $3 AND id > $4", sql)
+	assert.Equal(t, []any{1900, 2000, `50\%\_off\\%`, "10"}, args)
+}
+
+func TestBuilderQuery_CursorBuilder_Backward(t *testing.T) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("*").From("test")
+
+	builder = cursorBuilder(builder, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"titleTest"}, ID: 7, Backward: true},
+		SortBy:    entities.CursorTypeBookTitle,
+		SortOrder: entities.SortOrderTypeAsc,
+	})
+
+	sql, args, _ := builder.ToSql()
+
+	assert.Equal(t, "SELECT * FROM test WHERE (title < $1 OR (title = $2 AND id < $3))", sql)
+	assert.Equal(t, []any{"titleTest", "titleTest", int64(7)}, args)
+}
+
+func TestBuilderQuery_CursorBuilder_MultiColumn(t *testing.T) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("*").From("test")
+
+	builder = cursorBuilder(builder, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"2", "1999"}, ID: 7},
+		SortBy:    entities.CursorTypeBookGenreID,
+		SortOrder: entities.SortOrderTypeAsc,
+		ThenBy:    []entities.SortField{{Field: entities.CursorTypeBookYear, Order: entities.SortOrderTypeDesc}},
+	})
+
+	sql, args, _ := builder.ToSql()
+
+	assert.Equal(t,
+		"SELECT * FROM test WHERE (genre_id > $1 OR (genre_id = $2 AND year < $3) OR (genre_id = $4 AND year = $5 AND id > $6))",
+		sql,
+	)
+	assert.Equal(t, []any{"2", "2", "1999", "2", "1999", int64(7)}, args)
+}
+
+func TestBuilderQuery_CursorBuilder_UniqueSortWithoutTiebreaker(t *testing.T) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("*").From("test")
+
+	builder = cursorBuilder(builder, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"7"}, ID: 7},
+		SortBy:    entities.CursorTypeBookID,
+		SortOrder: entities.SortOrderTypeDesc,
+	})
+
+	sql, _, _ := builder.ToSql()
+
+	assert.Equal(t, "SELECT * FROM test WHERE id < $1", sql)
+}
+
 func TestBuilderQuery_OrderByBuilder_CursorNil(t *testing.T) {
 	builder := sq.Select("*").From("test")
 
@@ -73,15 +153,15 @@ func TestBuilderQuery_OrderByBuilder_CursorNil(t *testing.T) {
 
 	sql, _, _ := builder.ToSql()
 
-	assert.Equal(t, "SELECT * FROM test ORDER BY title desc", sql)
+	assert.Equal(t, "SELECT * FROM test ORDER BY title desc, id desc", sql)
 }
 
-func TestBuilderQuery_OrderByBuilder_CursorCreatedAt(t *testing.T) {
+func TestBuilderQuery_OrderByBuilder_CursorV1CreatedAt(t *testing.T) {
 	builder := sq.Select("*").From("test")
 	date := time.Now()
 
 	builder = orderByBuilder(builder, entities.PaginationParams{
-		Cursor:    &entities.Cursor{CreatedAt: &date},
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion1, CreatedAt: &date},
 		SortBy:    entities.CursorTypeBookTitle,
 		SortOrder: entities.SortOrderTypeDesc,
 	})
@@ -90,3 +170,54 @@ func TestBuilderQuery_OrderByBuilder_CursorCreatedAt(t *testing.T) {
 
 	assert.Equal(t, "SELECT * FROM test ORDER BY title desc, created_at desc", sql)
 }
+
+func TestBuilderQuery_OrderByBuilder_Backward(t *testing.T) {
+	builder := sq.Select("*").From("test")
+
+	builder = orderByBuilder(builder, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, ID: 3, Backward: true},
+		SortBy:    entities.CursorTypeBookTitle,
+		SortOrder: entities.SortOrderTypeDesc,
+	})
+
+	sql, _, _ := builder.ToSql()
+
+	assert.Equal(t, "SELECT * FROM test ORDER BY title asc, id asc", sql)
+}
+
+func TestBuilderQuery_OrderByBuilder_MultiColumn(t *testing.T) {
+	builder := sq.Select("*").From("test")
+
+	builder = orderByBuilder(builder, entities.PaginationParams{
+		SortBy:    entities.CursorTypeBookGenreID,
+		SortOrder: entities.SortOrderTypeAsc,
+		ThenBy:    []entities.SortField{{Field: entities.CursorTypeBookYear, Order: entities.SortOrderTypeDesc}},
+	})
+
+	sql, _, _ := builder.ToSql()
+
+	assert.Equal(t, "SELECT * FROM test ORDER BY genre_id asc, year desc, id asc", sql)
+}
+
+func TestBuilderQuery_SelectBooks_Current(t *testing.T) {
+	builder := selectBooks(sq.StatementBuilder.PlaceholderFormat(sq.Dollar), entities.BookFilter{}, "COUNT(*)")
+
+	sql, args, _ := builder.ToSql()
+
+	assert.Equal(t, "SELECT COUNT(*) FROM book", sql)
+	assert.Empty(t, args)
+}
+
+func TestBuilderQuery_SelectBooks_AsOf(t *testing.T) {
+	asOf := time.Date(2025, 10, 1, 3, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	filter := entities.BookFilter{AuthorID: 7, AsOf: asOf}
+
+	builder := filterBuilder(selectBooks(sq.StatementBuilder.PlaceholderFormat(sq.Dollar), filter, "*"), filter)
+
+	sql, args, _ := builder.ToSql()
+
+	assert.Equal(t, "SELECT * FROM (SELECT DISTINCT ON (book_id) book_id AS id, title, description, year, genre_id, isbn, removed, version, created_at, changed_at AS updated_at "+
+		"FROM book_history WHERE changed_at <= $1 ORDER BY book_id, version DESC) AS book "+
+		"WHERE EXISTS (SELECT 1 FROM book_history h WHERE h.book_id = book.id AND h.version = book.version AND h.author_ids @> jsonb_build_array($2::bigint)) AND removed = $3", sql)
+	assert.Equal(t, []any{asOf.UTC(), int64(7), false}, args)
+}
@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,28 +12,162 @@ import (
 	"github.com/mathbdw/book/internal/domain/entities"
 )
 
-// EncodeCursor - encodes cursor to base64
-func EncodeCursor(value string, createdAt *time.Time) (string, error) {
-	if value == "" {
-		return "", fmt.Errorf("value can not be empty")
+const (
+	// cursorFilterSeparator - separates the key of the filter appended to the end of the v1 cursor
+	cursorFilterSeparator = "|"
+	// cursorBackwardSuffix - marks the v1 cursor of the previous page
+	cursorBackwardSuffix = "|prev"
+	// cursorDescPrefix - marks a descending field in the sort of the v2 cursor
+	cursorDescPrefix = "-"
+)
+
+// cursorPayload - JSON of the v2 cursor
+type cursorPayload struct {
+	Version  int      `json:"v"`
+	Sort     []string `json:"s"`
+	Keys     []any    `json:"k"`
+	ID       int64    `json:"id"`
+	Filter   string   `json:"f,omitempty"`
+	Backward bool     `json:"b,omitempty"`
+	Expires  int64    `json:"e,omitempty"`
+}
+
+// EncodeCursor - encodes cursor as the v2 JSON in URL-safe base64, signs it when the signer is set
+func EncodeCursor(cursor entities.Cursor) (string, error) {
+	if cursor.ID < 1 {
+		return "", fmt.Errorf("id can not be empty")
+	}
+
+	if len(cursor.Sort) == 0 || len(cursor.Sort) != len(cursor.Keys) {
+		return "", fmt.Errorf("keys do not match the sort: %d fields, %d keys", len(cursor.Sort), len(cursor.Keys))
+	}
+
+	payload := cursorPayload{
+		Version:  entities.CursorVersion2,
+		Sort:     make([]string, 0, len(cursor.Sort)),
+		Keys:     cursor.Keys,
+		ID:       cursor.ID,
+		Filter:   cursor.Filter,
+		Backward: cursor.Backward,
+	}
+	signer := cursorSigner.Load()
+	if signer != nil {
+		payload.Expires = signer.expiresAt()
+	}
+	for _, field := range cursor.Sort {
+		if field.Order == entities.SortOrderTypeDesc {
+			payload.Sort = append(payload.Sort, cursorDescPrefix+string(field.Field))
+		} else {
+			payload.Sort = append(payload.Sort, string(field.Field))
+		}
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("invalid cursor encoding: %w", err)
 	}
 
-	strCursor := value
-	if createdAt != nil {
-		strCursor = strCursor + ":" + fmt.Sprint(createdAt.UnixNano())
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	if signer != nil {
+		return signer.Sign(encoded), nil
 	}
 
-	return base64.StdEncoding.EncodeToString([]byte(strCursor)), nil
+	return encoded, nil
 }
 
-// DecodeCursor - decodes cursor from base64
+// DecodeCursor - decodes the v2 cursor, falls back to the v1 cursor.
+// When the signer is set the signature and the expiry are verified,
+// the unsigned cursors are rejected unless the signer accepts them, then only the v1 cursors are accepted
 func DecodeCursor(strCursor string) (*entities.Cursor, error) {
+	signer := cursorSigner.Load()
+	if strings.Contains(strCursor, cursorSignSeparator) {
+		if signer == nil {
+			return nil, fmt.Errorf("%w: signing is off", ErrCursorForged)
+		}
+
+		encoded, err := signer.Verify(strCursor)
+		if err != nil {
+			return nil, err
+		}
+
+		cursor, expires, ok := decodeCursorV2(encoded)
+		if !ok {
+			return nil, fmt.Errorf("invalid cursor v2: payload")
+		}
+
+		if err := signer.checkExpiry(expires); err != nil {
+			return nil, err
+		}
+
+		return validateCursorV2(cursor)
+	}
+
+	if signer != nil && !signer.acceptUnsigned {
+		return nil, fmt.Errorf("%w: unsigned", ErrCursorForged)
+	}
+
+	if cursor, _, ok := decodeCursorV2(strCursor); ok {
+		// the transition covers only the legacy v1 cursors, the v2 cursors are always signed by the signer
+		if signer != nil {
+			return nil, fmt.Errorf("%w: unsigned v2", ErrCursorForged)
+		}
+
+		return validateCursorV2(cursor)
+	}
+
+	return decodeCursorV1(strCursor)
+}
+
+// validateCursorV2 - checks that the keys match the sort and the id is set
+func validateCursorV2(cursor *entities.Cursor) (*entities.Cursor, error) {
+	if len(cursor.Sort) == 0 || len(cursor.Sort) != len(cursor.Keys) || cursor.ID < 1 {
+		return nil, fmt.Errorf("invalid cursor v2: %d fields, %d keys, id %d", len(cursor.Sort), len(cursor.Keys), cursor.ID)
+	}
+
+	return cursor, nil
+}
+
+// decodeCursorV2 - decodes the v2 cursor and its expiry, false when strCursor is not one
+func decodeCursorV2(strCursor string) (*entities.Cursor, int64, bool) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strCursor)
+	if err != nil || len(decoded) == 0 || decoded[0] != '{' {
+		return nil, 0, false
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(decoded, &payload); err != nil || payload.Version != entities.CursorVersion2 {
+		return nil, 0, false
+	}
+
+	cursor := entities.Cursor{
+		Version:  entities.CursorVersion2,
+		Sort:     make([]entities.SortField, 0, len(payload.Sort)),
+		Keys:     payload.Keys,
+		ID:       payload.ID,
+		Filter:   payload.Filter,
+		Backward: payload.Backward,
+	}
+	for _, field := range payload.Sort {
+		if name, ok := strings.CutPrefix(field, cursorDescPrefix); ok {
+			cursor.Sort = append(cursor.Sort, entities.SortField{Field: entities.CursorType(name), Order: entities.SortOrderTypeDesc})
+		} else {
+			cursor.Sort = append(cursor.Sort, entities.SortField{Field: entities.CursorType(field), Order: entities.SortOrderTypeAsc})
+		}
+	}
+
+	return &cursor, payload.Expires, true
+}
+
+// decodeCursorV1 - decodes the "value[:createdAt][|filter][|prev]" cursor from base64
+func decodeCursorV1(strCursor string) (*entities.Cursor, error) {
 	decoded, err := base64.StdEncoding.DecodeString(strCursor)
 	if err != nil {
 		return nil, fmt.Errorf("invalid cursor decoding: %w", err)
 	}
 
-	parts := strings.Split(string(decoded), ":")
+	strCursor, backward := strings.CutSuffix(string(decoded), cursorBackwardSuffix)
+	strCursor, filter := splitCursorFilter(strCursor)
+	parts := strings.Split(strCursor, ":")
 	if len(parts[0]) == 0 {
 		return nil, fmt.Errorf("empty value in cursor")
 	}
@@ -40,7 +176,12 @@ func DecodeCursor(strCursor string) (*entities.Cursor, error) {
 		return nil, fmt.Errorf("invalid parts in cursor: %d", len(parts))
 	}
 
-	cursor := entities.Cursor{Value: parts[0], CreatedAt: nil}
+	cursor := entities.Cursor{
+		Version:  entities.CursorVersion1,
+		Keys:     []any{parts[0]},
+		Filter:   filter,
+		Backward: backward,
+	}
 	if len(parts) > 1 {
 		timestamp, err := strconv.ParseInt(parts[1], 10, 64)
 		if err != nil {
@@ -52,3 +193,19 @@ func DecodeCursor(strCursor string) (*entities.Cursor, error) {
 
 	return &cursor, nil
 }
+
+// splitCursorFilter - splits the decoded v1 cursor into the position and the key of the filter,
+// cursors created before the filter binding have no key
+func splitCursorFilter(decoded string) (string, string) {
+	i := strings.LastIndex(decoded, cursorFilterSeparator)
+	if i < 0 {
+		return decoded, ""
+	}
+
+	filter := decoded[i+1:]
+	if _, err := hex.DecodeString(filter); err != nil || len(filter) != 16 {
+		return decoded, ""
+	}
+
+	return decoded[:i], filter
+}
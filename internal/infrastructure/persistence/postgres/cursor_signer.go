@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// cursorSignSeparator - separates the payload, the key ID and the signature of the signed cursor
+const cursorSignSeparator = "."
+
+var (
+	// ErrCursorForged - the cursor is unsigned, signed with an unknown key or its signature does not match
+	ErrCursorForged = errors.New("cursor signature is invalid")
+	// ErrCursorExpired - the cursor is signed but its lifetime is over
+	ErrCursorExpired = errors.New("cursor expired")
+)
+
+// cursorSigner - the signer used by EncodeCursor and DecodeCursor, cursors are unsigned while it is not set
+var cursorSigner atomic.Pointer[CursorSigner]
+
+// CursorSigner - signs the cursors with HMAC-SHA256,
+// new cursors are signed with the current key, the cursors are verified with any known key
+type CursorSigner struct {
+	keyID          string
+	keys           map[string][]byte
+	ttl            time.Duration
+	acceptUnsigned bool
+	now            func() time.Time
+}
+
+// NewCursorSigner - creates the signer, keyID is the current key and must be present in keys.
+// ttl 0 - the cursors don't expire, acceptUnsigned - the unsigned cursors are decoded during the transition
+func NewCursorSigner(keyID string, keys map[string]string, ttl time.Duration, acceptUnsigned bool) (*CursorSigner, error) {
+	if _, ok := keys[keyID]; !ok {
+		return nil, fmt.Errorf("cursor key %q is not found", keyID)
+	}
+
+	if ttl < 0 {
+		return nil, fmt.Errorf("cursor ttl can not be negative: %s", ttl)
+	}
+
+	signer := &CursorSigner{
+		keyID:          keyID,
+		keys:           make(map[string][]byte, len(keys)),
+		ttl:            ttl,
+		acceptUnsigned: acceptUnsigned,
+		now:            time.Now,
+	}
+	for id, secret := range keys {
+		if id == "" || strings.Contains(id, cursorSignSeparator) {
+			return nil, fmt.Errorf("invalid cursor key id %q", id)
+		}
+
+		if len(secret) < 32 {
+			return nil, fmt.Errorf("cursor key %q is shorter than 32 bytes", id)
+		}
+		signer.keys[id] = []byte(secret)
+	}
+
+	return signer, nil
+}
+
+// SetCursorSigner - sets the signer of the cursors, nil turns the signing off
+func SetCursorSigner(signer *CursorSigner) {
+	cursorSigner.Store(signer)
+}
+
+// expiresAt - unix time when a cursor created now expires, 0 - never
+func (s *CursorSigner) expiresAt() int64 {
+	if s.ttl == 0 {
+		return 0
+	}
+
+	return s.now().Add(s.ttl).Unix()
+}
+
+// Sign - appends the current key ID and the signature to the encoded payload
+func (s *CursorSigner) Sign(payload string) string {
+	return payload + cursorSignSeparator + s.keyID + cursorSignSeparator + s.signature(s.keyID, payload)
+}
+
+// Verify - checks the signature of the signed cursor and returns its encoded payload
+func (s *CursorSigner) Verify(strCursor string) (string, error) {
+	parts := strings.Split(strCursor, cursorSignSeparator)
+	if len(parts) != 3 {
+		return "", fmt.Errorf("%w: invalid parts %d", ErrCursorForged, len(parts))
+	}
+
+	payload, keyID, signature := parts[0], parts[1], parts[2]
+	if _, ok := s.keys[keyID]; !ok {
+		return "", fmt.Errorf("%w: unknown key %q", ErrCursorForged, keyID)
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(s.signature(keyID, payload))) {
+		return "", ErrCursorForged
+	}
+
+	return payload, nil
+}
+
+// checkExpiry - checks the expiry of the decoded payload, 0 - never expires
+func (s *CursorSigner) checkExpiry(expiresAt int64) error {
+	if expiresAt != 0 && s.now().Unix() > expiresAt {
+		return fmt.Errorf("%w at %s", ErrCursorExpired, time.Unix(expiresAt, 0).UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// signature - HMAC of the payload and the key ID in URL-safe base64
+func (s *CursorSigner) signature(keyID, payload string) string {
+	mac := hmac.New(sha256.New, s.keys[keyID])
+	mac.Write([]byte(payload + cursorSignSeparator + keyID))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+)
+
+const (
+	testCursorSecret1 = "0123456789abcdef0123456789abcdef"
+	testCursorSecret2 = "fedcba9876543210fedcba9876543210"
+)
+
+// setTestCursorSigner - sets the signer for the test and turns the signing off after it
+func setTestCursorSigner(t *testing.T, signer *CursorSigner) {
+	SetCursorSigner(signer)
+	t.Cleanup(func() {
+		SetCursorSigner(nil)
+	})
+}
+
+func newTestCursorSigner(t *testing.T, keyID string, ttl time.Duration, acceptUnsigned bool) *CursorSigner {
+	signer, err := NewCursorSigner(keyID, map[string]string{"k1": testCursorSecret1, "k2": testCursorSecret2}, ttl, acceptUnsigned)
+	require.NoError(t, err)
+
+	return signer
+}
+
+func TestCursorSigner_New_Error(t *testing.T) {
+	tests := []struct {
+		name  string
+		keyID string
+		keys  map[string]string
+		ttl   time.Duration
+	}{
+		{"UnknownKeyID", "k3", map[string]string{"k1": testCursorSecret1}, 0},
+		{"NegativeTTL", "k1", map[string]string{"k1": testCursorSecret1}, -time.Second},
+		{"ShortSecret", "k1", map[string]string{"k1": "secret"}, 0},
+		{"SeparatorInKeyID", "k.1", map[string]string{"k.1": testCursorSecret1}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := NewCursorSigner(tt.keyID, tt.keys, tt.ttl, false)
+
+			assert.Error(t, err)
+			assert.Nil(t, signer)
+		})
+	}
+}
+
+func TestCursorSigner_EncodeDecode_Success(t *testing.T) {
+	setTestCursorSigner(t, newTestCursorSigner(t, "k1", 0, false))
+	expected := entities.Cursor{Sort: titleSort, Keys: []any{"Dune"}, ID: 3, Filter: "0123456789abcdef"}
+
+	strCursor, err := EncodeCursor(expected)
+	require.NoError(t, err)
+
+	parts := strings.Split(strCursor, ".")
+	require.Len(t, parts, 3)
+	assert.Equal(t, "k1", parts[1])
+	assert.Equal(t, `{"v":2,"s":["title"],"k":["Dune"],"id":3,"f":"0123456789abcdef"}`, decodeRawURL(t, parts[0]))
+
+	cursor, err := DecodeCursor(strCursor)
+
+	assert.NoError(t, err)
+	expected.Version = entities.CursorVersion2
+	assert.Equal(t, expected, *cursor)
+}
+
+func TestCursorSigner_Decode_RotatedKey(t *testing.T) {
+	setTestCursorSigner(t, newTestCursorSigner(t, "k1", 0, false))
+	strCursor, err := EncodeCursor(entities.Cursor{Sort: titleSort, Keys: []any{"Dune"}, ID: 3})
+	require.NoError(t, err)
+
+	SetCursorSigner(newTestCursorSigner(t, "k2", 0, false))
+	cursor, err := DecodeCursor(strCursor)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), cursor.ID)
+
+	strCursor, err = EncodeCursor(entities.Cursor{Sort: titleSort, Keys: []any{"Dune"}, ID: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, "k2", strings.Split(strCursor, ".")[1])
+}
+
+func TestCursorSigner_Decode_Forged(t *testing.T) {
+	setTestCursorSigner(t, newTestCursorSigner(t, "k1", 0, false))
+	strCursor, err := EncodeCursor(entities.Cursor{Sort: titleSort, Keys: []any{"Dune"}, ID: 3})
+	require.NoError(t, err)
+	parts := strings.Split(strCursor, ".")
+	editedPayload := base64.RawURLEncoding.EncodeToString([]byte(`{"v":2,"s":["title"],"k":["Dune"],"id":1}`))
+
+	tests := []struct {
+		name      string
+		strCursor string
+	}{
+		{"EditedPayload", editedPayload + "." + parts[1] + "." + parts[2]},
+		{"UnknownKey", parts[0] + ".k3." + parts[2]},
+		{"OtherKey", parts[0] + ".k2." + parts[2]},
+		{"NoSignature", parts[0] + "." + parts[1]},
+		{"UnsignedV2", parts[0]},
+		{"UnsignedV1", base64.StdEncoding.EncodeToString([]byte("10"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := DecodeCursor(tt.strCursor)
+
+			assert.ErrorIs(t, err, ErrCursorForged)
+			assert.Nil(t, cursor)
+		})
+	}
+}
+
+func TestCursorSigner_Decode_AcceptUnsigned(t *testing.T) {
+	setTestCursorSigner(t, newTestCursorSigner(t, "k1", 0, true))
+
+	cursor, err := DecodeCursor(base64.StdEncoding.EncodeToString([]byte("10")))
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.CursorVersion1, cursor.Version)
+
+	strCursor := base64.RawURLEncoding.EncodeToString([]byte(`{"v":2,"s":["title"],"k":["Dune"],"id":1}`))
+	cursor, err = DecodeCursor(strCursor)
+
+	assert.ErrorIs(t, err, ErrCursorForged)
+	assert.Nil(t, cursor)
+}
+
+func TestCursorSigner_Decode_SigningOff(t *testing.T) {
+	setTestCursorSigner(t, newTestCursorSigner(t, "k1", 0, false))
+	strCursor, err := EncodeCursor(entities.Cursor{Sort: titleSort, Keys: []any{"Dune"}, ID: 3})
+	require.NoError(t, err)
+
+	SetCursorSigner(nil)
+	_, err = DecodeCursor(strCursor)
+
+	assert.ErrorIs(t, err, ErrCursorForged)
+}
+
+func TestCursorSigner_Decode_Expired(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	signer := newTestCursorSigner(t, "k1", time.Hour, false)
+	signer.now = func() time.Time { return now }
+	setTestCursorSigner(t, signer)
+
+	strCursor, err := EncodeCursor(entities.Cursor{Sort: titleSort, Keys: []any{"Dune"}, ID: 3})
+	require.NoError(t, err)
+	assert.Contains(t, decodeRawURL(t, strings.Split(strCursor, ".")[0]), `"e":1714568400`)
+
+	now = now.Add(time.Hour)
+	_, err = DecodeCursor(strCursor)
+	assert.NoError(t, err)
+
+	now = now.Add(time.Second)
+	_, err = DecodeCursor(strCursor)
+	assert.ErrorIs(t, err, ErrCursorExpired)
+}
@@ -7,34 +7,38 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
-)
 
-func TestPagination_EncodeCursor_Error(t *testing.T) {
-	_, err := EncodeCursor("", nil)
+	"github.com/mathbdw/book/internal/domain/entities"
+)
 
-	assert.Error(t, err)
-}
+var titleSort = []entities.SortField{{Field: entities.CursorTypeBookTitle, Order: entities.SortOrderTypeAsc}}
 
-func TestPagination_EncodeCursor_Success(t *testing.T) {
-	now := time.Now()
+func TestPagination_EncodeCursor_Error(t *testing.T) {
 	tests := []struct {
-		name      string
-		value     string
-		createdAt *time.Time
+		name   string
+		cursor entities.Cursor
 	}{
-		{"CreatedAtNil", "test", nil},
-		{"CreatedAt", "test", &now},
+		{"EmptyID", entities.Cursor{Sort: titleSort, Keys: []any{"test"}}},
+		{"EmptySort", entities.Cursor{ID: 1}},
+		{"KeysMismatch", entities.Cursor{Sort: titleSort, Keys: []any{"test", "1900"}, ID: 1}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			strCursor, err := EncodeCursor(tt.value, tt.createdAt)
+			_, err := EncodeCursor(tt.cursor)
 
-			assert.NoError(t, err)
-			assert.Equal(t, true, len(strCursor) > 0)
+			assert.Error(t, err)
 		})
 	}
 }
 
+func TestPagination_EncodeCursor_Success(t *testing.T) {
+	strCursor, err := EncodeCursor(entities.Cursor{Sort: titleSort, Keys: []any{"test"}, ID: 1})
+
+	assert.NoError(t, err)
+	assert.NotContains(t, strCursor, "=")
+	assert.Equal(t, `{"v":2,"s":["title"],"k":["test"],"id":1}`, decodeRawURL(t, strCursor))
+}
+
 func TestPagination_DecodeCursor_ErrorList(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -54,6 +58,14 @@ func TestPagination_DecodeCursor_ErrorList(t *testing.T) {
 			base64.StdEncoding.EncodeToString([]byte("test:63456346345634563563")),
 			"invalid timestamp in cursor",
 		},
+		{"V2KeysMismatch",
+			base64.RawURLEncoding.EncodeToString([]byte(`{"v":2,"s":["genre_id","-year"],"k":["2"],"id":7}`)),
+			"invalid cursor v2",
+		},
+		{"V2EmptyID",
+			base64.RawURLEncoding.EncodeToString([]byte(`{"v":2,"s":["title"],"k":["test"]}`)),
+			"invalid cursor v2",
+		},
 	}
 
 	for _, tt := range tests {
@@ -66,7 +78,39 @@ func TestPagination_DecodeCursor_ErrorList(t *testing.T) {
 	}
 }
 
-func TestPagination_DecodeCursor_Success(t *testing.T) {
+func TestPagination_DecodeCursor_V2(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor entities.Cursor
+	}{
+		{"Colon", entities.Cursor{Sort: titleSort, Keys: []any{"Dune: Messiah"}, ID: 3}},
+		{"Separators", entities.Cursor{Sort: titleSort, Keys: []any{"a|b|prev"}, ID: 3, Filter: "0123456789abcdef"}},
+		{"MultiColumn", entities.Cursor{
+			Sort: []entities.SortField{
+				{Field: entities.CursorTypeBookGenreID, Order: entities.SortOrderTypeAsc},
+				{Field: entities.CursorTypeBookYear, Order: entities.SortOrderTypeDesc},
+			},
+			Keys:     []any{"2", "1999"},
+			ID:       7,
+			Backward: true,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strCursor, err := EncodeCursor(tt.cursor)
+			assert.NoError(t, err)
+
+			cursor, err := DecodeCursor(strCursor)
+
+			assert.NoError(t, err)
+			tt.cursor.Version = entities.CursorVersion2
+			assert.Equal(t, tt.cursor, *cursor)
+		})
+	}
+}
+
+func TestPagination_DecodeCursor_V1(t *testing.T) {
 	now := time.Now()
 	tests := []struct {
 		name      string
@@ -80,7 +124,7 @@ func TestPagination_DecodeCursor_Success(t *testing.T) {
 			nil,
 		},
 		{"ValueAndCreatedAt",
-			base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("10:%d", time.Now().UnixNano()))),
+			base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("10:%d", now.UnixNano()))),
 			"10",
 			&now,
 		},
@@ -91,10 +135,43 @@ func TestPagination_DecodeCursor_Success(t *testing.T) {
 			cursor, err := DecodeCursor(tt.strCursor)
 
 			assert.NoError(t, err)
-			assert.Equal(t, tt.value, cursor.Value)
+			assert.Equal(t, entities.CursorVersion1, cursor.Version)
+			assert.Equal(t, []any{tt.value}, cursor.Keys)
 			if tt.createdAt != nil {
-				assert.Equal(t, tt.createdAt.Unix(), cursor.CreatedAt.Unix())
+				assert.Equal(t, tt.createdAt.UnixNano(), cursor.CreatedAt.UnixNano())
 			}
 		})
 	}
 }
+
+func TestPagination_DecodeCursor_V1FilterAndBackward(t *testing.T) {
+	tests := []struct {
+		name         string
+		decoded      string
+		wantValue    string
+		wantFilter   string
+		wantBackward bool
+	}{
+		{"Filter", "10|0123456789abcdef", "10", "0123456789abcdef", false},
+		{"FilterBackward", "title:1|0123456789abcdef|prev", "title", "0123456789abcdef", true},
+		{"SeparatorInValue", "a|b:1", "a|b", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := DecodeCursor(base64.StdEncoding.EncodeToString([]byte(tt.decoded)))
+
+			assert.NoError(t, err)
+			assert.Equal(t, []any{tt.wantValue}, cursor.Keys)
+			assert.Equal(t, tt.wantFilter, cursor.Filter)
+			assert.Equal(t, tt.wantBackward, cursor.Backward)
+		})
+	}
+}
+
+func decodeRawURL(t *testing.T, strCursor string) string {
+	decoded, err := base64.RawURLEncoding.DecodeString(strCursor)
+	assert.NoError(t, err)
+
+	return string(decoded)
+}
@@ -0,0 +1,434 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+// genreSubtreeQuery - selects ID of the genre and IDs of all its descendants
+const genreSubtreeQuery = "WITH RECURSIVE subtree AS (" +
+	"SELECT id FROM genres WHERE id = ? " +
+	"UNION SELECT g.id FROM genres g JOIN subtree s ON g.parent_id = s.id" +
+	") SELECT id FROM subtree"
+
+type genreRepository struct {
+	querier sqlx.ExtContext
+	builder sq.StatementBuilderType
+
+	observ observability.RepositoryObservability
+}
+
+// NewGenreRepository - Constructor GenreRepository
+func NewGenreRepository(querier sqlx.ExtContext, builder sq.StatementBuilderType, observ observability.RepositoryObservability) repositories.GenreRepository {
+	return &genreRepository{querier: querier, builder: builder, observ: observ}
+}
+
+// Create - Adds row and returns the stored genre
+func (r *genreRepository) Create(ctx context.Context, genre entities.Genre) (entities.Genre, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "genreRepository.create")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "insert", "genres", duration, success)
+	}()
+
+	query, args, err := r.builder.Insert("genres").
+		Columns("name", "parent_id").
+		Values(genre.Name, genre.ParentID).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return entities.Genre{}, errs.Wrap(err, "genrePostgres.Create: error builder")
+	}
+
+	var created entities.Genre
+	err = r.querier.QueryRowxContext(ctx, query, args...).StructScan(&created)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return entities.Genre{}, errs.Wrap(genreConstraintError(err), "genrePostgres.Create: error scanning")
+	}
+
+	success = true
+	return created, nil
+}
+
+// GetByIDs - Returns genres by IDs
+func (r *genreRepository) GetByIDs(ctx context.Context, IDs []int64) ([]entities.Genre, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "genreRepository.getByIDs")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "genres", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("*").
+		From("genres").
+		Where(sq.Eq{"id": IDs}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "genrePostgres.GetByIDs: error builder")
+	}
+
+	genres, err := r.selectGenres(ctx, query, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "queryxContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "genrePostgres.GetByIDs")
+	}
+
+	if len(genres) == 0 {
+		span.SetAttributes([]observability.Attribute{{Key: "len.genre.zero", Value: true}})
+
+		return nil, errs.Wrap(errs.ErrNotFound, "genrePostgres.GetByIDs: len genres")
+	}
+
+	success = true
+	return genres, nil
+}
+
+// GetByName - Returns the genre by name ignoring case
+func (r *genreRepository) GetByName(ctx context.Context, name string) (entities.Genre, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "genreRepository.getByName")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "genres", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("*").
+		From("genres").
+		Where(sq.Expr("LOWER(name) = LOWER(?)", name)).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return entities.Genre{}, errs.Wrap(err, "genrePostgres.GetByName: error builder")
+	}
+
+	var genre entities.Genre
+	err = r.querier.QueryRowxContext(ctx, query, args...).StructScan(&genre)
+	if err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetAttributes([]observability.Attribute{{Key: "len.genre.zero", Value: true}})
+
+			return entities.Genre{}, errs.Wrap(errs.ErrNotFound, fmt.Sprintf("genrePostgres.GetByName: genre %s", name))
+		}
+
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return entities.Genre{}, errs.Wrap(err, "genrePostgres.GetByName: error scanning")
+	}
+
+	success = true
+	return genre, nil
+}
+
+// List - Returns all genres ordered by ID
+func (r *genreRepository) List(ctx context.Context) ([]entities.Genre, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "genreRepository.list")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "genres", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("*").From("genres").OrderBy("id").ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "genrePostgres.List: error builder")
+	}
+
+	genres, err := r.selectGenres(ctx, query, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "queryxContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "genrePostgres.List")
+	}
+
+	success = true
+	return genres, nil
+}
+
+// Update - Updates the name and the parent of the genre and returns its new state
+func (r *genreRepository) Update(ctx context.Context, genre entities.Genre) (entities.Genre, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "genreRepository.update")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "update", "genres", duration, success)
+	}()
+
+	query, args, err := r.builder.Update("genres").
+		Set("name", genre.Name).
+		Set("parent_id", genre.ParentID).
+		Set("updated_at", time.Now().UTC()).
+		Where(sq.Eq{"id": genre.ID}).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return entities.Genre{}, errs.Wrap(err, "genrePostgres.Update: error builder")
+	}
+
+	var updated entities.Genre
+	err = r.querier.QueryRowxContext(ctx, query, args...).StructScan(&updated)
+	if err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetAttributes([]observability.Attribute{{Key: "len.genre.zero", Value: true}})
+
+			return entities.Genre{}, errs.Wrap(errs.ErrNotFound, fmt.Sprintf("genrePostgres.Update: genre %d", genre.ID))
+		}
+
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return entities.Genre{}, errs.Wrap(genreConstraintError(err), "genrePostgres.Update: error scanning")
+	}
+
+	success = true
+	return updated, nil
+}
+
+// Remove - Deletes genres by IDs
+func (r *genreRepository) Remove(ctx context.Context, IDs []int64) error {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "genreRepository.remove")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "delete", "genres", duration, success)
+	}()
+
+	query, args, err := r.builder.Delete("genres").Where(sq.Eq{"id": IDs}).ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "genrePostgres.Remove: error builder")
+	}
+
+	res, err := r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(err, "genrePostgres.Remove: error query")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "rowsAffected.failed", Value: true}})
+
+		return errs.Wrap(err, "genrePostgres.Remove: error get affected rows")
+	}
+
+	if rowsAffected != int64(len(IDs)) {
+		span.SetAttributes([]observability.Attribute{{Key: "len.genre.noEqual.failed", Value: true}})
+
+		return errs.Wrap(errs.ErrNotFound, fmt.Sprintf("genrePostgres.Remove: expected rowsAffected %d, actual %d", len(IDs), rowsAffected))
+	}
+
+	success = true
+	return nil
+}
+
+// Subtree - Returns ID of the genre and IDs of all its descendants
+func (r *genreRepository) Subtree(ctx context.Context, ID int64) ([]int64, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "genreRepository.subtree")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "genres", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("id").
+		From("genres").
+		Where(sq.Expr("id IN ("+genreSubtreeQuery+")", ID)).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "genrePostgres.Subtree: error builder")
+	}
+
+	IDs := make([]int64, 0)
+	err = sqlx.SelectContext(ctx, r.querier, &IDs, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "selectContext.failed", Value: true}})
+
+		return nil, errs.Wrap(err, "genrePostgres.Subtree: error query")
+	}
+
+	success = true
+	return IDs, nil
+}
+
+// CountBooks - Returns count of books of the genres
+func (r *genreRepository) CountBooks(ctx context.Context, IDs []int64) (int64, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "genreRepository.countBooks")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "book", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("COUNT(*)").
+		From("book").
+		Where(sq.Eq{"genre_id": IDs}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "genrePostgres.CountBooks: error builder")
+	}
+
+	var count int64
+	err = r.querier.QueryRowxContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "genrePostgres.CountBooks: error scanning")
+	}
+
+	success = true
+	return count, nil
+}
+
+// CountChildren - Returns count of child genres of the genres, the genres themselves are not counted
+func (r *genreRepository) CountChildren(ctx context.Context, IDs []int64) (int64, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "genreRepository.countChildren")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "genres", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("COUNT(*)").
+		From("genres").
+		Where(sq.And{sq.Eq{"parent_id": IDs}, sq.NotEq{"id": IDs}}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "genrePostgres.CountChildren: error builder")
+	}
+
+	var count int64
+	err = r.querier.QueryRowxContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "genrePostgres.CountChildren: error scanning")
+	}
+
+	success = true
+	return count, nil
+}
+
+// selectGenres - runs the select query and scans the genres
+func (r *genreRepository) selectGenres(ctx context.Context, query string, args []any) ([]entities.Genre, error) {
+	rows, err := r.querier.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, errs.Wrap(err, "error query")
+	}
+	defer rows.Close()
+
+	genres := make([]entities.Genre, 0)
+	for rows.Next() {
+		var genre entities.Genre
+		if err := rows.StructScan(&genre); err != nil {
+			return nil, errs.Wrap(err, "error scan")
+		}
+		genres = append(genres, genre)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errs.Wrap(err, "iteration rows")
+	}
+
+	return genres, nil
+}
+
+// genreConstraintError - maps violations of the genres constraints to domain errors
+func genreConstraintError(err error) error {
+	switch {
+	case hasPgCode(err, pgCodeUniqueViolation):
+		return errs.Wrap(errs.ErrAlreadyExists, "genre name")
+	case hasPgCode(err, pgCodeForeignKeyViolation):
+		return errs.Wrap(errs.ErrInvalidInput, "parent genre not found")
+	default:
+		return err
+	}
+}
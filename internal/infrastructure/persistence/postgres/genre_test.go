@@ -0,0 +1,282 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+func newGenreRepositoryMock(t *testing.T) (repositories.GenreRepository, sqlmock.Sqlmock, func()) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+
+	return NewGenreRepository(sqlxDB, builder, observ), mock, func() { mockDB.Close() }
+}
+
+var genreColumns = []string{"id", "name", "parent_id", "created_at", "updated_at"}
+
+func TestGenre_Create_ErrorAlreadyExists(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO genres (name,parent_id) VALUES ($1,$2) RETURNING *")).
+		WithArgs("Fantasy", nil).
+		WillReturnError(&pgconn.PgError{Code: pgCodeUniqueViolation})
+
+	genre, err := repo.Create(ctx, entities.Genre{Name: "Fantasy"})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, entities.Genre{}, genre)
+	assert.True(t, errors.Is(err, errs.ErrAlreadyExists))
+}
+
+func TestGenre_Create_ErrorParentNotFound(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+	parentID := int64(9)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO genres (name,parent_id) VALUES ($1,$2) RETURNING *")).
+		WithArgs("Space opera", parentID).
+		WillReturnError(&pgconn.PgError{Code: pgCodeForeignKeyViolation})
+
+	_, err := repo.Create(ctx, entities.Genre{Name: "Space opera", ParentID: &parentID})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, errors.Is(err, errs.ErrInvalidInput))
+}
+
+func TestGenre_Create_Success(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+	now := time.Date(2025, 10, 18, 10, 0, 0, 0, time.UTC)
+	parentID := int64(1)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO genres (name,parent_id) VALUES ($1,$2) RETURNING *")).
+		WithArgs("Space opera", parentID).
+		WillReturnRows(sqlmock.NewRows(genreColumns).AddRow(2, "Space opera", parentID, now, now))
+
+	genre, err := repo.Create(ctx, entities.Genre{Name: "Space opera", ParentID: &parentID})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, entities.Genre{ID: 2, Name: "Space opera", ParentID: &parentID, CreatedAt: now, UpdatedAt: now}, genre)
+}
+
+func TestGenre_GetByIDs_ErrorNotFound(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM genres WHERE id IN ($1,$2) ORDER BY id")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows(genreColumns))
+
+	genres, err := repo.GetByIDs(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Nil(t, genres)
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestGenre_GetByIDs_Success(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM genres WHERE id IN ($1,$2) ORDER BY id")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows(genreColumns).
+			AddRow(1, "Fiction", nil, time.Now(), time.Now()).
+			AddRow(2, "Fantasy", 1, time.Now(), time.Now()))
+
+	genres, err := repo.GetByIDs(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, genres, 2)
+	assert.Nil(t, genres[0].ParentID)
+	assert.Equal(t, int64(1), genres[1].GetParentID())
+}
+
+func TestGenre_GetByName_ErrorNotFound(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM genres WHERE LOWER(name) = LOWER($1)")).
+		WithArgs("fantasy").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetByName(ctx, "fantasy")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestGenre_GetByName_Success(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM genres WHERE LOWER(name) = LOWER($1)")).
+		WithArgs("fantasy").
+		WillReturnRows(sqlmock.NewRows(genreColumns).AddRow(2, "Fantasy", nil, time.Now(), time.Now()))
+
+	genre, err := repo.GetByName(ctx, "fantasy")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), genre.ID)
+	assert.Equal(t, "Fantasy", genre.Name)
+}
+
+func TestGenre_List_Success(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM genres ORDER BY id")).
+		WillReturnRows(sqlmock.NewRows(genreColumns).
+			AddRow(1, "Fiction", nil, time.Now(), time.Now()).
+			AddRow(2, "Fantasy", 1, time.Now(), time.Now()))
+
+	genres, err := repo.List(ctx)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Len(t, genres, 2)
+}
+
+func TestGenre_Update_ErrorNotFound(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE genres SET name = $1, parent_id = $2, updated_at = $3 WHERE id = $4 RETURNING *")).
+		WithArgs("Fantasy", nil, sqlmock.AnyArg(), 2).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.Update(ctx, entities.Genre{ID: 2, Name: "Fantasy"})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestGenre_Update_Success(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+	parentID := int64(1)
+
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE genres SET name = $1, parent_id = $2, updated_at = $3 WHERE id = $4 RETURNING *")).
+		WithArgs("Fantasy", parentID, sqlmock.AnyArg(), 2).
+		WillReturnRows(sqlmock.NewRows(genreColumns).AddRow(2, "Fantasy", parentID, time.Now(), time.Now()))
+
+	genre, err := repo.Update(ctx, entities.Genre{ID: 2, Name: "Fantasy", ParentID: &parentID})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, parentID, genre.GetParentID())
+}
+
+func TestGenre_Remove_ErrorNotEqualRowsAffected(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM genres WHERE id IN ($1,$2)")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Remove(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestGenre_Remove_Success(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM genres WHERE id IN ($1,$2)")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.Remove(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func TestGenre_Subtree_Success(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM genres WHERE id IN (WITH RECURSIVE subtree AS (SELECT id FROM genres WHERE id = $1 ")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(5))
+
+	IDs, err := repo.Subtree(ctx, 1)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 5}, IDs)
+}
+
+func TestGenre_CountBooks_Success(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM book WHERE genre_id IN ($1,$2)")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	count, err := repo.CountBooks(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+}
+
+func TestGenre_CountChildren_Success(t *testing.T) {
+	repo, mock, closeDB := newGenreRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM genres WHERE (parent_id IN ($1,$2) AND id NOT IN ($3,$4))")).
+		WithArgs(1, 2, 1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	count, err := repo.CountChildren(ctx, []int64{1, 2})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
@@ -0,0 +1,221 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type idempotencyRepository struct {
+	querier sqlx.ExtContext
+	builder sq.StatementBuilderType
+
+	observ observability.RepositoryObservability
+}
+
+// NewIdempotencyRepository - Constructor IdempotencyRepository
+func NewIdempotencyRepository(querier sqlx.ExtContext, builder sq.StatementBuilderType, observ observability.RepositoryObservability) repositories.IdempotencyRepository {
+	return &idempotencyRepository{querier: querier, builder: builder, observ: observ}
+}
+
+// Reserve - Adds the key without response, a key expired before now is taken over.
+// Returns false when a live key exists. A concurrent transaction with the same key waits on the insert
+// until the first one ends
+func (r *idempotencyRepository) Reserve(ctx context.Context, key entities.IdempotencyKey, now time.Time) (bool, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "idempotencyRepository.reserve")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "insert", "idempotency_key", duration, success)
+	}()
+
+	query, args, err := r.builder.Insert("idempotency_key").
+		Columns("operation", "key", "request_hash", "created_at", "expires_at").
+		Values(key.Operation, key.Key, key.RequestHash, now, key.ExpiresAt).
+		Suffix(`ON CONFLICT (operation, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash, response = NULL, created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+			WHERE idempotency_key.expires_at <= EXCLUDED.created_at RETURNING key`).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return false, errs.Wrap(err, "idempotencyPostgres.Reserve: error builder")
+	}
+
+	var reserved string
+	err = r.querier.QueryRowxContext(ctx, query, args...).Scan(&reserved)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetAttributes([]observability.Attribute{{Key: "key.exists", Value: true}})
+			success = true
+
+			return false, nil
+		}
+
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return false, errs.Wrap(err, "idempotencyPostgres.Reserve: error scanning")
+	}
+
+	success = true
+	return true, nil
+}
+
+// Get - Returns the key
+func (r *idempotencyRepository) Get(ctx context.Context, operation entities.IdempotencyOperation, key string) (entities.IdempotencyKey, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "idempotencyRepository.get")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "select", "idempotency_key", duration, success)
+	}()
+
+	query, args, err := r.builder.Select("*").
+		From("idempotency_key").
+		Where(sq.And{sq.Eq{"operation": operation}, sq.Eq{"key": key}}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return entities.IdempotencyKey{}, errs.Wrap(err, "idempotencyPostgres.Get: error builder")
+	}
+
+	var stored entities.IdempotencyKey
+	err = r.querier.QueryRowxContext(ctx, query, args...).StructScan(&stored)
+	if err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetAttributes([]observability.Attribute{{Key: "len.key.zero", Value: true}})
+
+			return entities.IdempotencyKey{}, errs.Wrap(errs.ErrNotFound, fmt.Sprintf("idempotencyPostgres.Get: key %s", key))
+		}
+
+		span.SetAttributes([]observability.Attribute{{Key: "scan.failed", Value: true}})
+
+		return entities.IdempotencyKey{}, errs.Wrap(err, "idempotencyPostgres.Get: error scanning")
+	}
+
+	success = true
+	return stored, nil
+}
+
+// SaveResponse - Stores the response of the reserved key
+func (r *idempotencyRepository) SaveResponse(ctx context.Context, operation entities.IdempotencyOperation, key string, response []byte) error {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "idempotencyRepository.saveResponse")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "update", "idempotency_key", duration, success)
+	}()
+
+	query, args, err := r.builder.Update("idempotency_key").
+		Set("response", response).
+		Where(sq.And{sq.Eq{"operation": operation}, sq.Eq{"key": key}}).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return errs.Wrap(err, "idempotencyPostgres.SaveResponse: error builder")
+	}
+
+	res, err := r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return errs.Wrap(err, "idempotencyPostgres.SaveResponse: error query")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "rowsAffected.failed", Value: true}})
+
+		return errs.Wrap(err, "idempotencyPostgres.SaveResponse: error get affected rows")
+	}
+
+	if rowsAffected != 1 {
+		span.SetAttributes([]observability.Attribute{{Key: "len.key.noEqual.failed", Value: true}})
+
+		return errs.Wrap(errs.ErrNotFound, fmt.Sprintf("idempotencyPostgres.SaveResponse: key %s", key))
+	}
+
+	success = true
+	return nil
+}
+
+// DeleteExpired - Deletes a batch of the keys expired before now, returns count of deleted keys
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, now time.Time, limit uint64) (int64, error) {
+	var success bool
+	start := time.Now()
+	ctx, span := r.observ.StartSpan(ctx, "idempotencyRepository.deleteExpired")
+
+	defer span.End()
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		r.observ.RecordDatabaseQuery(ctx, "delete", "idempotency_key", duration, success)
+	}()
+
+	batch := sq.Select("operation", "key").
+		From("idempotency_key").
+		Where(sq.Lt{"expires_at": now}).
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := r.builder.Delete("idempotency_key").
+		Where(sq.Expr("(operation, key) IN (?)", batch)).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "toSql.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "idempotencyPostgres.DeleteExpired: error builder")
+	}
+
+	res, err := r.querier.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "execContext.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "idempotencyPostgres.DeleteExpired: error query")
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "rowsAffected.failed", Value: true}})
+
+		return 0, errs.Wrap(err, "idempotencyPostgres.DeleteExpired: error get affected rows")
+	}
+
+	success = true
+	return count, nil
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+func newIdempotencyRepositoryMock(t *testing.T) (repositories.IdempotencyRepository, sqlmock.Sqlmock, func()) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+
+	ctrl := gomock.NewController(t)
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	//createMockMockRepositoryObservability - book_event_postgres_test.go
+	observ := createMockMockRepositoryObservability(ctrl)
+
+	return NewIdempotencyRepository(sqlxDB, builder, observ), mock, func() { mockDB.Close() }
+}
+
+const idempotencyReserveQuery = "INSERT INTO idempotency_key (operation,key,request_hash,created_at,expires_at) VALUES ($1,$2,$3,$4,$5) ON CONFLICT (operation, key) DO UPDATE SET"
+
+func TestIdempotency_Reserve_Success(t *testing.T) {
+	repo, mock, closeDB := newIdempotencyRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+	now := time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC)
+	key := entities.IdempotencyKey{Operation: entities.IdempotencyOperationAddBook, Key: "key-1", RequestHash: "hash", ExpiresAt: now.Add(time.Hour)}
+
+	mock.ExpectQuery(regexp.QuoteMeta(idempotencyReserveQuery)).
+		WithArgs(key.Operation, "key-1", "hash", now, key.ExpiresAt).
+		WillReturnRows(sqlmock.NewRows([]string{"key"}).AddRow("key-1"))
+
+	reserved, err := repo.Reserve(ctx, key, now)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.True(t, reserved)
+}
+
+func TestIdempotency_Reserve_Exists(t *testing.T) {
+	repo, mock, closeDB := newIdempotencyRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+	now := time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC)
+	key := entities.IdempotencyKey{Operation: entities.IdempotencyOperationAddBook, Key: "key-1", RequestHash: "hash", ExpiresAt: now.Add(time.Hour)}
+
+	mock.ExpectQuery(regexp.QuoteMeta(idempotencyReserveQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"key"}))
+
+	reserved, err := repo.Reserve(ctx, key, now)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.False(t, reserved)
+}
+
+func TestIdempotency_Get_ErrorNotFound(t *testing.T) {
+	repo, mock, closeDB := newIdempotencyRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM idempotency_key WHERE (operation = $1 AND key = $2)")).
+		WithArgs(entities.IdempotencyOperationRemoveBook, "key-1").
+		WillReturnError(sql.ErrNoRows)
+
+	key, err := repo.Get(ctx, entities.IdempotencyOperationRemoveBook, "key-1")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.True(t, key.IsEmpty())
+}
+
+func TestIdempotency_SaveResponse_ErrorNotFound(t *testing.T) {
+	repo, mock, closeDB := newIdempotencyRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE idempotency_key SET response = $1 WHERE (operation = $2 AND key = $3)")).
+		WithArgs([]byte(`{"id":1}`), entities.IdempotencyOperationAddBook, "key-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.SaveResponse(ctx, entities.IdempotencyOperationAddBook, "key-1", []byte(`{"id":1}`))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestIdempotency_DeleteExpired_Success(t *testing.T) {
+	repo, mock, closeDB := newIdempotencyRepositoryMock(t)
+	defer closeDB()
+	ctx := context.Background()
+	now := time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM idempotency_key WHERE (operation, key) IN (SELECT operation, key FROM idempotency_key WHERE expires_at < $1 LIMIT 100 FOR UPDATE SKIP LOCKED)")).
+		WithArgs(now).
+		WillReturnResult(sqlmock.NewResult(0, 7))
+
+	count, err := repo.DeleteExpired(ctx, now, 100)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), count)
+}
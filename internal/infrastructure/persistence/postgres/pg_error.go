@@ -0,0 +1,19 @@
+package postgres
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	pgCodeForeignKeyViolation = "23503"
+	pgCodeUniqueViolation     = "23505"
+)
+
+// hasPgCode - reports whether err is a postgres error with the code
+func hasPgCode(err error, code string) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
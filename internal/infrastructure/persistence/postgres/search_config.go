@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	errs "github.com/mathbdw/book/internal/errors"
+)
+
+// ApplySearchConfig - stores the text search config in book_search_settings and rebuilds the search vectors of the books
+// when it is changed, returns the number of the rebuilt books. The book table is locked so no vector is written with the old config
+func ApplySearchConfig(ctx context.Context, db *sqlx.DB, builder sq.StatementBuilderType, config string) (int64, error) {
+	if config == "" {
+		return 0, nil
+	}
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, errs.Wrap(err, "searchConfigPostgres.Apply: error begin")
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, "LOCK TABLE book IN EXCLUSIVE MODE"); err != nil {
+		return 0, errs.Wrap(err, "searchConfigPostgres.Apply: error lock")
+	}
+
+	query, args, err := builder.Select("config::text").
+		From("book_search_settings").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return 0, errs.Wrap(err, "searchConfigPostgres.Apply: error builder current")
+	}
+
+	var current string
+	if err = tx.GetContext(ctx, &current, query, args...); err != nil {
+		return 0, errs.Wrap(err, "searchConfigPostgres.Apply: error query current")
+	}
+
+	if current == config {
+		return 0, nil
+	}
+
+	query, args, err = builder.Update("book_search_settings").
+		Set("config", sq.Expr("?::regconfig", config)).
+		ToSql()
+	if err != nil {
+		return 0, errs.Wrap(err, "searchConfigPostgres.Apply: error builder update")
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return 0, errs.Wrap(err, "searchConfigPostgres.Apply: error query update")
+	}
+
+	var rebuilt int64
+	if err = tx.GetContext(ctx, &rebuilt, "SELECT book_search_rebuild()"); err != nil {
+		return 0, errs.Wrap(err, "searchConfigPostgres.Apply: error rebuild")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, errs.Wrap(err, "searchConfigPostgres.Apply: error commit")
+	}
+
+	return rebuilt, nil
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSearchConfigMock(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock, sq.StatementBuilderType) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err, "Error create mock")
+	t.Cleanup(func() { mockDB.Close() })
+
+	return sqlx.NewDb(mockDB, "sqlmock"), mock, sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+}
+
+func TestApplySearchConfig_Changed(t *testing.T) {
+	db, mock, builder := newSearchConfigMock(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("LOCK TABLE book IN EXCLUSIVE MODE")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT config::text FROM book_search_settings FOR UPDATE")).
+		WillReturnRows(sqlmock.NewRows([]string{"config"}).AddRow("english"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_search_settings SET config = $1::regconfig")).
+		WithArgs("russian").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT book_search_rebuild()")).
+		WillReturnRows(sqlmock.NewRows([]string{"book_search_rebuild"}).AddRow(int64(3)))
+	mock.ExpectCommit()
+
+	rebuilt, err := ApplySearchConfig(context.Background(), db, builder, "russian")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), rebuilt)
+}
+
+func TestApplySearchConfig_Unchanged(t *testing.T) {
+	db, mock, builder := newSearchConfigMock(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("LOCK TABLE book IN EXCLUSIVE MODE")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT config::text FROM book_search_settings FOR UPDATE")).
+		WillReturnRows(sqlmock.NewRows([]string{"config"}).AddRow("english"))
+	mock.ExpectRollback()
+
+	rebuilt, err := ApplySearchConfig(context.Background(), db, builder, "english")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Zero(t, rebuilt)
+}
+
+func TestApplySearchConfig_Empty(t *testing.T) {
+	db, mock, builder := newSearchConfigMock(t)
+
+	rebuilt, err := ApplySearchConfig(context.Background(), db, builder, "")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Zero(t, rebuilt)
+}
+
+func TestApplySearchConfig_ErrorUpdate(t *testing.T) {
+	db, mock, builder := newSearchConfigMock(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("LOCK TABLE book IN EXCLUSIVE MODE")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT config::text FROM book_search_settings FOR UPDATE")).
+		WillReturnRows(sqlmock.NewRows([]string{"config"}).AddRow("english"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE book_search_settings SET config = $1::regconfig")).
+		WithArgs("klingon").
+		WillReturnError(errors.New(`text search configuration "klingon" does not exist`))
+	mock.ExpectRollback()
+
+	_, err := ApplySearchConfig(context.Background(), db, builder, "klingon")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "searchConfigPostgres.Apply: error query update")
+}
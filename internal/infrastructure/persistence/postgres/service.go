@@ -10,8 +10,9 @@ import (
 type servicePagination struct{}
 
 type ServicePagination interface {
-	CreateCursor(model entities.Paginatable, field string) (string, error)
+	CreateCursor(model entities.Paginatable, params entities.PaginationParams, backward bool) (string, error)
 	CreatePageInfo(model []entities.Paginatable, params entities.PaginationParams) (entities.PageInfo, error)
+	CreateForwardPageInfo(model []entities.Paginatable, params entities.PaginationParams) (entities.PageInfo, error)
 }
 
 // NewService - Constructor servicePagination
@@ -19,41 +20,98 @@ func NewService() ServicePagination {
 	return &servicePagination{}
 }
 
-// CreateCursor - Creates a cursor from a Paginatable field
-func (s *servicePagination) CreateCursor(model entities.Paginatable, field string) (string, error) {
-	value, err := model.GetFieldAsString(field)
+// CreateCursor - Creates a cursor from the sort fields of a Paginatable bound to the sort and the filter of params,
+// backward creates the cursor of the previous page
+func (s *servicePagination) CreateCursor(model entities.Paginatable, params entities.PaginationParams, backward bool) (string, error) {
+	sort := params.SortFields()
+	keys := make([]any, 0, len(sort))
+	for _, field := range sort {
+		value, err := model.GetFieldAsString(string(field.Field))
+		if err != nil {
+			return "", errors.Wrap(err, fmt.Sprintf("servicePostgres.CreateCursor: field not found - %s", field.Field))
+		}
+		keys = append(keys, value)
+	}
+
+	strCursor, err := EncodeCursor(entities.Cursor{
+		Version:  entities.CursorVersion2,
+		Sort:     sort,
+		Keys:     keys,
+		ID:       model.GetID(),
+		Filter:   params.Filter.Key(),
+		Backward: backward,
+	})
 	if err != nil {
-		return "", errors.Wrap(err, fmt.Sprintf("servicePostgres.CreateCursor: field not found - %s", field))
+		return "", errors.Wrap(err, "servicePostgres.CreateCursor: failed to encode cursor")
+	}
+
+	return strCursor, nil
+}
+
+// CreatePageInfo - Creates the PageInfo from a slice of Paginatable.
+// The slice is in the order of the query and holds up to Limit+1 rows, the extra row means one more page
+// in the direction of reading. A backward page is read in the reversed order, so its first row is the last one shown.
+func (s *servicePagination) CreatePageInfo(model []entities.Paginatable, params entities.PaginationParams) (entities.PageInfo, error) {
+	var pageInfo entities.PageInfo
+
+	hasMore := uint64(len(model)) > params.Limit
+	if hasMore {
+		model = model[:params.Limit]
+	}
+	if len(model) == 0 {
+		return pageInfo, nil
+	}
+
+	first, last := model[0], model[len(model)-1]
+	if params.IsBackward() {
+		first, last = last, first
+		pageInfo.HasPrevious = hasMore
+		pageInfo.HasNext = true
+	} else {
+		pageInfo.HasPrevious = params.Cursor != nil
+		pageInfo.HasNext = hasMore
 	}
 
-	var strCursor string
-	if _, ok := entities.SortTypesUnique[field]; ok {
-		strCursor, err = EncodeCursor(value, nil)
+	var err error
+	if pageInfo.HasNext {
+		pageInfo.NextCursor, err = s.CreateCursor(last, params, false)
 		if err != nil {
-			return "", errors.Wrap(err, "servicePostgres.CreateCursor: failed to encode cursor SortTypesUnique")
+			return entities.PageInfo{}, errors.Wrap(err, "servicePostgres.CreatePageInfo: error nextCursor")
 		}
-	} else {
-		createdAt := model.GetCreatedAt()
-		strCursor, err = EncodeCursor(value, &createdAt)
+	}
+
+	if pageInfo.HasPrevious {
+		pageInfo.PrevCursor, err = s.CreateCursor(first, params, true)
 		if err != nil {
-			return "", errors.Wrap(err, "servicePostgres.CreateCursor: failed to encode cursor")
+			return entities.PageInfo{}, errors.Wrap(err, "servicePostgres.CreatePageInfo: error prevCursor")
 		}
 	}
 
-	return strCursor, nil
+	return pageInfo, nil
 }
 
-// CreatePageInfo - Creates the PageInfo from a slice of Paginatable
-func (s *servicePagination) CreatePageInfo(model []entities.Paginatable, params entities.PaginationParams) (entities.PageInfo, error) {
-	var nextCursor string
-	var err error
+// CreateForwardPageInfo - Creates the PageInfo of a query that only pages forward, the previous page has no cursor
+func (s *servicePagination) CreateForwardPageInfo(model []entities.Paginatable, params entities.PaginationParams) (entities.PageInfo, error) {
+	var pageInfo entities.PageInfo
+
+	hasMore := uint64(len(model)) > params.Limit
+	if hasMore {
+		model = model[:params.Limit]
+	}
+	if len(model) == 0 {
+		return pageInfo, nil
+	}
 
-	if uint64(len(model)) > params.Limit {
-		nextCursor, err = s.CreateCursor(model[params.Limit-1], string(params.SortBy))
+	pageInfo.HasPrevious = params.Cursor != nil
+	pageInfo.HasNext = hasMore
+
+	var err error
+	if pageInfo.HasNext {
+		pageInfo.NextCursor, err = s.CreateCursor(model[len(model)-1], params, false)
 		if err != nil {
-			return entities.PageInfo{}, errors.Wrap(err, "servicePostgres.CreatePageInfo: error nextCursor")
+			return entities.PageInfo{}, errors.Wrap(err, "servicePostgres.CreateForwardPageInfo: error nextCursor")
 		}
 	}
 
-	return entities.PageInfo{NextCursor: nextCursor}, nil
+	return pageInfo, nil
 }
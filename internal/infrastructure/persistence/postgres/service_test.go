@@ -1,8 +1,8 @@
 package postgres
 
 import (
-	"encoding/base64"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -15,60 +15,52 @@ func TestBookPagination_CreateCursor_Error(t *testing.T) {
 	book := entities.Book{ID: 1, Title: "test", Year: 1900, CreatedAt: time.Now()}
 	service := NewService()
 
-	_, err := service.CreateCursor(book, "noField")
+	_, err := service.CreateCursor(book, entities.PaginationParams{SortBy: "noField"}, false)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "servicePostgres.CreateCursor: field not found")
 }
 
-func TestBookPagination_CreateCursor_ErrorFailedEncodingTwoField(t *testing.T) {
-	book := entities.Book{ID: 1, Title: "", Year: 1900, CreatedAt: time.Now()}
-	service := NewService()
-
-	_, err := service.CreateCursor(book, "title")
-
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "servicePostgres.CreateCursor: failed to encode cursor")
-}
-
-func TestBookPagination_CreateCursor_ErrorFailedEncodingOneField(t *testing.T) {
+func TestBookPagination_CreateCursor_ErrorFailedEncoding(t *testing.T) {
 	book := entities.Book{ID: 0, Title: "Test", Year: 1900, CreatedAt: time.Now()}
 	service := NewService()
 
-	_, err := service.CreateCursor(book, "id")
+	_, err := service.CreateCursor(book, entities.PaginationParams{SortBy: "id"}, false)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "servicePostgres.CreateCursor: failed to encode cursor")
 }
 
 func TestBookPagination_CreateCursor_Success(t *testing.T) {
-	now := time.Now()
-	book := entities.Book{ID: 1, Title: "test", Year: 1900, CreatedAt: now}
+	book := entities.Book{ID: 1, Title: "Dune: Messiah", Year: 1900, GenreID: 2, CreatedAt: time.Now()}
 	service := NewService()
 
 	tests := []struct {
-		name  string
-		field string
-		value string
+		name   string
+		params entities.PaginationParams
+		value  string
 	}{
-		{"ById", "id",
-			base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(book.ID))),
+		{"ById", entities.PaginationParams{SortBy: "id", SortOrder: entities.SortOrderTypeAsc},
+			`{"v":2,"s":["id"],"k":["1"],"id":1,"f":"%s"}`,
+		},
+		{"ByTitle", entities.PaginationParams{SortBy: "title", SortOrder: entities.SortOrderTypeDesc},
+			`{"v":2,"s":["-title"],"k":["Dune: Messiah"],"id":1,"f":"%s"}`,
 		},
-		{"ByTitle", "title",
-			base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d", book.Title, now.UnixNano()))),
+		{"ByGenreThenYear", entities.PaginationParams{
+			SortBy:    "genre_id",
+			SortOrder: entities.SortOrderTypeAsc,
+			ThenBy:    []entities.SortField{{Field: "year", Order: entities.SortOrderTypeDesc}},
 		},
-		{"ByYear", "year",
-			base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", book.Year, now.UnixNano()))),
+			`{"v":2,"s":["genre_id","-year"],"k":["2","1900"],"id":1,"f":"%s"}`,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-
-			strCursor, err := service.CreateCursor(book, tt.field)
+			strCursor, err := service.CreateCursor(book, tt.params, false)
 
 			assert.NoError(t, err)
-			assert.Equal(t, tt.value, strCursor)
+			assert.Equal(t, fmt.Sprintf(tt.value, entities.BookFilter{}.Key()), decodeRawURL(t, strCursor))
 		})
 	}
 }
@@ -116,8 +108,8 @@ func TestPageInfo_Create_SuccessEmptyPrevCursor(t *testing.T) {
 	}{
 		{"emptyPrevAndNext", nil, 5, false, false},
 		{"emptyPrevAndYesNext", nil, 3, false, true},
-		{"yesPrevAndEmptyNext", &entities.Cursor{Value: 5}, 5, true, false},
-		{"yesPrevAndNext", &entities.Cursor{Value: 5}, 3, true, true},
+		{"yesPrevAndEmptyNext", &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"5"}, ID: 5}, 5, true, false},
+		{"yesPrevAndNext", &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"5"}, ID: 5}, 3, true, true},
 	}
 
 	for _, tt := range tests {
@@ -131,6 +123,92 @@ func TestPageInfo_Create_SuccessEmptyPrevCursor(t *testing.T) {
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.wantNextCursor, len(pageInfo.NextCursor) > 0)
+			assert.Equal(t, tt.wantNextCursor, pageInfo.HasNext)
+			assert.Equal(t, tt.wantPrevCursor, len(pageInfo.PrevCursor) > 0)
+			assert.Equal(t, tt.wantPrevCursor, pageInfo.HasPrevious)
+		})
+	}
+}
+
+func TestPageInfo_Create_CursorBoundToFilter(t *testing.T) {
+	service := NewService()
+	filter := entities.BookFilter{GenreID: 2, YearFrom: 1900}
+
+	pageInfo, err := service.CreatePageInfo(ConvertPaginatable(books), entities.PaginationParams{
+		Limit:     3,
+		SortBy:    "title",
+		SortOrder: entities.SortOrderTypeAsc,
+		Filter:    filter,
+	})
+	assert.NoError(t, err)
+
+	cursor, err := DecodeCursor(pageInfo.NextCursor)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"Title5"}, cursor.Keys)
+	assert.Equal(t, int64(5), cursor.ID)
+	assert.Equal(t, filter.Key(), cursor.Filter)
+}
+
+func TestPageInfo_Create_Cursors(t *testing.T) {
+	service := NewService()
+	reversed := slices.Clone(books)
+	slices.Reverse(reversed)
+
+	tests := []struct {
+		name     string
+		model    []entities.Book
+		cursor   *entities.Cursor
+		wantPrev string
+		wantNext string
+	}{
+		// read 3, 4, 5 after the cursor, 6 is the extra row
+		{"Forward", books, &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"2"}, ID: 2}, "3", "5"},
+		// read 6, 5, 4 before the cursor, 3 is the extra row, the page is shown as 4, 5, 6
+		{"Backward", reversed, &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"7"}, ID: 7, Backward: true}, "4", "6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pageInfo, err := service.CreatePageInfo(ConvertPaginatable(tt.model), entities.PaginationParams{
+				Cursor:    tt.cursor,
+				Limit:     3,
+				SortBy:    "id",
+				SortOrder: entities.SortOrderTypeAsc,
+			})
+			assert.NoError(t, err)
+			assert.True(t, pageInfo.HasPrevious)
+			assert.True(t, pageInfo.HasNext)
+
+			prev, err := DecodeCursor(pageInfo.PrevCursor)
+			assert.NoError(t, err)
+			assert.Equal(t, []any{tt.wantPrev}, prev.Keys)
+			assert.True(t, prev.Backward)
+
+			next, err := DecodeCursor(pageInfo.NextCursor)
+			assert.NoError(t, err)
+			assert.Equal(t, []any{tt.wantNext}, next.Keys)
+			assert.False(t, next.Backward)
 		})
 	}
 }
+
+func TestPageInfo_CreateForward_NoPrevCursor(t *testing.T) {
+	service := NewService()
+
+	pageInfo, err := service.CreateForwardPageInfo(ConvertPaginatable(books), entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"2"}, ID: 2},
+		Limit:     3,
+		SortBy:    "id",
+		SortOrder: entities.SortOrderTypeAsc,
+	})
+	assert.NoError(t, err)
+	assert.True(t, pageInfo.HasPrevious)
+	assert.Empty(t, pageInfo.PrevCursor)
+	assert.True(t, pageInfo.HasNext)
+
+	next, err := DecodeCursor(pageInfo.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"5"}, next.Keys)
+	assert.False(t, next.Backward)
+}
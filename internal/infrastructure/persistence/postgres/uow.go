@@ -31,8 +31,14 @@ func (uow *unitOfWork) Do(ctx context.Context, fn func(repo *repositories.Reposi
 	defer tx.Rollback()
 
 	repos := &repositories.Repository{
-		Book:      NewBookRepository(tx, uow.builder, uow.observ),
-		BookEvent: NewBookEventRepository(tx, uow.builder, uow.observ),
+		Book:         NewBookRepository(tx, uow.builder, uow.observ),
+		BookEvent:    NewBookEventRepository(tx, uow.builder, uow.observ),
+		BookHistory:  NewBookHistoryRepository(tx, uow.builder, uow.observ),
+		BookRedirect: NewBookRedirectRepository(tx, uow.builder, uow.observ),
+		Author:       NewAuthorRepository(tx, uow.builder, uow.observ),
+		Genre:        NewGenreRepository(tx, uow.builder, uow.observ),
+
+		Idempotency: NewIdempotencyRepository(tx, uow.builder, uow.observ),
 	}
 
 	err = fn(repos)
@@ -60,16 +60,16 @@ func TestUnitOfWork_Do_ErrorExecutingFunction(t *testing.T) {
 		Title:       "Test Book",
 		Description: "Test Description",
 		Year:        1904,
-		Genre:       "Test Genre",
+		GenreID:     3,
 	}
-	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO book (description,genre,title,year) VALUES ($1,$2,$3,$4) RETURNING id`)).
-		WithArgs("Test Description", "Test Genre", "Test Book", 1904).
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO book (description,genre_id,isbn,title,year) VALUES ($1,$2,$3,$4,$5) RETURNING `+bookColumnsSQL)).
+		WithArgs("Test Description", 3, "", "Test Book", 1904).
 		WillReturnError(errors.New("error"))
 
 	mock.ExpectRollback()
 
 	err = uow.Do(ctx, func(repo *repositories.Repository) error {
-		book.ID, err = repo.Book.Create(ctx, book)
+		book, err = repo.Book.Create(ctx, book)
 		if err != nil {
 			return err
 		}
@@ -101,12 +101,12 @@ func TestUnitOfWork_Do_Success(t *testing.T) {
 		Title:       "Test Book",
 		Description: "Test Description",
 		Year:        1904,
-		Genre:       "Test Genre",
+		GenreID:     3,
 	}
 	strBook, _ := json.Marshal(book)
 
-	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO book (description,genre,title,year) VALUES ($1,$2,$3,$4) RETURNING id`)).
-		WithArgs("Test Description", "Test Genre", "Test Book", 1904).
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO book (description,genre_id,isbn,title,year) VALUES ($1,$2,$3,$4,$5) RETURNING `+bookColumnsSQL)).
+		WithArgs("Test Description", 3, "", "Test Book", 1904).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO book_event (book_id,payload,status,type) VALUES ($1,$2,$3,$4) RETURNING id`)).
 		WithArgs(1, strBook, entities.EventStatusNew, entities.Created).
@@ -115,7 +115,7 @@ func TestUnitOfWork_Do_Success(t *testing.T) {
 	mock.ExpectCommit()
 
 	err = uow.Do(ctx, func(repo *repositories.Repository) error {
-		book.ID, err = repo.Book.Create(ctx, book)
+		book, err = repo.Book.Create(ctx, book)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,45 @@
+package converters
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// AuthorIDsToAuthors - converts IDs of the authors to entities.Author
+func AuthorIDsToAuthors(IDs []int64) []entities.Author {
+	if len(IDs) == 0 {
+		return nil
+	}
+
+	authors := make([]entities.Author, 0, len(IDs))
+	for _, id := range IDs {
+		authors = append(authors, entities.Author{ID: id})
+	}
+
+	return authors
+}
+
+// AuthorToProtoAuthor - converts entities.Author to pb.Author
+func AuthorToProtoAuthor(author *entities.Author) *pb.Author {
+	return &pb.Author{
+		Id:        author.ID,
+		Name:      author.Name,
+		CreatedAt: timestamppb.New(author.CreatedAt),
+	}
+}
+
+// AuthorsToProtoAuthors - converts slice entities.Author to slice pb.Author
+func AuthorsToProtoAuthors(authors []entities.Author) []*pb.Author {
+	if len(authors) == 0 {
+		return nil
+	}
+
+	pbAuthors := make([]*pb.Author, 0, len(authors))
+	for i := range authors {
+		pbAuthors = append(pbAuthors, AuthorToProtoAuthor(&authors[i]))
+	}
+
+	return pbAuthors
+}
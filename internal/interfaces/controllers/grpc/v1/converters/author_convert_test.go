@@ -0,0 +1,29 @@
+package converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+)
+
+func TestAuthorIDsToAuthors(t *testing.T) {
+	assert.Nil(t, AuthorIDsToAuthors(nil))
+	assert.Equal(t, []entities.Author{{ID: 7}, {ID: 2}}, AuthorIDsToAuthors([]int64{7, 2}))
+}
+
+func TestAuthorsToProtoAuthors(t *testing.T) {
+	createdAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	authors := []entities.Author{{ID: 7, Name: "First", CreatedAt: createdAt}, {ID: 2, Name: "Second"}}
+
+	res := AuthorsToProtoAuthors(authors)
+
+	assert.Len(t, res, 2)
+	assert.Equal(t, int64(7), res[0].GetId())
+	assert.Equal(t, "First", res[0].GetName())
+	assert.Equal(t, createdAt, res[0].GetCreatedAt().AsTime())
+	assert.Equal(t, "Second", res[1].GetName())
+	assert.Nil(t, AuthorsToProtoAuthors(nil))
+}
@@ -1,6 +1,14 @@
 package converters
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/mathbdw/book/internal/domain/entities"
 	errs "github.com/mathbdw/book/internal/errors"
 	"github.com/mathbdw/book/internal/infrastructure/persistence/postgres"
@@ -12,19 +20,176 @@ func BookAddRequestToBook(req *pb.BookAddRequest) entities.Book {
 	return entities.Book{
 		Title:       req.GetTitle(),
 		Description: req.Description,
-		Genre:       req.GetGenre(),
+		GenreID:     req.GetGenreId(),
+		Genre:       deprecatedGenre(req.GetGenreId(), req.GetGenre()),
+		Year:        int(req.GetYear()),
+		Authors:     AuthorIDsToAuthors(req.GetAuthorIds()),
+		ISBN:        isbnOrRaw(req.GetIsbn()),
+	}
+}
+
+// ValidateBookAddRequest - validates pb.BookAddRequest including the ISBN checksum and the genre
+func ValidateBookAddRequest(req *pb.BookAddRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	if req.GetGenreId() == 0 && req.GetGenre() == "" {
+		return errs.Wrap(errs.ErrInvalidInput, "genre_id is required")
+	}
+
+	_, err := ISBNToBookISBN(req.GetIsbn())
+
+	return err
+}
+
+// ISBNToBookISBN - validates the ISBN of the request and converts it to ISBN-13, an empty ISBN stays empty
+func ISBNToBookISBN(isbn string) (string, error) {
+	if isbn == "" {
+		return "", nil
+	}
+
+	normalized, err := entities.NormalizeISBN(isbn)
+	if err != nil {
+		return "", errs.Wrap(errs.ErrInvalidInput, fmt.Sprintf("invalid isbn %s: %s", isbn, err))
+	}
+
+	return normalized, nil
+}
+
+// isbnOrRaw - returns the ISBN converted to ISBN-13 or as is when it is invalid
+func isbnOrRaw(isbn string) string {
+	normalized, err := ISBNToBookISBN(isbn)
+	if err != nil {
+		return isbn
+	}
+
+	return normalized
+}
+
+// BookUpdateRequestToBook - converts pb.BookUpdateRequest to entities.Book.
+func BookUpdateRequestToBook(req *pb.BookUpdateRequest) entities.Book {
+	return entities.Book{
+		ID:          req.GetId(),
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		GenreID:     req.GetGenreId(),
+		Genre:       deprecatedGenre(req.GetGenreId(), req.GetGenre()),
 		Year:        int(req.GetYear()),
+		Authors:     AuthorIDsToAuthors(req.GetAuthorIds()),
+		ISBN:        isbnOrRaw(req.GetIsbn()),
 	}
 }
 
+// deprecatedGenre - returns the name of the deprecated genre field when genre_id is not set,
+// the usecase resolves the name to genre_id
+func deprecatedGenre(genreID int64, genre string) string {
+	if genreID != 0 {
+		return ""
+	}
+
+	return genre
+}
+
+// _deprecatedGenrePath - the update_mask path of the deprecated genre field of pb.BookUpdateRequest
+const _deprecatedGenrePath = "genre"
+
+// UpdateMaskToBookFields - converts the update mask of pb.BookUpdateRequest to the book fields.
+// An empty mask selects all updatable fields, the authors and the ISBN only when they are set.
+// Every selected field is written with its value in the request, title, year and genre_id (or genre) must not be empty:
+// an empty description or isbn clears it, an empty author_ids removes the authors.
+func UpdateMaskToBookFields(req *pb.BookUpdateRequest) ([]entities.BookField, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = make([]string, 0, len(entities.BookUpdatableFields))
+		for _, field := range entities.BookUpdatableFields {
+			if field == entities.BookFieldAuthors && len(req.GetAuthorIds()) == 0 {
+				continue
+			}
+			if field == entities.BookFieldISBN && req.GetIsbn() == "" {
+				continue
+			}
+			paths = append(paths, string(field))
+		}
+	}
+
+	book := BookUpdateRequestToBook(req)
+	fields := make([]entities.BookField, 0, len(paths))
+	for _, path := range paths {
+		field := entities.BookField(path)
+		// the path of the deprecated genre field selects genre_id
+		if path == _deprecatedGenrePath {
+			field = entities.BookFieldGenre
+		}
+		if !field.IsUpdatable() {
+			return nil, errs.Wrap(errs.ErrInvalidInput, fmt.Sprintf("unknown field in update_mask: %s", path))
+		}
+
+		if slices.Contains(fields, field) {
+			continue
+		}
+
+		if field == entities.BookFieldAuthors {
+			fields = append(fields, field)
+			continue
+		}
+
+		// every selected field is written, the empty value of an optional field clears it
+		if field.IsRequired() {
+			value, _ := book.GetFieldValue(field)
+			if field == entities.BookFieldGenre && book.Genre != "" {
+				value = book.Genre
+			}
+			if value == "" || value == 0 || value == int64(0) {
+				return nil, errs.Wrap(errs.ErrInvalidInput, fmt.Sprintf("field %s must not be empty", path))
+			}
+		}
+
+		if field == entities.BookFieldISBN && req.GetIsbn() != "" {
+			if _, err := ISBNToBookISBN(req.GetIsbn()); err != nil {
+				return nil, err
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
 // BookToProtoBook - converts entities.Book to pb.Book
 func BookToProtoBook(book *entities.Book) *pb.Book {
 	return &pb.Book{
 		Id:          book.ID,
 		Title:       book.Title,
 		Description: book.Description,
+		GenreId:     book.GenreID,
 		Genre:       book.Genre,
 		Year:        int32(book.Year),
+		CreatedAt:   timestamppb.New(book.CreatedAt),
+		Authors:     AuthorsToProtoAuthors(book.Authors),
+		Isbn:        book.ISBN,
+		Version:     book.Version,
+	}
+}
+
+// EventTypeToProtoBookChangeType - converts entities.EventType of the book history to pb.BookChangeType
+func EventTypeToProtoBookChangeType(eventType entities.EventType) pb.BookChangeType {
+	switch eventType {
+	case entities.Created:
+		return pb.BookChangeType_BOOK_CHANGE_TYPE_CREATED
+	case entities.Updated:
+		return pb.BookChangeType_BOOK_CHANGE_TYPE_UPDATED
+	case entities.Deleted:
+		return pb.BookChangeType_BOOK_CHANGE_TYPE_DELETED
+	case entities.Restored:
+		return pb.BookChangeType_BOOK_CHANGE_TYPE_RESTORED
+	case entities.Merged:
+		return pb.BookChangeType_BOOK_CHANGE_TYPE_MERGED
+	case entities.Purged:
+		return pb.BookChangeType_BOOK_CHANGE_TYPE_PURGED
+	default:
+		return pb.BookChangeType_BOOK_CHANGE_TYPE_UNSPECIFIED
 	}
 }
 
@@ -35,18 +200,98 @@ func CursorPaginationToPaginationParams(req *pb.BookListRequest_CursorPagination
 	if req.GetCursor() != "" {
 		cursor, err = postgres.DecodeCursor(req.GetCursor())
 		if err != nil {
-			return entities.PaginationParams{}, errs.New("invalid cursor")
+			return entities.PaginationParams{}, cursorError(err)
 		}
 	}
 
+	thenBy := make([]entities.SortField, 0, len(req.GetThenBy()))
+	for _, sort := range req.GetThenBy() {
+		thenBy = append(thenBy, entities.SortField{
+			Field: entities.CursorType(sort.GetField()),
+			Order: entities.SortOrderType(sort.GetOrder()),
+		})
+	}
+
 	return entities.PaginationParams{
-		Limit:     req.GetPageSize(),
-		Cursor:    cursor,
-		SortBy:    entities.CursorType(req.GetSortBy()),
-		SortOrder: entities.SortOrderType(req.GetSortOrder()),
+		Limit:          req.GetPageSize(),
+		Cursor:         cursor,
+		SortBy:         entities.CursorType(req.GetSortBy()),
+		SortOrder:      entities.SortOrderType(req.GetSortOrder()),
+		ThenBy:         thenBy,
+		WithTotalCount: req.GetWithTotalCount(),
 	}, nil
 }
 
+// ListRequestToBookFilter - converts filters of pb.BookListRequest to BookFilter entities
+func ListRequestToBookFilter(req *pb.BookListRequest) (entities.BookFilter, error) {
+	filter := entities.BookFilter{
+		AuthorID:       req.GetAuthorId(),
+		GenreID:        req.GetGenreId(),
+		YearFrom:       int(req.GetYearFrom()),
+		YearTo:         int(req.GetYearTo()),
+		TitlePrefix:    strings.TrimSpace(req.GetTitlePrefix()),
+		IncludeRemoved: req.GetIncludeRemoved(),
+	}
+
+	asOf, err := AsOfToTime(req.GetAsOf())
+	if err != nil {
+		return entities.BookFilter{}, err
+	}
+	filter.AsOf = asOf
+
+	if filter.YearFrom > 0 && filter.YearTo > 0 && filter.YearFrom > filter.YearTo {
+		return entities.BookFilter{}, errs.Wrap(errs.ErrInvalidInput, fmt.Sprintf("year_from %d is greater than year_to %d", filter.YearFrom, filter.YearTo))
+	}
+
+	return filter, nil
+}
+
+// AsOfToTime - converts the as_of timestamp of the request, zero time when it is not set
+func AsOfToTime(asOf *timestamppb.Timestamp) (time.Time, error) {
+	if asOf == nil {
+		return time.Time{}, nil
+	}
+
+	if err := asOf.CheckValid(); err != nil {
+		return time.Time{}, errs.Wrap(errs.ErrInvalidInput, fmt.Sprintf("as_of: %s", err))
+	}
+
+	return asOf.AsTime(), nil
+}
+
+// SearchRequestToPaginationParams - converts pagination of pb.BookSearchRequest to PaginationParams entities, ordered by rank.
+// The search only pages forward.
+func SearchRequestToPaginationParams(req *pb.BookSearchRequest) (entities.PaginationParams, error) {
+	params := entities.PaginationParams{
+		Limit:     req.GetPageSize(),
+		SortBy:    entities.CursorTypeBookRank,
+		SortOrder: entities.SortOrderTypeDesc,
+	}
+
+	if req.GetCursor() != "" {
+		cursor, err := postgres.DecodeCursor(req.GetCursor())
+		if err != nil {
+			return entities.PaginationParams{}, cursorError(err)
+		}
+
+		if cursor.Backward || !cursor.MatchesSort(params.SortFields()) {
+			return entities.PaginationParams{}, errs.New("invalid cursor")
+		}
+		params.Cursor = cursor
+	}
+
+	return params, nil
+}
+
+// cursorError - hides the details of the decoding error, only the expiry is reported to the client
+func cursorError(err error) error {
+	if errors.Is(err, postgres.ErrCursorExpired) {
+		return errs.New("cursor expired")
+	}
+
+	return errs.New("invalid cursor")
+}
+
 // LevelToZerolog - converts string to int8 Level zerolog
 func LevelToZerolog(level string) (int8, error) {
 	switch level {
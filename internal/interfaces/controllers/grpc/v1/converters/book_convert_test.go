@@ -1,13 +1,19 @@
 package converters
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"testing"
 	"time"
 
 	"github.com/mathbdw/book/internal/domain/entities"
 	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/infrastructure/persistence/postgres"
 	pb "github.com/mathbdw/book/proto"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestBookAddRequestToBook(t *testing.T) {
@@ -15,13 +21,13 @@ func TestBookAddRequestToBook(t *testing.T) {
 		Title:       "Test",
 		Description: "Desc",
 		Year:        1900,
-		Genre:       "Genre",
+		GenreID:     3,
 	}
 	req := pb.BookAddRequest{
 		Title:       book.Title,
 		Description: book.Description,
 		Year:        int32(book.Year),
-		Genre:       book.Genre,
+		GenreId:     book.GenreID,
 	}
 
 	res := BookAddRequestToBook(&req)
@@ -29,7 +35,173 @@ func TestBookAddRequestToBook(t *testing.T) {
 	assert.Equal(t, book.Title, res.Title)
 	assert.Equal(t, book.Description, res.Description)
 	assert.Equal(t, book.Year, res.Year)
-	assert.Equal(t, book.Genre, res.Genre)
+	assert.Equal(t, book.GenreID, res.GenreID)
+	assert.Empty(t, res.Authors)
+
+	req.AuthorIds = []int64{7, 2}
+	res = BookAddRequestToBook(&req)
+
+	assert.Equal(t, []int64{7, 2}, res.AuthorIDs())
+
+	req.Isbn = "0-306-40615-2"
+	res = BookAddRequestToBook(&req)
+
+	assert.Equal(t, "9780306406157", res.ISBN)
+}
+
+func TestValidateBookAddRequest(t *testing.T) {
+	req := pb.BookAddRequest{Title: "Test", Description: "Desc", Year: 1900, GenreId: 3}
+
+	assert.NoError(t, ValidateBookAddRequest(&req))
+
+	req.Isbn = "978-0-306-40615-7"
+	assert.NoError(t, ValidateBookAddRequest(&req))
+
+	req.Isbn = "978-0-306-40615-8"
+	err := ValidateBookAddRequest(&req)
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "invalid isbn")
+
+	req.Isbn = "isbn"
+	assert.Error(t, ValidateBookAddRequest(&req))
+}
+
+func TestValidateBookAddRequest_DeprecatedGenre(t *testing.T) {
+	req := pb.BookAddRequest{Title: "Test", Description: "Desc", Year: 1900}
+
+	err := ValidateBookAddRequest(&req)
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "genre_id is required")
+
+	req.Genre = "Adventure"
+	assert.NoError(t, ValidateBookAddRequest(&req))
+	assert.Equal(t, entities.Book{Title: "Test", Description: "Desc", Year: 1900, Genre: "Adventure"}, BookAddRequestToBook(&req))
+
+	req.GenreId = 3
+	assert.Equal(t, entities.Book{Title: "Test", Description: "Desc", Year: 1900, GenreID: 3}, BookAddRequestToBook(&req))
+}
+
+func TestISBNToBookISBN(t *testing.T) {
+	isbn, err := ISBNToBookISBN("")
+	assert.NoError(t, err)
+	assert.Empty(t, isbn)
+
+	isbn, err = ISBNToBookISBN("0306406152")
+	assert.NoError(t, err)
+	assert.Equal(t, "9780306406157", isbn)
+
+	isbn, err = ISBNToBookISBN("0306406153")
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.Empty(t, isbn)
+}
+
+func TestBookUpdateRequestToBook(t *testing.T) {
+	book := entities.Book{
+		ID:          1,
+		Title:       "Test",
+		Description: "Desc",
+		Year:        1900,
+		GenreID:     3,
+	}
+	req := pb.BookUpdateRequest{
+		Id:          book.ID,
+		Title:       book.Title,
+		Description: book.Description,
+		Year:        int32(book.Year),
+		GenreId:     book.GenreID,
+	}
+
+	res := BookUpdateRequestToBook(&req)
+
+	assert.Equal(t, book, res)
+}
+
+func TestUpdateMaskToBookFields_Error(t *testing.T) {
+	tests := []struct {
+		name             string
+		req              *pb.BookUpdateRequest
+		wantErrorContent string
+	}{
+		{"UnknownField", &pb.BookUpdateRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"removed"}}}, "unknown field"},
+		{"EmptyField", &pb.BookUpdateRequest{Id: 1, Title: "Test", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "year"}}}, "field year must not be empty"},
+		{"EmptyMaskEmptyField", &pb.BookUpdateRequest{Id: 1, Title: "Test"}, "must not be empty"},
+		{"EmptyGenre", &pb.BookUpdateRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"genre_id"}}}, "field genre_id must not be empty"},
+		{"InvalidISBN", &pb.BookUpdateRequest{Id: 1, Isbn: "9780306406158", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"isbn"}}}, "invalid isbn"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := UpdateMaskToBookFields(tt.req)
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErrorContent)
+			assert.Nil(t, fields)
+		})
+	}
+}
+
+func TestUpdateMaskToBookFields_Success(t *testing.T) {
+	fields, err := UpdateMaskToBookFields(&pb.BookUpdateRequest{
+		Id:          1,
+		Description: "Desc",
+		GenreId:     3,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description", "genre_id", "description"}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.BookField{entities.BookFieldDescription, entities.BookFieldGenre}, fields)
+
+	fields, err = UpdateMaskToBookFields(&pb.BookUpdateRequest{Id: 1, Title: "Test", Description: "Desc", Year: 1900, GenreId: 3, AuthorIds: []int64{3}, Isbn: "0306406152"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.BookUpdatableFields, fields)
+
+	fields, err = UpdateMaskToBookFields(&pb.BookUpdateRequest{Id: 1, Title: "Test", Description: "Desc", Year: 1900, GenreId: 3})
+
+	assert.NoError(t, err)
+	assert.NotContains(t, fields, entities.BookFieldAuthors)
+	assert.NotContains(t, fields, entities.BookFieldISBN)
+
+	fields, err = UpdateMaskToBookFields(&pb.BookUpdateRequest{
+		Id:         1,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"author_ids"}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.BookField{entities.BookFieldAuthors}, fields)
+}
+
+func TestUpdateMaskToBookFields_DeprecatedGenre(t *testing.T) {
+	req := &pb.BookUpdateRequest{
+		Id:         1,
+		Genre:      "Adventure",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"genre"}},
+	}
+
+	fields, err := UpdateMaskToBookFields(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.BookField{entities.BookFieldGenre}, fields)
+	assert.Equal(t, entities.Book{ID: 1, Genre: "Adventure"}, BookUpdateRequestToBook(req))
+
+	req.Genre = ""
+	fields, err = UpdateMaskToBookFields(req)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "field genre must not be empty")
+	assert.Nil(t, fields)
+}
+
+func TestUpdateMaskToBookFields_ClearDescription(t *testing.T) {
+	req := &pb.BookUpdateRequest{
+		Id:         1,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "isbn"}},
+	}
+
+	fields, err := UpdateMaskToBookFields(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.BookField{entities.BookFieldDescription, entities.BookFieldISBN}, fields)
+	assert.Equal(t, "", BookUpdateRequestToBook(req).Description)
 }
 
 func TestBookToProtoBook(t *testing.T) {
@@ -38,14 +210,21 @@ func TestBookToProtoBook(t *testing.T) {
 		Title:       "Test",
 		Description: "Desc",
 		Year:        1900,
+		GenreID:     3,
 		Genre:       "Genre",
+		ISBN:        "9780306406157",
+		Version:     4,
+		CreatedAt:   time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
 	}
 	pbBook := pb.Book{
 		Id:          book.ID,
 		Title:       book.Title,
 		Description: book.Description,
 		Year:        int32(book.Year),
+		GenreId:     book.GenreID,
 		Genre:       book.Genre,
+		Isbn:        book.ISBN,
+		Version:     book.Version,
 	}
 
 	res := BookToProtoBook(&book)
@@ -54,7 +233,11 @@ func TestBookToProtoBook(t *testing.T) {
 	assert.Equal(t, pbBook.Title, res.Title)
 	assert.Equal(t, pbBook.Description, res.Description)
 	assert.Equal(t, pbBook.Year, res.Year)
+	assert.Equal(t, pbBook.GenreId, res.GenreId)
 	assert.Equal(t, pbBook.Genre, res.Genre)
+	assert.Equal(t, pbBook.Isbn, res.Isbn)
+	assert.Equal(t, pbBook.Version, res.Version)
+	assert.Equal(t, book.CreatedAt, res.CreatedAt.AsTime())
 }
 
 func TestCursorPaginationToPaginationParams_Error(t *testing.T) {
@@ -79,7 +262,7 @@ func TestCursorPaginationToPaginationParams_Error(t *testing.T) {
 func TestCursorPaginationToPaginationParams_Success(t *testing.T) {
 	timeCreated := time.Now()
 	cursor := &entities.Cursor{
-		Value:     1,
+		Keys:      []any{1},
 		CreatedAt: &timeCreated,
 	}
 	paginationParams := entities.PaginationParams{
@@ -101,6 +284,134 @@ func TestCursorPaginationToPaginationParams_Success(t *testing.T) {
 	assert.Equal(t, paginationParams.Limit, res.Limit)
 	assert.Equal(t, paginationParams.SortBy, res.SortBy)
 	assert.Equal(t, paginationParams.SortOrder, res.SortOrder)
+	assert.False(t, res.WithTotalCount)
+
+	res, err = CursorPaginationToPaginationParams(&pb.BookListRequest_CursorPagination{PageSize: 2, WithTotalCount: true})
+
+	assert.NoError(t, err)
+	assert.True(t, res.WithTotalCount)
+}
+
+func TestCursorPaginationToPaginationParams_ErrorSignedCursor(t *testing.T) {
+	secret := "0123456789abcdef0123456789abcdef"
+	signer, err := postgres.NewCursorSigner("k1", map[string]string{"k1": secret}, time.Hour, false)
+	assert.NoError(t, err)
+	postgres.SetCursorSigner(signer)
+	defer postgres.SetCursorSigner(nil)
+
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"v":2,"s":["id"],"k":["1"],"id":1,"e":1}`))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload + ".k1"))
+	expired := payload + ".k1." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name    string
+		cursor  string
+		wantErr string
+	}{
+		{"Unsigned", payload, "invalid cursor"},
+		{"Forged", payload + ".k1.c2lnbmF0dXJl", "invalid cursor"},
+		{"Expired", expired, "cursor expired"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := CursorPaginationToPaginationParams(&pb.BookListRequest_CursorPagination{PageSize: 1, Cursor: tt.cursor})
+
+			assert.EqualError(t, err, tt.wantErr)
+			assert.Empty(t, res)
+		})
+	}
+}
+
+func TestListRequestToBookFilter(t *testing.T) {
+	res, err := ListRequestToBookFilter(&pb.BookListRequest{
+		AuthorId:       1,
+		GenreId:        2,
+		YearFrom:       1900,
+		YearTo:         1900,
+		TitlePrefix:    " war ",
+		IncludeRemoved: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.BookFilter{
+		AuthorID:       1,
+		GenreID:        2,
+		YearFrom:       1900,
+		YearTo:         1900,
+		TitlePrefix:    "war",
+		IncludeRemoved: true,
+	}, res)
+
+	res, err = ListRequestToBookFilter(&pb.BookListRequest{YearFrom: 2000, YearTo: 1900})
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.Empty(t, res)
+}
+
+func TestListRequestToBookFilter_AsOf(t *testing.T) {
+	asOf := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	res, err := ListRequestToBookFilter(&pb.BookListRequest{AuthorId: 1, AsOf: timestamppb.New(asOf)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.BookFilter{AuthorID: 1, AsOf: asOf}, res)
+
+	res, err = ListRequestToBookFilter(&pb.BookListRequest{AsOf: &timestamppb.Timestamp{Nanos: -1}})
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.Empty(t, res)
+}
+
+func TestSearchRequestToPaginationParams(t *testing.T) {
+	res, err := SearchRequestToPaginationParams(&pb.BookSearchRequest{Query: "sea", PageSize: 10})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.PaginationParams{
+		Limit:     10,
+		SortBy:    entities.CursorTypeBookRank,
+		SortOrder: entities.SortOrderTypeDesc,
+	}, res)
+
+	res, err = SearchRequestToPaginationParams(&pb.BookSearchRequest{Query: "sea", PageSize: 10, Cursor: ":"})
+
+	assert.Error(t, err)
+	assert.Empty(t, res)
+}
+
+func TestSearchRequestToPaginationParams_ErrorBackwardCursor(t *testing.T) {
+	rankSort := []entities.SortField{{Field: entities.CursorTypeBookRank, Order: entities.SortOrderTypeDesc}}
+	cursor, _ := postgres.EncodeCursor(entities.Cursor{Sort: rankSort, Keys: []any{"0.5"}, ID: 1, Backward: true})
+
+	res, err := SearchRequestToPaginationParams(&pb.BookSearchRequest{Query: "sea", PageSize: 10, Cursor: cursor})
+
+	assert.Error(t, err)
+	assert.Empty(t, res)
+}
+
+func TestSearchRequestToPaginationParams_ErrorCursorOfAnotherSort(t *testing.T) {
+	idSort := []entities.SortField{{Field: entities.CursorTypeBookID, Order: entities.SortOrderTypeAsc}}
+	cursor, _ := postgres.EncodeCursor(entities.Cursor{Sort: idSort, Keys: []any{"1"}, ID: 1})
+
+	res, err := SearchRequestToPaginationParams(&pb.BookSearchRequest{Query: "sea", PageSize: 10, Cursor: cursor})
+
+	assert.Error(t, err)
+	assert.Empty(t, res)
+}
+
+func TestCursorPaginationToPaginationParams_ThenBy(t *testing.T) {
+	res, err := CursorPaginationToPaginationParams(&pb.BookListRequest_CursorPagination{
+		PageSize:  10,
+		SortBy:    "genre_id",
+		SortOrder: "asc",
+		ThenBy:    []*pb.BookListRequest_Sort{{Field: "year", Order: "desc"}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.SortField{
+		{Field: entities.CursorTypeBookGenreID, Order: entities.SortOrderTypeAsc},
+		{Field: entities.CursorTypeBookYear, Order: entities.SortOrderTypeDesc},
+	}, res.SortFields())
 }
 
 func TestLevelToZerolog(t *testing.T) {
@@ -0,0 +1,54 @@
+package converters
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// GenreAddRequestToGenre - converts pb.GenreAddRequest to entities.Genre
+func GenreAddRequestToGenre(req *pb.GenreAddRequest) entities.Genre {
+	return entities.Genre{
+		Name:     req.GetName(),
+		ParentID: parentIDToGenreParentID(req.GetParentId()),
+	}
+}
+
+// GenreUpdateRequestToGenre - converts pb.GenreUpdateRequest to entities.Genre
+func GenreUpdateRequestToGenre(req *pb.GenreUpdateRequest) entities.Genre {
+	return entities.Genre{
+		ID:       req.GetId(),
+		Name:     req.GetName(),
+		ParentID: parentIDToGenreParentID(req.GetParentId()),
+	}
+}
+
+// GenreToProtoGenre - converts entities.Genre to pb.Genre
+func GenreToProtoGenre(genre *entities.Genre) *pb.Genre {
+	return &pb.Genre{
+		Id:        genre.ID,
+		Name:      genre.Name,
+		ParentId:  genre.GetParentID(),
+		CreatedAt: timestamppb.New(genre.CreatedAt),
+	}
+}
+
+// GenresToProtoGenres - converts slice entities.Genre to slice pb.Genre
+func GenresToProtoGenres(genres []entities.Genre) []*pb.Genre {
+	pbGenres := make([]*pb.Genre, 0, len(genres))
+	for i := range genres {
+		pbGenres = append(pbGenres, GenreToProtoGenre(&genres[i]))
+	}
+
+	return pbGenres
+}
+
+// parentIDToGenreParentID - converts parent_id of the request, zero means a top-level genre
+func parentIDToGenreParentID(parentID int64) *int64 {
+	if parentID == 0 {
+		return nil
+	}
+
+	return &parentID
+}
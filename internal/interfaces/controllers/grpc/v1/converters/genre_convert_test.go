@@ -0,0 +1,42 @@
+package converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestGenreAddRequestToGenre(t *testing.T) {
+	res := GenreAddRequestToGenre(&pb.GenreAddRequest{Name: "Fiction"})
+
+	assert.Equal(t, entities.Genre{Name: "Fiction"}, res)
+
+	res = GenreAddRequestToGenre(&pb.GenreAddRequest{Name: "Fantasy", ParentId: 1})
+
+	assert.Equal(t, "Fantasy", res.Name)
+	assert.Equal(t, int64(1), res.GetParentID())
+}
+
+func TestGenreUpdateRequestToGenre(t *testing.T) {
+	res := GenreUpdateRequestToGenre(&pb.GenreUpdateRequest{Id: 2, Name: "Fantasy"})
+
+	assert.Equal(t, entities.Genre{ID: 2, Name: "Fantasy"}, res)
+}
+
+func TestGenresToProtoGenres(t *testing.T) {
+	createdAt := time.Date(2025, 10, 18, 10, 0, 0, 0, time.UTC)
+	parentID := int64(1)
+	genres := []entities.Genre{{ID: 1, Name: "Fiction", CreatedAt: createdAt}, {ID: 2, Name: "Fantasy", ParentID: &parentID}}
+
+	res := GenresToProtoGenres(genres)
+
+	assert.Len(t, res, 2)
+	assert.Equal(t, int64(0), res[0].GetParentId())
+	assert.Equal(t, createdAt, res[0].GetCreatedAt().AsTime())
+	assert.Equal(t, "Fantasy", res[1].GetName())
+	assert.Equal(t, int64(1), res[1].GetParentId())
+}
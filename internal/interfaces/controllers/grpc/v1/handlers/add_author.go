@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// Add - creates a new author based on data from a gRPC request.
+// Returns:
+// - *pb.Author: the created author with its ID and creation time
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (ah *AuthorHandler) Add(ctx context.Context, req *pb.AuthorAddRequest) (*pb.Author, error) {
+	start := time.Now()
+	logger := ah.observ.WithContext(ctx)
+	ctx, span := ah.observ.StartSpan(ctx, "v1.AuthorService.Add")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "POST"},
+		{Key: "http.route", Value: "v1/authors"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		ah.observ.RecordHanderRequest(ctx, "POST", "v1/authors", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcAuthor.Add: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	created, err := ah.uc.Add.Execute(ctx, entities.Author{Name: req.GetName()})
+	if err != nil {
+		logger.Info("grpcAuthor.Add: usecase", map[string]any{"error": err.Error()})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+		statusCode = codes.Internal
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "author.id", Value: created.ID}})
+
+	return converters.AuthorToProtoAuthor(&created), nil
+}
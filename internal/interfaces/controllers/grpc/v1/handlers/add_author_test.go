@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestAuthor_Add_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	authorRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	res, err := authorHandler.Add(context.Background(), &pb.AuthorAddRequest{Name: ""})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthor_Add_ErrorUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	authorRepo.EXPECT().
+		Create(gomock.Any(), entities.Author{Name: "Jules Verne"}).
+		Return(entities.Author{}, errors.New("db error"))
+
+	res, err := authorHandler.Add(context.Background(), &pb.AuthorAddRequest{Name: "Jules Verne"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestAuthor_Add_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	authorRepo.EXPECT().
+		Create(gomock.Any(), entities.Author{Name: "Jules Verne"}).
+		Return(entities.Author{ID: 1, Name: "Jules Verne"}, nil)
+
+	res, err := authorHandler.Add(context.Background(), &pb.AuthorAddRequest{Name: "Jules Verne"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), res.GetId())
+	assert.Equal(t, "Jules Verne", res.GetName())
+}
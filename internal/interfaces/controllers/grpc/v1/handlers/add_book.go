@@ -2,29 +2,45 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 
+	errs "github.com/mathbdw/book/internal/errors"
 	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
 	"github.com/mathbdw/book/internal/interfaces/observability"
+	book_usecase "github.com/mathbdw/book/internal/usecases/book"
 	pb "github.com/mathbdw/book/proto"
 )
 
+// duplicatesStatus - the status of the duplicates error with their IDs in the pb.BookDuplicates details
+func duplicatesStatus(code codes.Code, err error, duplicates *book_usecase.DuplicatesError) error {
+	st, detailsErr := status.New(code, err.Error()).WithDetails(&pb.BookDuplicates{BookId: duplicates.IDs})
+	if detailsErr != nil {
+		return status.Error(code, err.Error())
+	}
+
+	return st.Err()
+}
+
 // Add - creates a new book based on data from a gRPC request.
+// The repeated request with the same idempotency-key metadata gets the book created by the first one.
 // Returns:
-// - *emptypb.Empty: empty response on successful creation
+// - *pb.Book: the created book with its ID and creation time
 // - error: validation or business logic error
 //
 // Errors:
-// - codes.InvalidArgument: input data validation error
+// - codes.InvalidArgument: input data validation error, invalid ISBN checksum, unknown author or invalid idempotency key
+// - codes.AlreadyExists: a book with the ISBN already exists, or the book looks like the stored books
+// and force is not set, their IDs are in the pb.BookDuplicates details
+// - codes.FailedPrecondition: the idempotency key is used by another request
 // - codes.Internal: database or usecase level error
 //
 // Logging:
 // - Info level: validation and business logic errors
-func (bh *BookHandler) Add(ctx context.Context, req *pb.BookAddRequest) (*emptypb.Empty, error) {
+func (bh *BookHandler) Add(ctx context.Context, req *pb.BookAddRequest) (*pb.Book, error) {
 	start := time.Now()
 	logger := bh.observ.WithContext(ctx)
 	ctx, span := bh.observ.StartSpan(ctx, "v1.BookService.Add")
@@ -40,7 +56,7 @@ func (bh *BookHandler) Add(ctx context.Context, req *pb.BookAddRequest) (*emptyp
 		bh.observ.RecordHanderRequest(ctx, "POST", "v1/books", int(statusCode), duration)
 	}()
 
-	if err := req.Validate(); err != nil {
+	if err := converters.ValidateBookAddRequest(req); err != nil {
 		logger.Info("grpcBook.Add: validate", map[string]any{"error": err.Error()})
 		span.RecordError(err)
 		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
@@ -49,23 +65,57 @@ func (bh *BookHandler) Add(ctx context.Context, req *pb.BookAddRequest) (*emptyp
 		return nil, status.Error(statusCode, err.Error())
 	}
 
+	idempotency, err := idempotencyKey(ctx, req)
+	if err != nil {
+		logger.Info("grpcBook.Add: validate idempotency key", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation_idempotency.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
 	book := converters.BookAddRequestToBook(req)
 	span.SetAttributes([]observability.Attribute{
 		{Key: "book.title", Value: book.Title},
 		{Key: "book.description", Value: book.Description},
 		{Key: "book.year", Value: book.Year},
-		{Key: "book.genre", Value: book.Genre},
+		{Key: "book.genre_id", Value: book.GenreID},
+		{Key: "idempotency.key", Value: idempotency.Key},
 	})
 
-	err := bh.uc.Add.Execute(ctx, book)
+	created, err := bh.uc.Add.Execute(withCaller(ctx), book, idempotency, req.GetForce())
 	if err != nil {
 		logger.Info("grpcBook.Add: usecase", map[string]any{"error": err.Error()})
 
 		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
-		statusCode = codes.Internal
 
+		if errors.Is(err, errs.ErrInvalidInput) {
+			statusCode = codes.InvalidArgument
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		var duplicates *book_usecase.DuplicatesError
+		if errors.As(err, &duplicates) {
+			statusCode = codes.AlreadyExists
+			return nil, duplicatesStatus(statusCode, err, duplicates)
+		}
+
+		if errors.Is(err, errs.ErrAlreadyExists) {
+			statusCode = codes.AlreadyExists
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		if errors.Is(err, errs.ErrConflict) {
+			statusCode = codes.FailedPrecondition
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		statusCode = codes.Internal
 		return nil, status.Error(statusCode, err.Error())
 	}
 
-	return &emptypb.Empty{}, nil
+	span.SetAttributes([]observability.Attribute{{Key: "book.id", Value: created.ID}})
+
+	return converters.BookToProtoBook(&created), nil
 }
@@ -4,16 +4,18 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 
-	"github.com/mathbdw/book/internal/usecases/book"
 	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
 	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/internal/usecases/book"
 	"github.com/mathbdw/book/mocks"
 	pb "github.com/mathbdw/book/proto"
 )
@@ -24,7 +26,10 @@ func createMockHandlerObservability(ctrl *gomock.Controller) *mocks.MockHandlerO
 	mockSpan := mocks.NewMockSpan(ctrl)
 
 	observ.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
-	observ.EXPECT().StartSpan(gomock.Any(), gomock.Any()).Return(context.Background(), mockSpan).AnyTimes()
+	observ.EXPECT().StartSpan(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ string) (context.Context, observability.Span) {
+			return ctx, mockSpan
+		}).AnyTimes()
 	observ.EXPECT().RecordHanderRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
 	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
@@ -44,7 +49,10 @@ func createMockUsecaseObservability(ctrl *gomock.Controller) *mocks.MockUsecaseO
 	mockSpan := mocks.NewMockSpan(ctrl)
 
 	// Настройка мока observability
-	observ.EXPECT().StartSpan(gomock.Any(), gomock.Any()).Return(context.Background(), mockSpan).AnyTimes()
+	observ.EXPECT().StartSpan(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ string) (context.Context, observability.Span) {
+			return ctx, mockSpan
+		}).AnyTimes()
 	observ.EXPECT().RecordBookCreated(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
 	// Настройка span методов
@@ -55,14 +63,14 @@ func createMockUsecaseObservability(ctrl *gomock.Controller) *mocks.MockUsecaseO
 	return observ
 }
 
-func createMockUC(ctrl *gomock.Controller, uowRepo repositories.UnitOfWork) *book.BookUsecases{
+func createMockUC(ctrl *gomock.Controller, uowRepo repositories.UnitOfWork) *book.BookUsecases {
 	bookRepo := mocks.NewMockBookRepository(ctrl)
 	observUsecase := createMockUsecaseObservability(ctrl)
 
-	addUC := book.NewAddBookUsecase(uowRepo, observUsecase)
-	getUC := book.NewGetBookUsecase(bookRepo, observUsecase)
+	addUC := book.NewAddBookUsecase(uowRepo, observUsecase, time.Hour)
+	getUC := book.NewGetBookUsecase(bookRepo, nil, observUsecase)
 	listUC := book.NewListBookUsecase(bookRepo, observUsecase)
-	removeUC := book.NewRemoveBookUsecase(uowRepo, observUsecase)
+	removeUC := book.NewRemoveBookUsecase(uowRepo, observUsecase, time.Hour)
 
 	return book.New(
 		book.WithAddBookUsecase(addUC),
@@ -86,7 +94,7 @@ func TestBook_Add_ErrorValidate(t *testing.T) {
 		Title:       "Nert",
 		Description: "New Desc",
 		// Year:        1900,
-		Genre: "New Genre",
+		GenreId: 3,
 	})
 
 	assert.Nil(t, res)
@@ -100,6 +108,7 @@ func TestBook_Add_ErrorUsecase(t *testing.T) {
 
 	uowRepo := mocks.NewMockUnitOfWork(ctrl)
 	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
 	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
 	observHandler := createMockHandlerObservability(ctrl)
 	uc := createMockUC(ctrl, uowRepo)
@@ -109,15 +118,24 @@ func TestBook_Add_ErrorUsecase(t *testing.T) {
 	expectedBook := entities.Book{
 		Title:       "New Test",
 		Description: "New Desc",
+		GenreID:     3,
 		Genre:       "New Genre",
 		Year:        1900,
 	}
 	uowRepo.EXPECT().
 		Do(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "New Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]int64{}, nil)
+
 			bookMock.EXPECT().
 				Create(ctx, expectedBook).
-				Return(int64(0), errors.New("error repoBook"))
+				Return(entities.Book{}, errors.New("error repoBook"))
 
 			bookEventMock.EXPECT().
 				Create(ctx, gomock.Any()).
@@ -126,16 +144,17 @@ func TestBook_Add_ErrorUsecase(t *testing.T) {
 
 			repo := &repositories.Repository{
 				Book:      bookMock,
+				Genre:     genreMock,
 				BookEvent: bookEventMock,
 			}
 
 			return fn(repo)
 		})
-		
+
 	res, err := bookHandler.Add(ctx, &pb.BookAddRequest{
 		Title:       "New Test",
 		Description: "New Desc",
-		Genre:       "New Genre",
+		GenreId:     3,
 		Year:        1900,
 	})
 
@@ -150,7 +169,9 @@ func TestBook_Add_Success(t *testing.T) {
 
 	uowRepo := mocks.NewMockUnitOfWork(ctrl)
 	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
 	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
 	observHandler := createMockHandlerObservability(ctrl)
 	uc := createMockUC(ctrl, uowRepo)
 	bookHandler := &BookHandler{uc: uc, observ: observHandler}
@@ -159,24 +180,42 @@ func TestBook_Add_Success(t *testing.T) {
 	expectedBook := entities.Book{
 		Title:       "New Test",
 		Description: "New Desc",
+		GenreID:     3,
 		Genre:       "New Genre",
 		Year:        1900,
 	}
+	createdBook := expectedBook
+	createdBook.ID = 1
+	createdBook.CreatedAt = time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
 
 	uowRepo.EXPECT().
 		Do(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "New Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]int64{}, nil)
+
 			bookMock.EXPECT().
 				Create(ctx, expectedBook).
-				Return(int64(1), nil)
+				Return(createdBook, nil)
 
 			bookEventMock.EXPECT().
 				Create(ctx, gomock.Any()).
 				Return(int64(1), nil)
 
+			bookHistoryMock.EXPECT().
+				Record(gomock.Any(), gomock.Any(), entities.Created, "").
+				Return(nil)
+
 			repo := &repositories.Repository{
-				Book:      bookMock,
-				BookEvent: bookEventMock,
+				Book:        bookMock,
+				Genre:       genreMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
 			}
 
 			return fn(repo)
@@ -185,10 +224,246 @@ func TestBook_Add_Success(t *testing.T) {
 	res, err := bookHandler.Add(ctx, &pb.BookAddRequest{
 		Title:       "New Test",
 		Description: "New Desc",
-		Genre:       "New Genre",
+		GenreId:     3,
 		Year:        1900,
 	})
 
-	assert.Equal(t, &emptypb.Empty{}, res)
 	assert.Nil(t, err)
+	assert.Equal(t, int64(1), res.GetId())
+	assert.Equal(t, "New Test", res.GetTitle())
+	assert.Equal(t, createdBook.CreatedAt, res.GetCreatedAt().AsTime())
+}
+
+func TestBook_Add_ErrorDuplicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := createMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "New Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]int64{4, 2}, nil)
+
+			repo := &repositories.Repository{
+				Book:  bookMock,
+				Genre: genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Add(ctx, &pb.BookAddRequest{
+		Title:       "New Tset",
+		Description: "New Desc",
+		GenreId:     3,
+		Year:        1900,
+	})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	details := status.Convert(err).Details()
+	assert.Len(t, details, 1)
+	assert.Equal(t, []int64{4, 2}, details[0].(*pb.BookDuplicates).GetBookId())
+}
+
+func TestBook_Add_SuccessForce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := createMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "New Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(0)
+
+			bookMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(entities.Book{ID: 5, Title: "New Tset"}, nil)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(1), nil)
+
+			bookHistoryMock.EXPECT().
+				Record(gomock.Any(), []int64{5}, entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				Genre:       genreMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Add(ctx, &pb.BookAddRequest{
+		Title:       "New Tset",
+		Description: "New Desc",
+		GenreId:     3,
+		Year:        1900,
+		Force:       true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), res.GetId())
+}
+
+func TestBook_Add_ErrorUnknownAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := createMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "New Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]int64{}, nil)
+
+			bookMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(entities.Book{ID: 1}, nil)
+
+			authorMock.EXPECT().
+				GetByIDs(ctx, []int64{7}).
+				Return(nil, errs.ErrNotFound)
+
+			repo := &repositories.Repository{
+				Book:   bookMock,
+				Genre:  genreMock,
+				Author: authorMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Add(ctx, &pb.BookAddRequest{
+		Title:       "New Test",
+		Description: "New Desc",
+		GenreId:     3,
+		Year:        1900,
+		AuthorIds:   []int64{7},
+	})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBook_Add_ErrorInvalidISBN(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := createMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	res, err := bookHandler.Add(ctx, &pb.BookAddRequest{
+		Title:       "New Test",
+		Description: "New Desc",
+		GenreId:     3,
+		Year:        1900,
+		Isbn:        "978-0-306-40615-8",
+	})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "invalid isbn")
+}
+
+func TestBook_Add_ErrorISBNExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := createMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "New Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]int64{}, nil)
+
+			bookMock.EXPECT().
+				Create(ctx, entities.Book{
+					Title:       "New Test",
+					Description: "New Desc",
+					GenreID:     3,
+					Genre:       "New Genre",
+					Year:        1900,
+					ISBN:        "9780306406157",
+				}).
+				Return(entities.Book{}, errs.Wrap(errs.ErrAlreadyExists, "book isbn"))
+
+			repo := &repositories.Repository{
+				Book:  bookMock,
+				Genre: genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Add(ctx, &pb.BookAddRequest{
+		Title:       "New Test",
+		Description: "New Desc",
+		GenreId:     3,
+		Year:        1900,
+		Isbn:        "0-306-40615-2",
+	})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
 }
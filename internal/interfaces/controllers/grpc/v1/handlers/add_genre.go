@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// Add - creates a new genre based on data from a gRPC request.
+// Returns:
+// - *pb.Genre: the created genre with its ID and creation time
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error or the parent genre does not exist
+// - codes.AlreadyExists: a genre with the same name exists
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (gh *GenreHandler) Add(ctx context.Context, req *pb.GenreAddRequest) (*pb.Genre, error) {
+	start := time.Now()
+	logger := gh.observ.WithContext(ctx)
+	ctx, span := gh.observ.StartSpan(ctx, "v1.GenreService.Add")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "POST"},
+		{Key: "http.route", Value: "v1/genres"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		gh.observ.RecordHanderRequest(ctx, "POST", "v1/genres", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcGenre.Add: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	created, err := gh.uc.Add.Execute(ctx, converters.GenreAddRequestToGenre(req))
+	if err != nil {
+		logger.Info("grpcGenre.Add: usecase", map[string]any{"error": err.Error()})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidInput):
+			statusCode = codes.InvalidArgument
+			return nil, status.Error(statusCode, err.Error())
+		case errors.Is(err, errs.ErrAlreadyExists):
+			statusCode = codes.AlreadyExists
+			return nil, status.Error(statusCode, "genre with the same name already exists")
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "genre.id", Value: created.ID}})
+
+	return converters.GenreToProtoGenre(&created), nil
+}
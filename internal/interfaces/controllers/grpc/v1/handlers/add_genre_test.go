@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestGenre_Add_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, nil), observ: observHandler}
+
+	genreRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	res, err := genreHandler.Add(context.Background(), &pb.GenreAddRequest{Name: "F"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGenre_Add_ErrorAlreadyExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, nil), observ: observHandler}
+
+	genreRepo.EXPECT().
+		Create(gomock.Any(), entities.Genre{Name: "Fantasy"}).
+		Return(entities.Genre{}, errs.ErrAlreadyExists)
+
+	res, err := genreHandler.Add(context.Background(), &pb.GenreAddRequest{Name: "Fantasy"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestGenre_Add_ErrorParentNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, nil), observ: observHandler}
+
+	genreRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		Return(entities.Genre{}, errs.ErrInvalidInput)
+
+	res, err := genreHandler.Add(context.Background(), &pb.GenreAddRequest{Name: "Space opera", ParentId: 9})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGenre_Add_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, nil), observ: observHandler}
+	parentID := int64(1)
+
+	genreRepo.EXPECT().
+		Create(gomock.Any(), entities.Genre{Name: "Fantasy", ParentID: &parentID}).
+		Return(entities.Genre{ID: 2, Name: "Fantasy", ParentID: &parentID}, nil)
+
+	res, err := genreHandler.Add(context.Background(), &pb.GenreAddRequest{Name: "Fantasy", ParentId: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), res.GetId())
+	assert.Equal(t, int64(1), res.GetParentId())
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/usecases/author"
+	pb "github.com/mathbdw/book/proto"
+)
+
+type AuthorHandler struct {
+	pb.AuthorServiceServer
+
+	uc     *author.AuthorUsecases
+	observ observability.HandlerObservability
+}
+
+func NewAuthorHandler(app grpc.ServiceRegistrar, uc *author.AuthorUsecases, observ observability.HandlerObservability) {
+	handler := &AuthorHandler{
+		observ: observ,
+		uc:     uc,
+	}
+
+	pb.RegisterAuthorServiceServer(app, handler)
+}
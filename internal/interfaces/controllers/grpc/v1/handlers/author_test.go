@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/internal/usecases/author"
+	"github.com/mathbdw/book/mocks"
+)
+
+func authorMockUC(ctrl *gomock.Controller, authorRepo repositories.AuthorRepository, uowRepo repositories.UnitOfWork) *author.AuthorUsecases {
+	//createMockUsecaseObservability - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+
+	return author.New(
+		author.WithAddAuthorUsecase(author.NewAddAuthorUsecase(authorRepo, observUsecase)),
+		author.WithGetAuthorUsecase(author.NewGetAuthorUsecase(authorRepo, observUsecase)),
+		author.WithListAuthorUsecase(author.NewListAuthorUsecase(authorRepo, observUsecase)),
+		author.WithUpdateAuthorUsecase(author.NewUpdateAuthorUsecase(authorRepo, observUsecase)),
+		author.WithRemoveAuthorUsecase(author.NewRemoveAuthorUsecase(uowRepo, observUsecase)),
+	)
+}
+
+func TestNewAuthorHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRegistrar := mocks.NewMockServiceRegistrar(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := authorMockUC(ctrl, mocks.NewMockAuthorRepository(ctrl), mocks.NewMockUnitOfWork(ctrl))
+
+	var registeredService any
+	var serviceDesc *grpc.ServiceDesc
+	mockRegistrar.EXPECT().
+		RegisterService(gomock.Any(), gomock.Any()).
+		Do(func(sd *grpc.ServiceDesc, ss any) {
+			serviceDesc = sd
+			registeredService = ss
+		}).
+		Times(1)
+
+	NewAuthorHandler(mockRegistrar, uc, observHandler)
+
+	assert.NotNil(t, serviceDesc)
+	assert.Equal(t, "mathbdw.grpc.v1.AuthorService", serviceDesc.ServiceName)
+
+	handler, ok := registeredService.(*AuthorHandler)
+	assert.True(t, ok)
+	assert.Equal(t, uc, handler.uc)
+	assert.Equal(t, observHandler, handler.observ)
+}
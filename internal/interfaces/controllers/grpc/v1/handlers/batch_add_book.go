@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// batchRejected - reason for valid items not created in all-or-nothing mode
+const batchRejected = "not created: batch contains invalid items"
+
+// BatchAdd - creates books in one transaction based on data from a gRPC request.
+// Every item is validated separately, in all-or-nothing mode one invalid item rejects the batch,
+// in best-effort mode only valid items are created: the items with an unknown genre or author or a taken ISBN
+// get their errors and the rest of the batch is committed.
+// Returns:
+// - *pb.BookBatchAddResponse: result for each item, the created book or the error
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: request validation error or unknown author
+// - codes.AlreadyExists: a book with one of the ISBNs already exists
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (bh *BookHandler) BatchAdd(ctx context.Context, req *pb.BookBatchAddRequest) (*pb.BookBatchAddResponse, error) {
+	start := time.Now()
+	logger := bh.observ.WithContext(ctx)
+	ctx, span := bh.observ.StartSpan(ctx, "v1.BookService.BatchAdd")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "POST"},
+		{Key: "http.route", Value: "v1/books/batch"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		bh.observ.RecordHanderRequest(ctx, "POST", "v1/books/batch", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcBook.BatchAdd: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	results := make([]*pb.BookBatchAddResult, len(req.GetBooks()))
+	books := make([]entities.Book, 0, len(req.GetBooks()))
+	positions := make([]int, 0, len(req.GetBooks()))
+	for i, item := range req.GetBooks() {
+		results[i] = &pb.BookBatchAddResult{Index: int32(i)}
+		if err := converters.ValidateBookAddRequest(item); err != nil {
+			results[i].Error = err.Error()
+
+			continue
+		}
+
+		books = append(books, converters.BookAddRequestToBook(item))
+		positions = append(positions, i)
+	}
+
+	invalid := len(results) - len(books)
+	span.SetAttributes([]observability.Attribute{
+		{Key: "batch.mode", Value: req.GetMode().String()},
+		{Key: "batch.valid", Value: len(books)},
+		{Key: "batch.invalid", Value: invalid},
+	})
+
+	if invalid > 0 && req.GetMode() == pb.BatchMode_BATCH_MODE_ALL_OR_NOTHING {
+		logger.Info("grpcBook.BatchAdd: batch rejected", map[string]any{"invalid": invalid})
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+
+		for _, i := range positions {
+			results[i].Error = batchRejected
+		}
+
+		return &pb.BookBatchAddResponse{Results: results}, nil
+	}
+
+	if len(books) == 0 {
+		return &pb.BookBatchAddResponse{Results: results}, nil
+	}
+
+	bestEffort := req.GetMode() == pb.BatchMode_BATCH_MODE_BEST_EFFORT
+	created, skipped, err := bh.uc.BatchAdd.Execute(withCaller(ctx), books, bestEffort)
+	if err != nil {
+		logger.Info("grpcBook.BatchAdd: usecase", map[string]any{"error": err.Error()})
+
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		if errors.Is(err, errs.ErrInvalidInput) {
+			statusCode = codes.InvalidArgument
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		if errors.Is(err, errs.ErrAlreadyExists) {
+			statusCode = codes.AlreadyExists
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	for j, i := range positions {
+		if skipped[j] != nil {
+			results[i].Error = skipped[j].Error()
+
+			continue
+		}
+
+		results[i].Book = converters.BookToProtoBook(&created[j])
+	}
+
+	return &pb.BookBatchAddResponse{Results: results}, nil
+}
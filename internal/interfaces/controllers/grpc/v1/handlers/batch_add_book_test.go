@@ -0,0 +1,329 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/internal/usecases/book"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func batchAddMockUC(ctrl *gomock.Controller, uowRepo repositories.UnitOfWork) *book.BookUsecases {
+	observUsecase := createMockUsecaseObservability(ctrl)
+
+	batchAddUC := book.NewBatchAddBookUsecase(uowRepo, observUsecase)
+
+	return book.New(
+		book.WithBatchAddBookUsecase(batchAddUC),
+	)
+}
+
+func batchAddRequest(mode pb.BatchMode) *pb.BookBatchAddRequest {
+	return &pb.BookBatchAddRequest{
+		Books: []*pb.BookAddRequest{
+			{Title: "First", Description: "First desc", GenreId: 3, Year: 2001},
+			{Title: "S", Description: "Second desc", GenreId: 3, Year: 2002},
+			{Title: "Third", Description: "Third desc", GenreId: 3, Year: 2003},
+		},
+		Mode: mode,
+	}
+}
+
+func TestBook_BatchAdd_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := batchAddMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	res, err := bookHandler.BatchAdd(ctx, &pb.BookBatchAddRequest{})
+
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBook_BatchAdd_AllOrNothingRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := batchAddMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).Times(0)
+
+	res, err := bookHandler.BatchAdd(ctx, batchAddRequest(pb.BatchMode_BATCH_MODE_ALL_OR_NOTHING))
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetResults(), 3)
+	assert.Equal(t, batchRejected, res.GetResults()[0].GetError())
+	assert.Contains(t, res.GetResults()[1].GetError(), "Title")
+	assert.Equal(t, batchRejected, res.GetResults()[2].GetError())
+	for i, result := range res.GetResults() {
+		assert.Equal(t, int32(i), result.GetIndex())
+		assert.Nil(t, result.GetBook())
+	}
+}
+
+func TestBook_BatchAdd_ErrorUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := batchAddMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Genre"}}, nil)
+
+			bookMock.EXPECT().
+				CreateBatch(ctx, gomock.Any()).
+				Return(nil, errors.New("error repoBook"))
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				Genre:     genreMock,
+				BookEvent: bookEventMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.BatchAdd(ctx, batchAddRequest(pb.BatchMode_BATCH_MODE_BEST_EFFORT))
+
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestBook_BatchAdd_BestEffort(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := batchAddMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	expectedBooks := []entities.Book{
+		{Title: "First", Description: "First desc", GenreID: 3, Genre: "Genre", Year: 2001},
+		{Title: "Third", Description: "Third desc", GenreID: 3, Genre: "Genre", Year: 2003},
+	}
+	createdBooks := []entities.Book{
+		{ID: 1, Title: "First", Description: "First desc", GenreID: 3, Genre: "Genre", Year: 2001},
+		{ID: 2, Title: "Third", Description: "Third desc", GenreID: 3, Genre: "Genre", Year: 2003},
+	}
+
+	uowRepo.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Genre"}}, nil)
+
+			bookMock.EXPECT().
+				CreateBatch(ctx, expectedBooks).
+				Return(createdBooks, nil)
+
+			bookEventMock.EXPECT().
+				CreateBatch(ctx, gomock.Any()).
+				Return([]int64{1, 2}, nil)
+
+			bookHistoryMock.EXPECT().
+				Record(gomock.Any(), gomock.Any(), entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				Genre:       genreMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.BatchAdd(ctx, batchAddRequest(pb.BatchMode_BATCH_MODE_BEST_EFFORT))
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetResults(), 3)
+	assert.Equal(t, int64(1), res.GetResults()[0].GetBook().GetId())
+	assert.Empty(t, res.GetResults()[0].GetError())
+	assert.Nil(t, res.GetResults()[1].GetBook())
+	assert.Contains(t, res.GetResults()[1].GetError(), "Title")
+	assert.Equal(t, int64(2), res.GetResults()[2].GetBook().GetId())
+	assert.Equal(t, "Third", res.GetResults()[2].GetBook().GetTitle())
+}
+
+func TestBook_BatchAdd_BestEffortConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := batchAddMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	createdBooks := []entities.Book{
+		{ID: 3, Title: "Third", Description: "Third desc", GenreID: 3, Genre: "Genre", Year: 2003},
+	}
+
+	uowRepo.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Genre"}}, nil)
+
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{9}).
+				Return(nil, errs.ErrNotFound)
+
+			bookMock.EXPECT().
+				GetByISBN(ctx, "9780306406157").
+				Return(entities.Book{ID: 1, ISBN: "9780306406157"}, nil)
+
+			bookMock.EXPECT().
+				CreateBatch(ctx, gomock.Len(1)).
+				Return(createdBooks, nil)
+
+			bookEventMock.EXPECT().
+				CreateBatch(ctx, gomock.Any()).
+				Return([]int64{1}, nil)
+
+			bookHistoryMock.EXPECT().
+				Record(gomock.Any(), []int64{3}, entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				Genre:       genreMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.BatchAdd(ctx, &pb.BookBatchAddRequest{
+		Books: []*pb.BookAddRequest{
+			{Title: "First", Description: "First desc", GenreId: 3, Year: 2001, Isbn: "978-0-306-40615-7"},
+			{Title: "Second", Description: "Second desc", GenreId: 9, Year: 2002},
+			{Title: "Third", Description: "Third desc", GenreId: 3, Year: 2003},
+		},
+		Mode: pb.BatchMode_BATCH_MODE_BEST_EFFORT,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetResults(), 3)
+	assert.Nil(t, res.GetResults()[0].GetBook())
+	assert.Contains(t, res.GetResults()[0].GetError(), "book with isbn 9780306406157 already exists")
+	assert.Nil(t, res.GetResults()[1].GetBook())
+	assert.Contains(t, res.GetResults()[1].GetError(), "genre 9 not found")
+	assert.Empty(t, res.GetResults()[2].GetError())
+	assert.Equal(t, int64(3), res.GetResults()[2].GetBook().GetId())
+}
+
+func TestBook_BatchAdd_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := batchAddMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	createdBooks := []entities.Book{
+		{ID: 1, Title: "First", Description: "First desc", GenreID: 3, Genre: "Genre", Year: 2001},
+		{ID: 2, Title: "Second", Description: "Second desc", GenreID: 3, Genre: "Genre", Year: 2002},
+	}
+
+	uowRepo.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Genre"}}, nil)
+
+			bookMock.EXPECT().
+				CreateBatch(ctx, gomock.Any()).
+				Return(createdBooks, nil)
+
+			bookEventMock.EXPECT().
+				CreateBatch(ctx, gomock.Any()).
+				Return([]int64{1, 2}, nil)
+
+			bookHistoryMock.EXPECT().
+				Record(gomock.Any(), gomock.Any(), entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				Genre:       genreMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.BatchAdd(ctx, &pb.BookBatchAddRequest{
+		Books: []*pb.BookAddRequest{
+			{Title: "First", Description: "First desc", GenreId: 3, Year: 2001},
+			{Title: "Second", Description: "Second desc", GenreId: 3, Year: 2002},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetResults(), 2)
+	for i, result := range res.GetResults() {
+		assert.Empty(t, result.GetError())
+		assert.Equal(t, createdBooks[i].ID, result.GetBook().GetId())
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
@@ -21,10 +22,10 @@ func TestNewBookHandler(t *testing.T) {
 
 	observUsecase := createMockUsecaseObservability(ctrl)
 
-	addUC := book.NewAddBookUsecase(mockUowRepo, observUsecase)
-	getUC := book.NewGetBookUsecase(mockBookRepo, observUsecase)
+	addUC := book.NewAddBookUsecase(mockUowRepo, observUsecase, time.Hour)
+	getUC := book.NewGetBookUsecase(mockBookRepo, nil, observUsecase)
 	listUC := book.NewListBookUsecase(mockBookRepo, observUsecase)
-	removeUC := book.NewRemoveBookUsecase(mockUowRepo, observUsecase)
+	removeUC := book.NewRemoveBookUsecase(mockUowRepo, observUsecase, time.Hour)
 
 	uc := book.New(
 		book.WithAddBookUsecase(addUC),
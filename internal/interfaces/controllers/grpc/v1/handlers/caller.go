@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mathbdw/book/internal/usecases/book"
+)
+
+const (
+	// callerMetadata - metadata key of the caller identity, the REST gateway forwards the X-Caller-Id header to it
+	callerMetadata = "x-caller-id"
+	// callerMaxLen - max length of the caller stored in the book history
+	callerMaxLen = 255
+)
+
+// withCaller - returns the context carrying the caller from the metadata, the writes record it in the book history
+func withCaller(ctx context.Context) context.Context {
+	data, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	values := data.Get(callerMetadata)
+	if len(values) == 0 {
+		return ctx
+	}
+
+	caller := []rune(strings.TrimSpace(values[0]))
+	if len(caller) > callerMaxLen {
+		caller = caller[:callerMaxLen]
+	}
+
+	return book.WithActor(ctx, string(caller))
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/internal/usecases/book"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestBook_Restore_RecordsCaller(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryRepo := mocks.NewMockBookHistoryRepository(ctrl)
+	bookRedirectRepo := mocks.NewMockBookRedirectRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := book.New(book.WithRestoreBookUsecase(book.NewRestoreBookUsecase(uowRepo, createMockUsecaseObservability(ctrl))))
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(callerMetadata, " alice@example.com "))
+
+	uowRepo.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookRepo.EXPECT().Restore(gomock.Any(), []int64{3}, int64(0)).Return(nil)
+			bookRedirectRepo.EXPECT().Delete(gomock.Any(), []int64{3}).Return(nil)
+			bookRepo.EXPECT().GetByIDs(gomock.Any(), []int64{3}).Return([]entities.Book{{ID: 3}}, nil)
+			bookEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+			bookHistoryRepo.EXPECT().
+				Record(gomock.Any(), []int64{3}, entities.Restored, "alice@example.com").
+				Return(nil)
+
+			return fn(&repositories.Repository{Book: bookRepo, BookEvent: bookEventRepo, BookHistory: bookHistoryRepo, BookRedirect: bookRedirectRepo})
+		})
+
+	_, err := bookHandler.Restore(ctx, &pb.BookChangeRequest{BookId: []int64{3}})
+
+	assert.NoError(t, err)
+}
+
+func TestWithCaller_TooLong(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryRepo := mocks.NewMockBookHistoryRepository(ctrl)
+	bookRedirectRepo := mocks.NewMockBookRedirectRepository(ctrl)
+	uc := book.NewRestoreBookUsecase(uowRepo, createMockUsecaseObservability(ctrl))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(callerMetadata, strings.Repeat("я", callerMaxLen+1)))
+
+	uowRepo.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookRepo.EXPECT().Restore(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			bookRedirectRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+			bookRepo.EXPECT().GetByIDs(gomock.Any(), gomock.Any()).Return([]entities.Book{{ID: 3}}, nil)
+			bookEventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+			bookHistoryRepo.EXPECT().
+				Record(gomock.Any(), gomock.Any(), gomock.Any(), strings.Repeat("я", callerMaxLen)).
+				Return(nil)
+
+			return fn(&repositories.Repository{Book: bookRepo, BookEvent: bookEventRepo, BookHistory: bookHistoryRepo, BookRedirect: bookRedirectRepo})
+		})
+
+	err := uc.Execute(withCaller(ctx), []int64{3}, 0)
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ifMatchMetadata - metadata key of the expected version, the REST gateway forwards the If-Match header to it
+const ifMatchMetadata = "if-match"
+
+// expectedVersion - returns the expected version of the book, the field of the request wins over the If-Match metadata.
+// 0 - any version
+func expectedVersion(ctx context.Context, requested int64) (int64, error) {
+	if requested > 0 {
+		return requested, nil
+	}
+
+	data, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+
+	values := data.Get(ifMatchMetadata)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	return parseETag(values[0])
+}
+
+// parseETag - parses the ETag `"<version>"` of the book, "*" - any version.
+// If-Match uses the strong comparison, so a weak ETag never matches a version and is rejected
+func parseETag(etag string) (int64, error) {
+	etag = strings.TrimSpace(etag)
+	if etag == "*" {
+		return 0, nil
+	}
+
+	if strings.Contains(etag, ",") {
+		return 0, fmt.Errorf("if-match: only one ETag is supported: %s", etag)
+	}
+
+	if strings.HasPrefix(etag, "W/") {
+		return 0, fmt.Errorf("if-match: weak ETag is not supported: %s", etag)
+	}
+
+	unquoted := strings.Trim(etag, `"`)
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("if-match: invalid ETag: %s", etag)
+	}
+
+	return version, nil
+}
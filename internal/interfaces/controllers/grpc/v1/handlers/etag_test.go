@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestExpectedVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		ifMatch   []string
+		requested int64
+		want      int64
+		wantError bool
+	}{
+		{"NoMetadata", nil, 0, 0, false},
+		{"RequestWins", []string{`"5"`}, 3, 3, false},
+		{"StrongETag", []string{`"5"`}, 0, 5, false},
+		{"WeakETag", []string{`W/"5"`}, 0, 0, true},
+		{"Any", []string{"*"}, 0, 0, false},
+		{"SeveralETags", []string{`"5", "6"`}, 0, 0, true},
+		{"InvalidETag", []string{`"abc"`}, 0, 0, true},
+		{"ZeroETag", []string{`"0"`}, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ifMatch != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ifMatchMetadata, tt.ifMatch[0]))
+			}
+
+			version, err := expectedVersion(ctx, tt.requested)
+
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, version)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/usecases/genre"
+	pb "github.com/mathbdw/book/proto"
+)
+
+type GenreHandler struct {
+	pb.GenreServiceServer
+
+	uc     *genre.GenreUsecases
+	observ observability.HandlerObservability
+}
+
+func NewGenreHandler(app grpc.ServiceRegistrar, uc *genre.GenreUsecases, observ observability.HandlerObservability) {
+	handler := &GenreHandler{
+		observ: observ,
+		uc:     uc,
+	}
+
+	pb.RegisterGenreServiceServer(app, handler)
+}
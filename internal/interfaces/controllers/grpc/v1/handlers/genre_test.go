@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/internal/usecases/genre"
+	"github.com/mathbdw/book/mocks"
+)
+
+func genreMockUC(ctrl *gomock.Controller, genreRepo repositories.GenreRepository, uowRepo repositories.UnitOfWork) *genre.GenreUsecases {
+	//createMockUsecaseObservability - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+
+	return genre.New(
+		genre.WithAddGenreUsecase(genre.NewAddGenreUsecase(genreRepo, observUsecase)),
+		genre.WithGetGenreUsecase(genre.NewGetGenreUsecase(genreRepo, observUsecase)),
+		genre.WithListGenreUsecase(genre.NewListGenreUsecase(genreRepo, observUsecase)),
+		genre.WithUpdateGenreUsecase(genre.NewUpdateGenreUsecase(uowRepo, observUsecase)),
+		genre.WithRemoveGenreUsecase(genre.NewRemoveGenreUsecase(uowRepo, observUsecase)),
+	)
+}
+
+func TestNewGenreHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRegistrar := mocks.NewMockServiceRegistrar(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := genreMockUC(ctrl, mocks.NewMockGenreRepository(ctrl), mocks.NewMockUnitOfWork(ctrl))
+
+	var registeredService any
+	var serviceDesc *grpc.ServiceDesc
+	mockRegistrar.EXPECT().
+		RegisterService(gomock.Any(), gomock.Any()).
+		Do(func(sd *grpc.ServiceDesc, ss any) {
+			serviceDesc = sd
+			registeredService = ss
+		}).
+		Times(1)
+
+	NewGenreHandler(mockRegistrar, uc, observHandler)
+
+	assert.NotNil(t, serviceDesc)
+	assert.Equal(t, "mathbdw.grpc.v1.GenreService", serviceDesc.ServiceName)
+
+	handler, ok := registeredService.(*GenreHandler)
+	assert.True(t, ok)
+	assert.Equal(t, uc, handler.uc)
+	assert.Equal(t, observHandler, handler.observ)
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/response"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// GetByIDs - returns the authors based on data from a gRPC request.
+// Returns:
+// - *pb.AuthorsResponse: the found authors
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error
+// - codes.NotFound: none of the authors exist
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (ah *AuthorHandler) GetByIDs(ctx context.Context, req *pb.AuthorGetRequest) (*pb.AuthorsResponse, error) {
+	start := time.Now()
+	logger := ah.observ.WithContext(ctx)
+	ctx, span := ah.observ.StartSpan(ctx, "v1.AuthorService.GetByIDs")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "GET"},
+		{Key: "http.route", Value: "v1/authors"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		ah.observ.RecordHanderRequest(ctx, "GET", "v1/authors", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcAuthor.GetByIDs: validate", map[string]any{
+			"error": err.Error(),
+			"ids":   req.GetAuthorId(),
+		})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "author.ids", Value: req.GetAuthorId()}})
+
+	authors, err := ah.uc.Get.GetByIDs(ctx, req.GetAuthorId())
+	if err != nil {
+		logger.Info("grpcAuthor.GetByIDs: usecase", map[string]any{
+			"error": err.Error(),
+			"ids":   req.GetAuthorId(),
+		})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		if errors.Is(err, errs.ErrNotFound) {
+			statusCode = codes.NotFound
+			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return response.GetAuthorsResponse(authors), nil
+}
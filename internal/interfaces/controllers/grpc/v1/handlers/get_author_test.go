@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestAuthor_GetByIDs_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	res, err := authorHandler.GetByIDs(context.Background(), &pb.AuthorGetRequest{AuthorId: []int64{1, 1}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthor_GetByIDs_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	authorRepo.EXPECT().
+		GetByIDs(gomock.Any(), []int64{1}).
+		Return(nil, errs.ErrNotFound)
+
+	res, err := authorHandler.GetByIDs(context.Background(), &pb.AuthorGetRequest{AuthorId: []int64{1}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAuthor_GetByIDs_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	authorRepo.EXPECT().
+		GetByIDs(gomock.Any(), []int64{1, 2}).
+		Return([]entities.Author{{ID: 1, Name: "First"}, {ID: 2, Name: "Second"}}, nil)
+
+	res, err := authorHandler.GetByIDs(context.Background(), &pb.AuthorGetRequest{AuthorId: []int64{1, 2}})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetAuthors(), 2)
+}
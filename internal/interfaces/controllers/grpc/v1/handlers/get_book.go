@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/mathbdw/book/internal/domain/entities"
 	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
 	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/response"
 	errs "github.com/mathbdw/book/internal/errors"
@@ -56,9 +57,28 @@ func (bh *BookHandler) GetByIDs(ctx context.Context, req *pb.BookGetRequest) (*p
 		return nil, status.Error(statusCode, err.Error())
 	}
 
+	asOf, err := converters.AsOfToTime(req.GetAsOf())
+	if err != nil {
+		logger.Info("grpcBook.GetByIDs: as_of", map[string]any{
+			"error": err.Error(),
+			"ids":   req.GetBookId(),
+		})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
 	span.SetAttributes([]observability.Attribute{{Key: "book.ids", Value: req.GetBookId()}})
 
-	books, err := bh.uc.Get.GetByIDs(ctx, req.GetBookId())
+	var books []entities.Book
+	if asOf.IsZero() {
+		books, err = bh.uc.Get.GetByIDs(ctx, req.GetBookId())
+	} else {
+		span.SetAttributes([]observability.Attribute{{Key: "book.asOf", Value: asOf.Format(time.RFC3339Nano)}})
+		books, err = bh.uc.Get.GetByIDsAsOf(ctx, req.GetBookId(), asOf)
+	}
 	if err != nil {
 		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
 
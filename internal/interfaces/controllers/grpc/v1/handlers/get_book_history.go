@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/response"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// GetHistory - returns the versions of the book from a gRPC request.
+// Returns:
+// - *pb.BookHistoryResponse: the versions of the book from the oldest one
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error
+// - codes.NotFound: the book has no history
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (bh *BookHandler) GetHistory(ctx context.Context, req *pb.BookHistoryRequest) (*pb.BookHistoryResponse, error) {
+	start := time.Now()
+	logger := bh.observ.WithContext(ctx)
+	ctx, span := bh.observ.StartSpan(ctx, "v1.BookService.GetHistory")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "GET"},
+		{Key: "http.route", Value: "v1/books/{book_id}/history"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		bh.observ.RecordHanderRequest(ctx, "GET", "v1/books/{book_id}/history", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcBook.GetHistory: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "book.id", Value: req.GetBookId()}})
+
+	history, err := bh.uc.History.Execute(ctx, req.GetBookId())
+	if err != nil {
+		logger.Info("grpcBook.GetHistory: usecase", map[string]any{
+			"error": err.Error(),
+			"id":    req.GetBookId(),
+		})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		if errors.Is(err, errs.ErrNotFound) {
+			statusCode = codes.NotFound
+			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "book.versions", Value: len(history)}})
+
+	return response.GetHistoryResponse(history), nil
+}
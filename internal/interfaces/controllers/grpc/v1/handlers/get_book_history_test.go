@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/usecases/book"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBook_GetHistory_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	historyRepo := mocks.NewMockBookHistoryRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := book.New(book.WithHistoryBookUsecase(book.NewHistoryBookUsecase(historyRepo, createMockUsecaseObservability(ctrl))))
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+
+	res, err := bookHandler.GetHistory(context.Background(), &pb.BookHistoryRequest{BookId: 0})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBook_GetHistory_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	historyRepo := mocks.NewMockBookHistoryRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := book.New(book.WithHistoryBookUsecase(book.NewHistoryBookUsecase(historyRepo, createMockUsecaseObservability(ctrl))))
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+
+	historyRepo.EXPECT().
+		GetByBookID(gomock.Any(), int64(5)).
+		Return(nil, errs.Wrap(errs.ErrNotFound, "book 5"))
+
+	res, err := bookHandler.GetHistory(context.Background(), &pb.BookHistoryRequest{BookId: 5})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBook_GetHistory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	historyRepo := mocks.NewMockBookHistoryRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := book.New(book.WithHistoryBookUsecase(book.NewHistoryBookUsecase(historyRepo, createMockUsecaseObservability(ctrl))))
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	changedAt := time.Date(2025, 10, 20, 10, 0, 0, 0, time.UTC)
+
+	historyRepo.EXPECT().
+		GetByBookID(gomock.Any(), int64(5)).
+		Return([]entities.BookHistory{
+			{Book: entities.Book{ID: 5, Title: "Dune", Version: 1}, Type: entities.Created, ChangedAt: changedAt},
+			{Book: entities.Book{ID: 5, Title: "Dune", Version: 2, Removed: true}, Type: entities.Deleted, Actor: "telegram:42", ChangedAt: changedAt.Add(time.Hour)},
+		}, nil)
+
+	res, err := bookHandler.GetHistory(context.Background(), &pb.BookHistoryRequest{BookId: 5})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetVersions(), 2)
+	assert.Equal(t, pb.BookChangeType_BOOK_CHANGE_TYPE_CREATED, res.GetVersions()[0].GetChange())
+	assert.False(t, res.GetVersions()[0].GetRemoved())
+	assert.Equal(t, int64(2), res.GetVersions()[1].GetBook().GetVersion())
+	assert.Equal(t, pb.BookChangeType_BOOK_CHANGE_TYPE_DELETED, res.GetVersions()[1].GetChange())
+	assert.True(t, res.GetVersions()[1].GetRemoved())
+	assert.Equal(t, "telegram:42", res.GetVersions()[1].GetActor())
+	assert.Equal(t, changedAt.Add(time.Hour), res.GetVersions()[1].GetChangedAt().AsTime())
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// GetByISBN - returns the book by ISBN-10 or ISBN-13 from a gRPC request.
+// Returns:
+// - *pb.Book: the found book
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error or invalid ISBN checksum
+// - codes.NotFound: the book does not exist
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (bh *BookHandler) GetByISBN(ctx context.Context, req *pb.BookGetByISBNRequest) (*pb.Book, error) {
+	start := time.Now()
+	logger := bh.observ.WithContext(ctx)
+	ctx, span := bh.observ.StartSpan(ctx, "v1.BookService.GetByISBN")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "GET"},
+		{Key: "http.route", Value: "v1/books/isbn/{isbn}"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		bh.observ.RecordHanderRequest(ctx, "GET", "v1/books/isbn/{isbn}", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcBook.GetByISBN: validate", map[string]any{
+			"error": err.Error(),
+			"isbn":  req.GetIsbn(),
+		})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	isbn, err := converters.ISBNToBookISBN(req.GetIsbn())
+	if err != nil {
+		logger.Info("grpcBook.GetByISBN: isbn", map[string]any{
+			"error": err.Error(),
+			"isbn":  req.GetIsbn(),
+		})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "book.isbn", Value: isbn}})
+
+	book, err := bh.uc.Get.GetByISBN(ctx, isbn)
+	if err != nil {
+		logger.Info("grpcBook.GetByISBN: usecase", map[string]any{
+			"error": err.Error(),
+			"isbn":  isbn,
+		})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		if errors.Is(err, errs.ErrNotFound) {
+			statusCode = codes.NotFound
+			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "book.id", Value: book.ID}})
+
+	return converters.BookToProtoBook(&book), nil
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBook_GetByISBN_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//getMockUC - get_book_test.go
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		isbn   string
+		errStr string
+	}{
+		{"Empty", "", "value does not match regex pattern"},
+		{"Letters", "isbn-0306406152", "value does not match regex pattern"},
+		{"Checksum", "0306406153", "invalid isbn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := bookHandler.GetByISBN(ctx, &pb.BookGetByISBNRequest{Isbn: tt.isbn})
+
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.errStr)
+		})
+	}
+}
+
+func TestBook_GetByISBN_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//getMockUC - get_book_test.go
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	bookRepo.EXPECT().
+		GetByISBN(gomock.Any(), "9780306406157").
+		Return(entities.Book{}, errs.Wrap(errs.ErrNotFound, "book"))
+
+	res, err := bookHandler.GetByISBN(ctx, &pb.BookGetByISBNRequest{Isbn: "9780306406157"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBook_GetByISBN_ErrorUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//getMockUC - get_book_test.go
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	bookRepo.EXPECT().
+		GetByISBN(gomock.Any(), "9780306406157").
+		Return(entities.Book{}, errs.New("error"))
+
+	res, err := bookHandler.GetByISBN(ctx, &pb.BookGetByISBNRequest{Isbn: "9780306406157"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestBook_GetByISBN_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//getMockUC - get_book_test.go
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	bookRepo.EXPECT().
+		GetByISBN(gomock.Any(), "9780306406157").
+		Return(entities.Book{ID: 1, Title: "Title", ISBN: "9780306406157"}, nil)
+
+	res, err := bookHandler.GetByISBN(ctx, &pb.BookGetByISBNRequest{Isbn: "0-306-40615-2"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), res.Id)
+	assert.Equal(t, "9780306406157", res.Isbn)
+}
@@ -3,10 +3,12 @@ package handlers
 import (
 	"context"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/mathbdw/book/internal/domain/entities"
 	errs "github.com/mathbdw/book/internal/errors"
@@ -21,16 +23,21 @@ func getMockUC(ctrl *gomock.Controller, bookRepo repositories.BookRepository) *b
 	uowRepo := mocks.NewMockUnitOfWork(ctrl)
 	observUsecase := createMockUsecaseObservability(ctrl)
 
-	addUC := book.NewAddBookUsecase(uowRepo, observUsecase)
-	getUC := book.NewGetBookUsecase(bookRepo, observUsecase)
+	addUC := book.NewAddBookUsecase(uowRepo, observUsecase, time.Hour)
+	redirectRepo := mocks.NewMockBookRedirectRepository(ctrl)
+	redirectRepo.EXPECT().GetTargets(gomock.Any(), gomock.Any()).Return(map[int64]int64{}, nil).AnyTimes()
+
+	getUC := book.NewGetBookUsecase(bookRepo, redirectRepo, observUsecase)
 	listUC := book.NewListBookUsecase(bookRepo, observUsecase)
-	removeUC := book.NewRemoveBookUsecase(uowRepo, observUsecase)
+	removeUC := book.NewRemoveBookUsecase(uowRepo, observUsecase, time.Hour)
+	searchUC := book.NewSearchBookUsecase(bookRepo, observUsecase)
 
 	return book.New(
 		book.WithAddBookUsecase(addUC),
 		book.WithGetBookUsecase(getUC),
 		book.WithListBookUsecase(listUC),
 		book.WithRemoveBookUsecase(removeUC),
+		book.WithSearchBookUsecase(searchUC),
 	)
 }
 
@@ -142,3 +149,48 @@ func TestBook_GetByIDs_Success(t *testing.T) {
 	assert.Equal(t, 2, len(res.Book))
 	assert.NoError(t, err)
 }
+
+func TestBook_GetByIDs_AsOf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+	asOf := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	bookRepo.EXPECT().
+		GetByIDsAsOf(gomock.Any(), []int64{1}, asOf).
+		Return([]entities.Book{{ID: 1, Title: "Old Title", Version: 2}}, nil)
+
+	res, err := bookHandler.GetByIDs(ctx, &pb.BookGetRequest{
+		BookId: []int64{1},
+		AsOf:   timestamppb.New(asOf),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Old Title", res.GetBook()[0].GetTitle())
+}
+
+func TestBook_GetByIDs_ErrorAsOf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	res, err := bookHandler.GetByIDs(ctx, &pb.BookGetRequest{
+		BookId: []int64{1},
+		AsOf:   &timestamppb.Timestamp{Seconds: 1, Nanos: -1},
+	})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
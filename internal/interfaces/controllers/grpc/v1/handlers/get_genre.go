@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/response"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// GetByIDs - returns the genres based on data from a gRPC request.
+// Returns:
+// - *pb.GenresResponse: the found genres
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error
+// - codes.NotFound: none of the genres exist
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (gh *GenreHandler) GetByIDs(ctx context.Context, req *pb.GenreGetRequest) (*pb.GenresResponse, error) {
+	start := time.Now()
+	logger := gh.observ.WithContext(ctx)
+	ctx, span := gh.observ.StartSpan(ctx, "v1.GenreService.GetByIDs")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "GET"},
+		{Key: "http.route", Value: "v1/genres"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		gh.observ.RecordHanderRequest(ctx, "GET", "v1/genres", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcGenre.GetByIDs: validate", map[string]any{
+			"error": err.Error(),
+			"ids":   req.GetGenreId(),
+		})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "genre.ids", Value: req.GetGenreId()}})
+
+	genres, err := gh.uc.Get.GetByIDs(ctx, req.GetGenreId())
+	if err != nil {
+		logger.Info("grpcGenre.GetByIDs: usecase", map[string]any{
+			"error": err.Error(),
+			"ids":   req.GetGenreId(),
+		})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		if errors.Is(err, errs.ErrNotFound) {
+			statusCode = codes.NotFound
+			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return response.GetGenresResponse(genres), nil
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestGenre_GetByIDs_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, nil), observ: observHandler}
+
+	genreRepo.EXPECT().GetByIDs(gomock.Any(), gomock.Any()).Times(0)
+
+	res, err := genreHandler.GetByIDs(context.Background(), &pb.GenreGetRequest{GenreId: []int64{0}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGenre_GetByIDs_ErrorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, nil), observ: observHandler}
+
+	genreRepo.EXPECT().
+		GetByIDs(gomock.Any(), []int64{1}).
+		Return(nil, errs.ErrNotFound)
+
+	res, err := genreHandler.GetByIDs(context.Background(), &pb.GenreGetRequest{GenreId: []int64{1}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGenre_GetByIDs_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, nil), observ: observHandler}
+
+	genreRepo.EXPECT().
+		GetByIDs(gomock.Any(), []int64{1, 2}).
+		Return([]entities.Genre{{ID: 1, Name: "Fiction"}, {ID: 2, Name: "Fantasy"}}, nil)
+
+	res, err := genreHandler.GetByIDs(context.Background(), &pb.GenreGetRequest{GenreId: []int64{1, 2}})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetGenres(), 2)
+	assert.Equal(t, "Fantasy", res.GetGenres()[1].GetName())
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"unicode"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+)
+
+const (
+	// idempotencyKeyMetadata - metadata key of the idempotency key, the REST gateway forwards the Idempotency-Key header to it
+	idempotencyKeyMetadata = "idempotency-key"
+	// idempotencyKeyMaxLen - max length of the idempotency key
+	idempotencyKeyMaxLen = 128
+)
+
+// idempotencyPreconditions - metadata of the preconditions, a retry with other preconditions is another request
+var idempotencyPreconditions = []string{ifMatchMetadata}
+
+// idempotencyKey - returns the idempotency key of the request from the metadata with the fingerprint of the request
+// and its preconditions, the empty key when the client sent none
+func idempotencyKey(ctx context.Context, req proto.Message) (entities.IdempotencyKey, error) {
+	data, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return entities.IdempotencyKey{}, nil
+	}
+
+	values := data.Get(idempotencyKeyMetadata)
+	if len(values) == 0 {
+		return entities.IdempotencyKey{}, nil
+	}
+
+	key := values[0]
+	if len(key) == 0 || len(key) > idempotencyKeyMaxLen {
+		return entities.IdempotencyKey{}, fmt.Errorf("idempotency-key: length must be from 1 to %d", idempotencyKeyMaxLen)
+	}
+
+	for _, r := range key {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return entities.IdempotencyKey{}, fmt.Errorf("idempotency-key: only printable ASCII is allowed")
+		}
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return entities.IdempotencyKey{}, fmt.Errorf("idempotency-key: request fingerprint: %w", err)
+	}
+	hash := sha256.New()
+	hash.Write(body)
+	for _, name := range idempotencyPreconditions {
+		for _, value := range data.Get(name) {
+			fmt.Fprintf(hash, "\n%s: %s", name, value)
+		}
+	}
+
+	return entities.IdempotencyKey{Key: key, RequestHash: hex.EncodeToString(hash.Sum(nil))}, nil
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       []string
+		wantKey   string
+		wantError bool
+	}{
+		{"NoMetadata", nil, "", false},
+		{"Key", []string{"3f2c-7a"}, "3f2c-7a", false},
+		{"Empty", []string{""}, "", true},
+		{"TooLong", []string{strings.Repeat("k", idempotencyKeyMaxLen+1)}, "", true},
+		{"NotPrintable", []string{"key\n1"}, "", true},
+		{"NotASCII", []string{"ключ"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.key != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(idempotencyKeyMetadata, tt.key[0]))
+			}
+
+			key, err := idempotencyKey(ctx, &pb.BookChangeRequest{BookId: []int64{1}})
+
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantKey, key.Key)
+		})
+	}
+}
+
+func TestIdempotencyKey_RequestHash(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyMetadata, "key-1"))
+
+	first, err := idempotencyKey(ctx, &pb.BookChangeRequest{BookId: []int64{1}})
+	require.NoError(t, err)
+	same, err := idempotencyKey(ctx, &pb.BookChangeRequest{BookId: []int64{1}})
+	require.NoError(t, err)
+	other, err := idempotencyKey(ctx, &pb.BookChangeRequest{BookId: []int64{2}})
+	require.NoError(t, err)
+
+	assert.Len(t, first.RequestHash, 64)
+	assert.Equal(t, first.RequestHash, same.RequestHash)
+	assert.NotEqual(t, first.RequestHash, other.RequestHash)
+}
+
+func TestIdempotencyKey_RequestHashPreconditions(t *testing.T) {
+	req := &pb.BookChangeRequest{BookId: []int64{1}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyMetadata, "key-1"))
+	ctxIfMatch := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyMetadata, "key-1", ifMatchMetadata, `"3"`))
+	ctxOtherIfMatch := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyMetadata, "key-1", ifMatchMetadata, `"4"`))
+
+	plain, err := idempotencyKey(ctx, req)
+	require.NoError(t, err)
+	ifMatch, err := idempotencyKey(ctxIfMatch, req)
+	require.NoError(t, err)
+	otherIfMatch, err := idempotencyKey(ctxOtherIfMatch, req)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, plain.RequestHash, ifMatch.RequestHash)
+	assert.NotEqual(t, ifMatch.RequestHash, otherIfMatch.RequestHash)
+}
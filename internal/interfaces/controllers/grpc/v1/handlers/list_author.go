@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/response"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// List - returns the page of authors ordered by ID based on data from a gRPC request.
+// Returns:
+// - *pb.AuthorListResponse: the authors and the ID to request the next page after
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (ah *AuthorHandler) List(ctx context.Context, req *pb.AuthorListRequest) (*pb.AuthorListResponse, error) {
+	start := time.Now()
+	logger := ah.observ.WithContext(ctx)
+	ctx, span := ah.observ.StartSpan(ctx, "v1.AuthorService.List")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "GET"},
+		{Key: "http.route", Value: "v1/author-list"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		ah.observ.RecordHanderRequest(ctx, "GET", "v1/author-list", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcAuthor.List: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	params := entities.AuthorListParams{Limit: req.GetPageSize(), AfterID: req.GetAfterId()}
+	span.SetAttributes([]observability.Attribute{
+		{Key: "limit", Value: int64(params.Limit)},
+		{Key: "after_id", Value: params.AfterID},
+	})
+
+	resp, err := ah.uc.List.Execute(ctx, params)
+	if err != nil {
+		logger.Info("grpcAuthor.List: usecase", map[string]any{"error": err.Error()})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+		statusCode = codes.Internal
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return response.GetAuthorListResponse(resp), nil
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestAuthor_List_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	res, err := authorHandler.List(context.Background(), &pb.AuthorListRequest{PageSize: 3})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthor_List_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	authorRepo.EXPECT().
+		List(gomock.Any(), entities.AuthorListParams{Limit: 2, AfterID: 4}).
+		Return(&entities.ResponseAuthors{Data: []entities.Author{{ID: 5}, {ID: 6}}, NextID: 6}, nil)
+
+	res, err := authorHandler.List(context.Background(), &pb.AuthorListRequest{PageSize: 2, AfterId: 4})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetAuthors(), 2)
+	assert.Equal(t, int64(6), res.GetNextAfterId())
+}
@@ -2,12 +2,14 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errs "github.com/mathbdw/book/internal/errors"
 	"github.com/mathbdw/book/internal/interfaces/observability"
 	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
 	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/response"
@@ -20,7 +22,7 @@ import (
 // - error: validation or business logic error
 //
 // Errors:
-// - codes.InvalidArgument: input data validation error
+// - codes.InvalidArgument: input data validation error, invalid year range, a forged or expired cursor or a cursor of another filter
 // - codes.Internal: database or usecase level error
 //
 // Logging:
@@ -31,7 +33,7 @@ func (bh *BookHandler) List(ctx context.Context, req *pb.BookListRequest) (*pb.B
 	ctx, span := bh.observ.StartSpan(ctx, "v1.BookService.List")
 	span.SetAttributes([]observability.Attribute{
 		{Key: "http.method", Value: "GET"},
-		{Key: "http.methrouteod", Value: "v1/book-list"},
+		{Key: "http.route", Value: "v1/book-list"},
 	})
 
 	defer span.End()
@@ -58,17 +60,40 @@ func (bh *BookHandler) List(ctx context.Context, req *pb.BookListRequest) (*pb.B
 		span.SetAttributes([]observability.Attribute{{Key: "validation_cursor.failed", Value: true}})
 		statusCode = codes.InvalidArgument
 
-		return nil, status.Error(statusCode, "invalid cursor")
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	params.Filter, err = converters.ListRequestToBookFilter(req)
+	if err != nil {
+		logger.Info("grpcBook.List: validate filter", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation_filter.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
 	}
 
 	span.SetAttributes([]observability.Attribute{
 		{Key: "limit", Value: int64(params.Limit)},
 		{Key: "sort_by", Value: string(params.SortBy)},
 		{Key: "sort_order", Value: string(params.SortOrder)},
+		{Key: "then_by", Value: fmt.Sprintf("%v", params.ThenBy)},
+		{Key: "filter.author_id", Value: params.Filter.AuthorID},
+		{Key: "filter.genre_id", Value: params.Filter.GenreID},
+		{Key: "filter.year_from", Value: int64(params.Filter.YearFrom)},
+		{Key: "filter.year_to", Value: int64(params.Filter.YearTo)},
+		{Key: "filter.title_prefix", Value: params.Filter.TitlePrefix},
+		{Key: "filter.include_removed", Value: params.Filter.IncludeRemoved},
+		{Key: "with_total_count", Value: params.WithTotalCount},
 	})
 
 	if params.Cursor != nil {
-		span.SetAttributes([]observability.Attribute{{Key: "cursor.value", Value: fmt.Sprintf("%v", params.Cursor.Value)}})
+		span.SetAttributes([]observability.Attribute{
+			{Key: "cursor.version", Value: int64(params.Cursor.Version)},
+			{Key: "cursor.keys", Value: fmt.Sprintf("%v", params.Cursor.Keys)},
+			{Key: "cursor.id", Value: params.Cursor.ID},
+			{Key: "cursor.backward", Value: params.Cursor.Backward},
+		})
 		if params.Cursor.CreatedAt != nil {
 			span.SetAttributes([]observability.Attribute{{Key: "cursor.created_at_unix", Value: params.Cursor.CreatedAt.Unix()}})
 		}
@@ -79,6 +104,9 @@ func (bh *BookHandler) List(ctx context.Context, req *pb.BookListRequest) (*pb.B
 		logger.Info("grpcBook.List: usecase", map[string]any{"error": err.Error()})
 		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
 		statusCode = codes.Internal
+		if errors.Is(err, errs.ErrInvalidInput) {
+			statusCode = codes.InvalidArgument
+		}
 
 		return nil, status.Error(statusCode, err.Error())
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/mathbdw/book/internal/domain/entities"
 	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/infrastructure/persistence/postgres"
 	"github.com/mathbdw/book/mocks"
 	pb "github.com/mathbdw/book/proto"
 	"github.com/stretchr/testify/assert"
@@ -44,7 +45,7 @@ func TestBook_List_ErrorValidate(t *testing.T) {
 				PageSize: 2,
 				SortBy:   "re",
 			},
-			"value must be in list [id title year]",
+			"value must be in list [id title year genre_id created_at]",
 		},
 		{
 			"sortOrder",
@@ -149,3 +150,97 @@ func TestBook_List_Success(t *testing.T) {
 	assert.Equal(t, "", protoBook.GetPagination().GetCursorNext())
 	assert.Equal(t, 1, len(protoBook.GetBooks()))
 }
+
+var idSort = []entities.SortField{{Field: entities.CursorTypeBookID, Order: entities.SortOrderTypeAsc}}
+
+func TestBook_List_ErrorYearRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	res, err := bookHandler.List(ctx, &pb.BookListRequest{
+		Pagination: &pb.BookListRequest_CursorPagination{
+			PageSize:  2,
+			SortBy:    "id",
+			SortOrder: "asc",
+		},
+		YearFrom: 2000,
+		YearTo:   1900,
+	})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "year_from 2000 is greater than year_to 1900")
+}
+
+func TestBook_List_ErrorCursorOfAnotherFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+	cursor, _ := postgres.EncodeCursor(entities.Cursor{Sort: idSort, Keys: []any{"1"}, ID: 1, Filter: entities.BookFilter{GenreID: 2}.Key()})
+
+	res, err := bookHandler.List(ctx, &pb.BookListRequest{
+		Pagination: &pb.BookListRequest_CursorPagination{
+			Cursor:    cursor,
+			PageSize:  2,
+			SortBy:    "id",
+			SortOrder: "asc",
+		},
+		GenreId: 3,
+	})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "cursor does not match the filter")
+}
+
+func TestBook_List_SuccessFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+	filter := entities.BookFilter{GenreID: 3, YearFrom: 1900, YearTo: 2000, TitlePrefix: "war", IncludeRemoved: true}
+	cursor, _ := postgres.EncodeCursor(entities.Cursor{Sort: idSort, Keys: []any{"1"}, ID: 1, Filter: filter.Key()})
+
+	bookRepo.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, params entities.PaginationParams) (*entities.ResponseBooks, error) {
+			assert.Equal(t, filter, params.Filter)
+
+			return &entities.ResponseBooks{Data: []entities.Book{{ID: 2, Title: "War and Peace"}}}, nil
+		})
+
+	res, err := bookHandler.List(ctx, &pb.BookListRequest{
+		Pagination: &pb.BookListRequest_CursorPagination{
+			Cursor:    cursor,
+			PageSize:  2,
+			SortBy:    "id",
+			SortOrder: "asc",
+		},
+		GenreId:        3,
+		YearFrom:       1900,
+		YearTo:         2000,
+		TitlePrefix:    "war",
+		IncludeRemoved: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetBooks(), 1)
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/response"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// List - returns all genres ordered by ID, the tree is built by the parent_id of the genres.
+// Returns:
+// - *pb.GenresResponse: all genres
+// - error: business logic error
+//
+// Errors:
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: business logic errors
+func (gh *GenreHandler) List(ctx context.Context, _ *emptypb.Empty) (*pb.GenresResponse, error) {
+	start := time.Now()
+	logger := gh.observ.WithContext(ctx)
+	ctx, span := gh.observ.StartSpan(ctx, "v1.GenreService.List")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "GET"},
+		{Key: "http.route", Value: "v1/genre-list"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		gh.observ.RecordHanderRequest(ctx, "GET", "v1/genre-list", int(statusCode), duration)
+	}()
+
+	genres, err := gh.uc.List.Execute(ctx)
+	if err != nil {
+		logger.Info("grpcGenre.List: usecase", map[string]any{"error": err.Error()})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+		statusCode = codes.Internal
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return response.GetGenresResponse(genres), nil
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestGenre_List_ErrorUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, nil), observ: observHandler}
+
+	genreRepo.EXPECT().
+		List(gomock.Any()).
+		Return(nil, errors.New("db error"))
+
+	res, err := genreHandler.List(context.Background(), &emptypb.Empty{})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestGenre_List_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, nil), observ: observHandler}
+	parentID := int64(1)
+
+	genreRepo.EXPECT().
+		List(gomock.Any()).
+		Return([]entities.Genre{{ID: 1, Name: "Fiction"}, {ID: 2, Name: "Fantasy", ParentID: &parentID}}, nil)
+
+	res, err := genreHandler.List(context.Background(), &emptypb.Empty{})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetGenres(), 2)
+	assert.Equal(t, int64(1), res.GetGenres()[1].GetParentId())
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// Merge - merges the duplicate books into the target book based on data from a gRPC request.
+// Returns:
+// - *pb.Book: the target book after the merge
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error or the target is among the sources
+// - codes.NotFound: one of the books does not exist or has been removed
+// - codes.Aborted: the target has been changed during the merge
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (bh *BookHandler) Merge(ctx context.Context, req *pb.BookMergeRequest) (*pb.Book, error) {
+	start := time.Now()
+	logger := bh.observ.WithContext(ctx)
+	ctx, span := bh.observ.StartSpan(ctx, "v1.BookService.Merge")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "POST"},
+		{Key: "http.route", Value: "v1/books/{target_id}/merge"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		bh.observ.RecordHanderRequest(ctx, "POST", "v1/books/{target_id}/merge", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcBook.Merge: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{
+		{Key: "book.id", Value: req.GetTargetId()},
+		{Key: "book.source_ids", Value: req.GetSourceIds()},
+	})
+
+	merged, err := bh.uc.Merge.Execute(withCaller(ctx), req.GetSourceIds(), req.GetTargetId())
+	if err != nil {
+		logger.Info("grpcBook.Merge: usecase", map[string]any{
+			"error":      err.Error(),
+			"id":         req.GetTargetId(),
+			"source_ids": req.GetSourceIds(),
+		})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		if errors.Is(err, errs.ErrInvalidInput) {
+			statusCode = codes.InvalidArgument
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		if errors.Is(err, errs.ErrNotFound) {
+			statusCode = codes.NotFound
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		if errors.Is(err, errs.ErrVersionMismatch) {
+			statusCode = codes.Aborted
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return converters.BookToProtoBook(&merged), nil
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/internal/usecases/book"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func mergeMockUC(ctrl *gomock.Controller, uowRepo repositories.UnitOfWork) *book.BookUsecases {
+	observUsecase := createMockUsecaseObservability(ctrl)
+
+	mergeUC := book.NewMergeBookUsecase(uowRepo, observUsecase)
+
+	return book.New(
+		book.WithMergeBookUsecase(mergeUC),
+	)
+}
+
+func TestBook_Merge_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := mergeMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *pb.BookMergeRequest
+	}{
+		{"InvalidTarget", &pb.BookMergeRequest{SourceIds: []int64{2}, TargetId: 0}},
+		{"EmptySources", &pb.BookMergeRequest{TargetId: 1}},
+		{"DuplicateSources", &pb.BookMergeRequest{SourceIds: []int64{2, 2}, TargetId: 1}},
+		{"InvalidSource", &pb.BookMergeRequest{SourceIds: []int64{0}, TargetId: 1}},
+		{"TargetInSources", &pb.BookMergeRequest{SourceIds: []int64{1, 2}, TargetId: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := bookHandler.Merge(ctx, tt.req)
+
+			assert.Nil(t, res)
+			assert.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestBook_Merge_ErrorUsecase_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := mergeMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookRepo.EXPECT().
+				GetByIDs(gomock.Any(), []int64{1, 2}).
+				Return([]entities.Book{{ID: 1, Title: "Title"}}, nil)
+
+			return fn(&repositories.Repository{Book: bookRepo})
+		})
+
+	res, err := bookHandler.Merge(ctx, &pb.BookMergeRequest{SourceIds: []int64{2}, TargetId: 1})
+
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBook_Merge_ErrorUsecase_VersionMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := mergeMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).Return(errs.ErrVersionMismatch)
+
+	res, err := bookHandler.Merge(ctx, &pb.BookMergeRequest{SourceIds: []int64{2}, TargetId: 1})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func TestBook_Merge_ErrorUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := mergeMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).Return(errs.New("db error"))
+
+	res, err := bookHandler.Merge(ctx, &pb.BookMergeRequest{SourceIds: []int64{2}, TargetId: 1})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestBook_Merge_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryRepo := mocks.NewMockBookHistoryRepository(ctrl)
+	bookRedirectRepo := mocks.NewMockBookRedirectRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := mergeMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookRepo.EXPECT().
+				GetByIDs(gomock.Any(), []int64{1, 2}).
+				Return([]entities.Book{{ID: 1, Title: "Title", Version: 1}, {ID: 2, Title: "Title", Year: 1900}}, nil)
+
+			bookRepo.EXPECT().
+				Remove(gomock.Any(), []int64{2}, int64(0)).
+				Return(nil)
+
+			bookRepo.EXPECT().
+				Update(gomock.Any(), entities.Book{ID: 1, Title: "Title", Year: 1900, Version: 1}, []entities.BookField{entities.BookFieldYear}).
+				Return(entities.Book{ID: 1, Title: "Title", Year: 1900, Version: 2}, nil)
+
+			bookRedirectRepo.EXPECT().
+				Add(gomock.Any(), []int64{2}, int64(1)).
+				Return(nil)
+
+			bookEventRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(int64(1), nil)
+
+			bookHistoryRepo.EXPECT().
+				Record(gomock.Any(), []int64{1, 2}, entities.Merged, "").
+				Return(nil)
+
+			return fn(&repositories.Repository{
+				Book:         bookRepo,
+				BookEvent:    bookEventRepo,
+				BookHistory:  bookHistoryRepo,
+				BookRedirect: bookRedirectRepo,
+			})
+		})
+
+	res, err := bookHandler.Merge(ctx, &pb.BookMergeRequest{SourceIds: []int64{2}, TargetId: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), res.GetId())
+	assert.Equal(t, int32(1900), res.GetYear())
+	assert.Equal(t, int64(2), res.GetVersion())
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// Delete - deletes the authors based on data from a gRPC request.
+// Authors linked to any book are not deleted.
+// Returns:
+// - *emptypb.Empty: empty response on successful deletion
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error
+// - codes.NotFound: some of the authors do not exist
+// - codes.FailedPrecondition: the authors are linked to books
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (ah *AuthorHandler) Delete(ctx context.Context, req *pb.AuthorGetRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	logger := ah.observ.WithContext(ctx)
+	ctx, span := ah.observ.StartSpan(ctx, "v1.AuthorService.Delete")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "DELETE"},
+		{Key: "http.route", Value: "v1/authors"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		ah.observ.RecordHanderRequest(ctx, "DELETE", "v1/authors", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcAuthor.Delete: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "author.ids", Value: req.GetAuthorId()}})
+
+	err := ah.uc.Remove.Execute(ctx, req.GetAuthorId())
+	if err != nil {
+		logger.Info("grpcAuthor.Delete: usecase", map[string]any{
+			"error": err.Error(),
+			"ids":   req.GetAuthorId(),
+		})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			statusCode = codes.NotFound
+			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
+		case errors.Is(err, errs.ErrConflict):
+			statusCode = codes.FailedPrecondition
+			return nil, status.Error(statusCode, "authors are linked to books")
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestAuthor_Delete_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, nil, uowRepo), observ: observHandler}
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).Times(0)
+
+	res, err := authorHandler.Delete(context.Background(), &pb.AuthorGetRequest{AuthorId: []int64{}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthor_Delete_ErrorLinked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, uowRepo), observ: observHandler}
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			authorRepo.EXPECT().
+				CountBooks(gomock.Any(), []int64{1}).
+				Return(int64(2), nil)
+
+			return fn(&repositories.Repository{Author: authorRepo})
+		})
+
+	res, err := authorHandler.Delete(context.Background(), &pb.AuthorGetRequest{AuthorId: []int64{1}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestAuthor_Delete_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, uowRepo), observ: observHandler}
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			authorRepo.EXPECT().
+				CountBooks(gomock.Any(), []int64{1}).
+				Return(int64(0), nil)
+			authorRepo.EXPECT().
+				Remove(gomock.Any(), []int64{1}).
+				Return(nil)
+
+			return fn(&repositories.Repository{Author: authorRepo})
+		})
+
+	res, err := authorHandler.Delete(context.Background(), &pb.AuthorGetRequest{AuthorId: []int64{1}})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+}
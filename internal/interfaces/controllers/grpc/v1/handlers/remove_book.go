@@ -3,35 +3,39 @@ package handlers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
-	"github.com/mathbdw/book/internal/interfaces/observability"
 	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
 	pb "github.com/mathbdw/book/proto"
 )
 
 // Delete - deletes the books based on data from a gRPC request.
+// The repeated request with the same idempotency-key metadata succeeds without deleting again.
 // Returns:
 // - *emptypb.Empty: empty response on successful creation
 // - error: validation or business logic error
 //
 // Errors:
-// - codes.InvalidArgument: input data validation error
+// - codes.InvalidArgument: input data validation error, expected version with several books or invalid idempotency key
+// - codes.Aborted: the book is at another version than expected
+// - codes.FailedPrecondition: the idempotency key is used by another request
 // - codes.Internal: database or usecase level error
 //
 // Logging:
 // - Info level: validation and business logic errors
-func (bh *BookHandler) Delete(ctx context.Context, req *pb.BookGetRequest) (*emptypb.Empty, error) {
+func (bh *BookHandler) Delete(ctx context.Context, req *pb.BookChangeRequest) (*emptypb.Empty, error) {
 	start := time.Now()
 	logger := bh.observ.WithContext(ctx)
 	ctx, span := bh.observ.StartSpan(ctx, "v1.BookService.Delete")
 	span.SetAttributes([]observability.Attribute{
 		{Key: "http.method", Value: "DELETE"},
-		{Key: "http.methrouteod", Value: "v1/books"},
+		{Key: "http.route", Value: "v1/books"},
 	})
 
 	defer span.End()
@@ -51,9 +55,36 @@ func (bh *BookHandler) Delete(ctx context.Context, req *pb.BookGetRequest) (*emp
 		return nil, status.Error(statusCode, "invalid arguments")
 	}
 
-	span.SetAttributes([]observability.Attribute{{Key: "book.ids", Value: req.GetBookId()}})
+	version, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err == nil && version > 0 && len(req.GetBookId()) != 1 {
+		err = fmt.Errorf("expected version needs one book_id, got %d", len(req.GetBookId()))
+	}
+	if err != nil {
+		logger.Info("grpcBook.Delete: validate expected version", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation_version.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
 
-	err := bh.uc.Remove.Execute(ctx, req.GetBookId())
+	idempotency, err := idempotencyKey(ctx, req)
+	if err != nil {
+		logger.Info("grpcBook.Delete: validate idempotency key", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation_idempotency.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{
+		{Key: "book.ids", Value: req.GetBookId()},
+		{Key: "book.expected_version", Value: version},
+		{Key: "idempotency.key", Value: idempotency.Key},
+	})
+
+	err = bh.uc.Remove.Execute(withCaller(ctx), req.GetBookId(), version, idempotency)
 	if err != nil {
 		logger.Info(
 			"grpcBook.Delete: usecase",
@@ -69,6 +100,16 @@ func (bh *BookHandler) Delete(ctx context.Context, req *pb.BookGetRequest) (*emp
 			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
 		}
 
+		if errors.Is(err, errs.ErrVersionMismatch) {
+			statusCode = codes.Aborted
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		if errors.Is(err, errs.ErrConflict) {
+			statusCode = codes.FailedPrecondition
+			return nil, status.Error(statusCode, err.Error())
+		}
+
 		statusCode = codes.Internal
 		return nil, status.Error(statusCode, err.Error())
 	}
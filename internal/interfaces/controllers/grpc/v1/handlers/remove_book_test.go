@@ -3,14 +3,16 @@ package handlers
 import (
 	"context"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
-	"github.com/mathbdw/book/internal/usecases/book"
 	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/internal/usecases/book"
 
 	"github.com/mathbdw/book/internal/domain/entities"
 	errs "github.com/mathbdw/book/internal/errors"
@@ -19,13 +21,13 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func removeMockUC(ctrl *gomock.Controller, uowRepo repositories.UnitOfWork, bookRepo repositories.BookRepository) *book.BookUsecases{
+func removeMockUC(ctrl *gomock.Controller, uowRepo repositories.UnitOfWork, bookRepo repositories.BookRepository) *book.BookUsecases {
 	observUsecase := createMockUsecaseObservability(ctrl)
 
-	addUC := book.NewAddBookUsecase(uowRepo, observUsecase)
-	getUC := book.NewGetBookUsecase(bookRepo, observUsecase)
+	addUC := book.NewAddBookUsecase(uowRepo, observUsecase, time.Hour)
+	getUC := book.NewGetBookUsecase(bookRepo, nil, observUsecase)
 	listUC := book.NewListBookUsecase(bookRepo, observUsecase)
-	removeUC := book.NewRemoveBookUsecase(uowRepo, observUsecase)
+	removeUC := book.NewRemoveBookUsecase(uowRepo, observUsecase, time.Hour)
 
 	return book.New(
 		book.WithAddBookUsecase(addUC),
@@ -46,7 +48,7 @@ func TestBook_Delete_ErrorValidate(t *testing.T) {
 	bookHandler := &BookHandler{uc: uc, observ: observHandler}
 	ctx := context.Background()
 
-	res, err := bookHandler.Delete(ctx, &pb.BookGetRequest{BookId: []int64{}})
+	res, err := bookHandler.Delete(ctx, &pb.BookChangeRequest{BookId: []int64{}})
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -68,7 +70,7 @@ func TestBook_Delete_ErrorUsecase_NotFound(t *testing.T) {
 	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
 			bookRepo.EXPECT().
-				Remove(ctx, gomock.Any()).
+				Remove(ctx, gomock.Any(), int64(0)).
 				Return(errs.ErrNotFound)
 
 			bookEventRepo.EXPECT().
@@ -84,7 +86,7 @@ func TestBook_Delete_ErrorUsecase_NotFound(t *testing.T) {
 			return fn(repo)
 		})
 
-	res, err := bookHandler.Delete(ctx, &pb.BookGetRequest{BookId: []int64{1}})
+	res, err := bookHandler.Delete(ctx, &pb.BookChangeRequest{BookId: []int64{1}})
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -107,7 +109,7 @@ func TestBook_Delete_ErrorUsecase(t *testing.T) {
 	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
 			bookRepo.EXPECT().
-				Remove(ctx, gomock.Any()).
+				Remove(ctx, gomock.Any(), int64(0)).
 				Return(errs.New("error"))
 
 			bookEventRepo.EXPECT().
@@ -123,7 +125,7 @@ func TestBook_Delete_ErrorUsecase(t *testing.T) {
 			return fn(repo)
 		})
 
-	res, err := bookHandler.Delete(ctx, &pb.BookGetRequest{BookId: []int64{1}})
+	res, err := bookHandler.Delete(ctx, &pb.BookChangeRequest{BookId: []int64{1}})
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -137,6 +139,7 @@ func TestBook_Delete_Success(t *testing.T) {
 	uowRepo := mocks.NewMockUnitOfWork(ctrl)
 	bookRepo := mocks.NewMockBookRepository(ctrl)
 	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryRepo := mocks.NewMockBookHistoryRepository(ctrl)
 	observHandler := createMockHandlerObservability(ctrl)
 	uc := removeMockUC(ctrl, uowRepo, bookRepo)
 	bookHandler := &BookHandler{uc: uc, observ: observHandler}
@@ -146,7 +149,7 @@ func TestBook_Delete_Success(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
 			ids := []int64{1, 2}
 			bookRepo.EXPECT().
-				Remove(ctx, ids).
+				Remove(ctx, ids, int64(0)).
 				Return(nil)
 
 			for _, id := range ids {
@@ -156,16 +159,70 @@ func TestBook_Delete_Success(t *testing.T) {
 					Return(int64(1), nil)
 			}
 
+			bookHistoryRepo.EXPECT().
+				Record(gomock.Any(), gomock.Any(), entities.Deleted, "").
+				Return(nil)
+
 			repo := &repositories.Repository{
-				Book:      bookRepo,
-				BookEvent: bookEventRepo,
+				Book:        bookRepo,
+				BookEvent:   bookEventRepo,
+				BookHistory: bookHistoryRepo,
 			}
 
 			return fn(repo)
 		})
 
-	res, err := bookHandler.Delete(ctx, &pb.BookGetRequest{BookId: []int64{1, 2}})
+	res, err := bookHandler.Delete(ctx, &pb.BookChangeRequest{BookId: []int64{1, 2}})
 
 	assert.Nil(t, err)
 	assert.Equal(t, &emptypb.Empty{}, res)
 }
+
+func TestBook_Delete_ErrorExpectedVersionSeveralBooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := removeMockUC(ctrl, uowRepo, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	res, err := bookHandler.Delete(ctx, &pb.BookChangeRequest{BookId: []int64{1, 2}, ExpectedVersion: 3})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBook_Delete_ErrorVersionMismatchIfMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := removeMockUC(ctrl, uowRepo, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", `"3"`))
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookRepo.EXPECT().
+				Remove(ctx, []int64{1}, int64(3)).
+				Return(errs.Wrap(errs.ErrVersionMismatch, "book 1 is at version 4, expected 3"))
+
+			repo := &repositories.Repository{
+				Book:      bookRepo,
+				BookEvent: bookEventRepo,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Delete(ctx, &pb.BookChangeRequest{BookId: []int64{1}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// Delete - deletes the genres based on data from a gRPC request.
+// Genres that have books or child genres are not deleted.
+// Returns:
+// - *emptypb.Empty: empty response on successful deletion
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error
+// - codes.NotFound: some of the genres do not exist
+// - codes.FailedPrecondition: the genres have books or child genres
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (gh *GenreHandler) Delete(ctx context.Context, req *pb.GenreGetRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	logger := gh.observ.WithContext(ctx)
+	ctx, span := gh.observ.StartSpan(ctx, "v1.GenreService.Delete")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "DELETE"},
+		{Key: "http.route", Value: "v1/genres"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		gh.observ.RecordHanderRequest(ctx, "DELETE", "v1/genres", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcGenre.Delete: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "genre.ids", Value: req.GetGenreId()}})
+
+	err := gh.uc.Remove.Execute(ctx, req.GetGenreId())
+	if err != nil {
+		logger.Info("grpcGenre.Delete: usecase", map[string]any{
+			"error": err.Error(),
+			"ids":   req.GetGenreId(),
+		})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			statusCode = codes.NotFound
+			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
+		case errors.Is(err, errs.ErrConflict):
+			statusCode = codes.FailedPrecondition
+			return nil, status.Error(statusCode, "genres have books or child genres")
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestGenre_Delete_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, nil, uowRepo), observ: observHandler}
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).Times(0)
+
+	res, err := genreHandler.Delete(context.Background(), &pb.GenreGetRequest{GenreId: []int64{}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGenre_Delete_ErrorHasChildren(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, uowRepo), observ: observHandler}
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreRepo.EXPECT().
+				CountBooks(gomock.Any(), []int64{1}).
+				Return(int64(0), nil)
+			genreRepo.EXPECT().
+				CountChildren(gomock.Any(), []int64{1}).
+				Return(int64(1), nil)
+
+			return fn(&repositories.Repository{Genre: genreRepo})
+		})
+
+	res, err := genreHandler.Delete(context.Background(), &pb.GenreGetRequest{GenreId: []int64{1}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGenre_Delete_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, uowRepo), observ: observHandler}
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreRepo.EXPECT().
+				CountBooks(gomock.Any(), []int64{1}).
+				Return(int64(0), nil)
+			genreRepo.EXPECT().
+				CountChildren(gomock.Any(), []int64{1}).
+				Return(int64(0), nil)
+			genreRepo.EXPECT().
+				Remove(gomock.Any(), []int64{1}).
+				Return(nil)
+
+			return fn(&repositories.Repository{Genre: genreRepo})
+		})
+
+	res, err := genreHandler.Delete(context.Background(), &pb.GenreGetRequest{GenreId: []int64{1}})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+}
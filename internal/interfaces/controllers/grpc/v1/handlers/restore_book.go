@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	errs "github.com/mathbdw/book/internal/errors"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// Restore - restores the soft-deleted books based on data from a gRPC request.
+// Returns:
+// - *emptypb.Empty: empty response on successful restoration
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error or expected version with several books
+// - codes.Aborted: the book is at another version than expected
+// - codes.AlreadyExists: a not removed book with the same ISBN exists
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (bh *BookHandler) Restore(ctx context.Context, req *pb.BookChangeRequest) (*emptypb.Empty, error) {
+	start := time.Now()
+	logger := bh.observ.WithContext(ctx)
+	ctx, span := bh.observ.StartSpan(ctx, "v1.BookService.Restore")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "POST"},
+		{Key: "http.route", Value: "v1/books/restore"},
+	})
+
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		bh.observ.RecordHanderRequest(ctx, "POST", "v1/books/restore", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcBook.Restore: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, "invalid arguments")
+	}
+
+	version, err := expectedVersion(ctx, req.GetExpectedVersion())
+	if err == nil && version > 0 && len(req.GetBookId()) != 1 {
+		err = fmt.Errorf("expected version needs one book_id, got %d", len(req.GetBookId()))
+	}
+	if err != nil {
+		logger.Info("grpcBook.Restore: validate expected version", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation_version.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{
+		{Key: "book.ids", Value: req.GetBookId()},
+		{Key: "book.expected_version", Value: version},
+	})
+
+	err = bh.uc.Restore.Execute(withCaller(ctx), req.GetBookId(), version)
+	if err != nil {
+		logger.Info(
+			"grpcBook.Restore: usecase",
+			map[string]any{
+				"error": err.Error(),
+				"ids":   req.GetBookId(),
+			},
+		)
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		if errors.Is(err, errs.ErrNotFound) {
+			statusCode = codes.InvalidArgument
+			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
+		}
+
+		if errors.Is(err, errs.ErrVersionMismatch) {
+			statusCode = codes.Aborted
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		if errors.Is(err, errs.ErrAlreadyExists) {
+			statusCode = codes.AlreadyExists
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
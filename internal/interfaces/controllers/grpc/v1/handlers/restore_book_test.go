@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/internal/usecases/book"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func restoreMockUC(ctrl *gomock.Controller, uowRepo repositories.UnitOfWork, bookRepo repositories.BookRepository) *book.BookUsecases {
+	observUsecase := createMockUsecaseObservability(ctrl)
+
+	addUC := book.NewAddBookUsecase(uowRepo, observUsecase, time.Hour)
+	getUC := book.NewGetBookUsecase(bookRepo, nil, observUsecase)
+	listUC := book.NewListBookUsecase(bookRepo, observUsecase)
+	restoreUC := book.NewRestoreBookUsecase(uowRepo, observUsecase)
+
+	return book.New(
+		book.WithAddBookUsecase(addUC),
+		book.WithGetBookUsecase(getUC),
+		book.WithListBookUsecase(listUC),
+		book.WithRestoreBookUsecase(restoreUC),
+	)
+}
+
+func TestBook_Restore_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := restoreMockUC(ctrl, uowRepo, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	res, err := bookHandler.Restore(ctx, &pb.BookChangeRequest{BookId: []int64{}})
+
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBook_Restore_ErrorUsecase_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := restoreMockUC(ctrl, uowRepo, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookRepo.EXPECT().
+				Restore(ctx, gomock.Any(), int64(0)).
+				Return(errs.ErrNotFound)
+
+			bookEventRepo.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(1), nil).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookRepo,
+				BookEvent: bookEventRepo,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Restore(ctx, &pb.BookChangeRequest{BookId: []int64{1}})
+
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestBook_Restore_ErrorUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := restoreMockUC(ctrl, uowRepo, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookRepo.EXPECT().
+				Restore(ctx, gomock.Any(), int64(0)).
+				Return(errs.New("error"))
+
+			bookEventRepo.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(1), nil).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookRepo,
+				BookEvent: bookEventRepo,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Restore(ctx, &pb.BookChangeRequest{BookId: []int64{1}})
+
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestBook_Restore_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryRepo := mocks.NewMockBookHistoryRepository(ctrl)
+	bookRedirectRepo := mocks.NewMockBookRedirectRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := restoreMockUC(ctrl, uowRepo, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			ids := []int64{1, 2}
+			bookRepo.EXPECT().
+				Restore(ctx, ids, int64(0)).
+				Return(nil)
+
+			bookRedirectRepo.EXPECT().
+				Delete(ctx, ids).
+				Return(nil)
+
+			books := []entities.Book{{ID: 1, Title: "title 1"}, {ID: 2, Title: "title 2"}}
+			bookRepo.EXPECT().
+				GetByIDs(ctx, ids).
+				Return(books, nil)
+
+			for _, book := range books {
+				payload, _ := json.Marshal(book)
+				bookEvent := entities.BookEvent{BookId: book.ID, Type: entities.Restored, Status: entities.EventStatusNew, Payload: payload}
+				bookEventRepo.EXPECT().
+					Create(ctx, bookEvent).
+					Return(int64(1), nil)
+			}
+
+			bookHistoryRepo.EXPECT().
+				Record(gomock.Any(), gomock.Any(), entities.Restored, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:         bookRepo,
+				BookEvent:    bookEventRepo,
+				BookHistory:  bookHistoryRepo,
+				BookRedirect: bookRedirectRepo,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Restore(ctx, &pb.BookChangeRequest{BookId: []int64{1, 2}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &emptypb.Empty{}, res)
+}
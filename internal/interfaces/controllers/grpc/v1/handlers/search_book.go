@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/response"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// Search - returns books matching the full-text query from a gRPC request, the most relevant first.
+// Returns:
+// - *pb.BookSearchResponse: the found books with rank and snippets, empty when nothing matches
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error, invalid, forged or expired cursor
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (bh *BookHandler) Search(ctx context.Context, req *pb.BookSearchRequest) (*pb.BookSearchResponse, error) {
+	start := time.Now()
+	logger := bh.observ.WithContext(ctx)
+	ctx, span := bh.observ.StartSpan(ctx, "v1.BookService.Search")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "GET"},
+		{Key: "http.route", Value: "v1/books/search"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		bh.observ.RecordHanderRequest(ctx, "GET", "v1/books/search", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcBook.Search: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	params, err := converters.SearchRequestToPaginationParams(req)
+	if err != nil {
+		logger.Info("grpcBook.Search: validate cursor", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation_cursor.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{
+		{Key: "query", Value: req.GetQuery()},
+		{Key: "limit", Value: int64(params.Limit)},
+	})
+
+	if params.Cursor != nil {
+		span.SetAttributes([]observability.Attribute{
+			{Key: "cursor.version", Value: int64(params.Cursor.Version)},
+			{Key: "cursor.keys", Value: fmt.Sprintf("%v", params.Cursor.Keys)},
+		})
+	}
+
+	resp, err := bh.uc.Search.Execute(ctx, req.GetQuery(), params)
+	if err != nil {
+		logger.Info("grpcBook.Search: usecase", map[string]any{"error": err.Error()})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+		statusCode = codes.Internal
+		if errors.Is(err, errs.ErrInvalidInput) {
+			statusCode = codes.InvalidArgument
+		}
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return response.GetSearchResponse(resp), nil
+}
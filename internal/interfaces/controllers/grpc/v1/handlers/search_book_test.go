@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/infrastructure/persistence/postgres"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBook_Search_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//getMockUC - get_book_test.go
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		req    *pb.BookSearchRequest
+		errStr string
+	}{
+		{"ShortQuery", &pb.BookSearchRequest{Query: "s", PageSize: 10}, "value length must be between"},
+		{"PageSize", &pb.BookSearchRequest{Query: "sea", PageSize: 3}, "value must be in list [2 10 50]"},
+		{"Cursor", &pb.BookSearchRequest{Query: "sea", PageSize: 10, Cursor: ":"}, "invalid cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := bookHandler.Search(ctx, tt.req)
+
+			assert.Nil(t, res)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.errStr)
+		})
+	}
+}
+
+func TestBook_Search_ErrorUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//getMockUC - get_book_test.go
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	bookRepo.EXPECT().
+		Search(gomock.Any(), "sea", gomock.Any()).
+		Return(nil, errs.New("error"))
+
+	res, err := bookHandler.Search(ctx, &pb.BookSearchRequest{Query: "sea", PageSize: 10})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestBook_Search_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//getMockUC - get_book_test.go
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+	cursor, _ := postgres.EncodeCursor(entities.Cursor{
+		Sort:   []entities.SortField{{Field: entities.CursorTypeBookRank, Order: entities.SortOrderTypeDesc}},
+		Keys:   []any{"0.5"},
+		ID:     3,
+		Filter: entities.BookFilter{Query: "sea wolf"}.Key(),
+	})
+
+	bookRepo.EXPECT().
+		Search(gomock.Any(), "sea wolf", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, text string, params entities.PaginationParams) (*entities.ResponseBookSearch, error) {
+			assert.Equal(t, uint64(2), params.Limit)
+			assert.Equal(t, entities.CursorTypeBookRank, params.SortBy)
+			assert.Equal(t, []any{"0.5"}, params.Cursor.Keys)
+
+			return &entities.ResponseBookSearch{
+				Data: []entities.BookSearchResult{
+					{Book: entities.Book{ID: 1, Title: "Sea Wolf"}, Rank: 0.4, Snippet: "<b>Sea</b> <b>Wolf</b>"},
+				},
+				PageInfo: entities.PageInfo{NextCursor: "next"},
+			}, nil
+		})
+
+	res, err := bookHandler.Search(ctx, &pb.BookSearchRequest{Query: "sea wolf", PageSize: 2, Cursor: cursor})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.GetResults(), 1)
+	assert.Equal(t, "<b>Sea</b> <b>Wolf</b>", res.GetResults()[0].GetSnippet())
+	assert.Equal(t, "next", res.GetCursorNext())
+}
+
+func TestBook_Search_ErrorCursorQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//getMockUC - get_book_test.go
+	uc := getMockUC(ctrl, bookRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+	cursor, _ := postgres.EncodeCursor(entities.Cursor{
+		Sort:   []entities.SortField{{Field: entities.CursorTypeBookRank, Order: entities.SortOrderTypeDesc}},
+		Keys:   []any{"0.5"},
+		ID:     3,
+		Filter: entities.BookFilter{Query: "sea wolf"}.Key(),
+	})
+
+	res, err := bookHandler.Search(ctx, &pb.BookSearchRequest{Query: "white fang", PageSize: 2, Cursor: cursor})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// Update - renames the author based on data from a gRPC request.
+// Returns:
+// - *pb.Author: the author state after the update
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error
+// - codes.NotFound: the author does not exist
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (ah *AuthorHandler) Update(ctx context.Context, req *pb.AuthorUpdateRequest) (*pb.Author, error) {
+	start := time.Now()
+	logger := ah.observ.WithContext(ctx)
+	ctx, span := ah.observ.StartSpan(ctx, "v1.AuthorService.Update")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "PUT"},
+		{Key: "http.route", Value: "v1/authors/{id}"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		ah.observ.RecordHanderRequest(ctx, "PUT", "v1/authors/{id}", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcAuthor.Update: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{{Key: "author.id", Value: req.GetId()}})
+
+	updated, err := ah.uc.Update.Execute(ctx, entities.Author{ID: req.GetId(), Name: req.GetName()})
+	if err != nil {
+		logger.Info("grpcAuthor.Update: usecase", map[string]any{
+			"error": err.Error(),
+			"id":    req.GetId(),
+		})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		if errors.Is(err, errs.ErrNotFound) {
+			statusCode = codes.NotFound
+			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return converters.AuthorToProtoAuthor(&updated), nil
+}
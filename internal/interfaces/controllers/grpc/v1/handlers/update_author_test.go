@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestAuthor_Update_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	res, err := authorHandler.Update(context.Background(), &pb.AuthorUpdateRequest{Id: 0, Name: "New name"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthor_Update_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	authorRepo.EXPECT().
+		Update(gomock.Any(), entities.Author{ID: 1, Name: "New name"}).
+		Return(entities.Author{}, errs.ErrNotFound)
+
+	res, err := authorHandler.Update(context.Background(), &pb.AuthorUpdateRequest{Id: 1, Name: "New name"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAuthor_Update_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorRepo := mocks.NewMockAuthorRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//authorMockUC - author_test.go
+	authorHandler := &AuthorHandler{uc: authorMockUC(ctrl, authorRepo, nil), observ: observHandler}
+
+	authorRepo.EXPECT().
+		Update(gomock.Any(), entities.Author{ID: 1, Name: "New name"}).
+		Return(entities.Author{ID: 1, Name: "New name"}, nil)
+
+	res, err := authorHandler.Update(context.Background(), &pb.AuthorUpdateRequest{Id: 1, Name: "New name"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "New name", res.GetName())
+}
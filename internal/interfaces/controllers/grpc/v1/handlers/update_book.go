@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// Update - updates the book fields listed in the update mask based on data from a gRPC request.
+// An empty update mask updates all fields.
+// Returns:
+// - *pb.Book: the book state after the update
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error or unknown author
+// - codes.NotFound: the book does not exist or has been removed
+// - codes.AlreadyExists: another book with the ISBN already exists
+// - codes.Aborted: the book is at another version than expected
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (bh *BookHandler) Update(ctx context.Context, req *pb.BookUpdateRequest) (*pb.Book, error) {
+	start := time.Now()
+	logger := bh.observ.WithContext(ctx)
+	ctx, span := bh.observ.StartSpan(ctx, "v1.BookService.Update")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "PUT"},
+		{Key: "http.route", Value: "v1/books/{id}"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		bh.observ.RecordHanderRequest(ctx, "PUT", "v1/books/{id}", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcBook.Update: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	fields, err := converters.UpdateMaskToBookFields(req)
+	if err != nil {
+		logger.Info("grpcBook.Update: validate update_mask", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation_mask.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	book := converters.BookUpdateRequestToBook(req)
+	book.Version, err = expectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil {
+		logger.Info("grpcBook.Update: validate expected version", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation_version.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{
+		{Key: "book.id", Value: book.ID},
+		{Key: "book.title", Value: book.Title},
+		{Key: "book.description", Value: book.Description},
+		{Key: "book.year", Value: book.Year},
+		{Key: "book.genre_id", Value: book.GenreID},
+		{Key: "book.fields", Value: req.GetUpdateMask().GetPaths()},
+		{Key: "book.expected_version", Value: book.Version},
+	})
+
+	updated, err := bh.uc.Update.Execute(withCaller(ctx), book, fields)
+	if err != nil {
+		logger.Info("grpcBook.Update: usecase", map[string]any{
+			"error": err.Error(),
+			"id":    book.ID,
+		})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		if errors.Is(err, errs.ErrNotFound) {
+			statusCode = codes.NotFound
+			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
+		}
+
+		if errors.Is(err, errs.ErrInvalidInput) {
+			statusCode = codes.InvalidArgument
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		if errors.Is(err, errs.ErrAlreadyExists) {
+			statusCode = codes.AlreadyExists
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		if errors.Is(err, errs.ErrVersionMismatch) {
+			statusCode = codes.Aborted
+			return nil, status.Error(statusCode, err.Error())
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return converters.BookToProtoBook(&updated), nil
+}
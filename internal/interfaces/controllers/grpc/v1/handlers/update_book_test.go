@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/internal/usecases/book"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func updateMockUC(ctrl *gomock.Controller, uowRepo repositories.UnitOfWork) *book.BookUsecases {
+	observUsecase := createMockUsecaseObservability(ctrl)
+
+	updateUC := book.NewUpdateBookUsecase(uowRepo, observUsecase)
+
+	return book.New(
+		book.WithUpdateBookUsecase(updateUC),
+	)
+}
+
+func TestBook_Update_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	//createMockObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := updateMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *pb.BookUpdateRequest
+	}{
+		{"InvalidID", &pb.BookUpdateRequest{Id: 0, Title: "Title", Description: "Desc", Year: 1900, GenreId: 3}},
+		{"InvalidTitle", &pb.BookUpdateRequest{Id: 1, Title: "T", Description: "Desc", Year: 1900, GenreId: 3}},
+		{"EmptyYear", &pb.BookUpdateRequest{Id: 1, Title: "Title", Description: "Desc", Year: 0, GenreId: 3}},
+		{"EmptyMaskedField", &pb.BookUpdateRequest{Id: 1, Title: "Title", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"genre_id"}}}},
+		{"UnknownMaskField", &pb.BookUpdateRequest{Id: 1, Title: "Title", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"id"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := bookHandler.Update(ctx, tt.req)
+
+			assert.Nil(t, res)
+			assert.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestBook_Update_ErrorUsecase_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := updateMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookRepo.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{}, errs.ErrNotFound)
+
+			bookRepo.EXPECT().
+				Update(ctx, gomock.Any(), gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookRepo,
+				BookEvent: bookEventRepo,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Update(ctx, &pb.BookUpdateRequest{Id: 1, Title: "Title", Description: "Desc", Year: 1900, GenreId: 3})
+
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBook_Update_ErrorUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := updateMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookRepo.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{{ID: 1, Title: "Old Title", Description: "Desc", Year: 1900, GenreID: 3, Genre: "Genre"}}, nil)
+
+			bookRepo.EXPECT().
+				Update(ctx, gomock.Any(), gomock.Any()).
+				Return(entities.Book{}, errs.New("error"))
+
+			bookEventRepo.EXPECT().
+				Create(ctx, gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookRepo,
+				BookEvent: bookEventRepo,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Update(ctx, &pb.BookUpdateRequest{Id: 1, Title: "Title", Description: "Desc", Year: 1900, GenreId: 3})
+
+	assert.Nil(t, res)
+	assert.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestBook_Update_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	bookRepo := mocks.NewMockBookRepository(ctrl)
+	bookEventRepo := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryRepo := mocks.NewMockBookHistoryRepository(ctrl)
+	observHandler := createMockHandlerObservability(ctrl)
+	uc := updateMockUC(ctrl, uowRepo)
+	bookHandler := &BookHandler{uc: uc, observ: observHandler}
+	ctx := context.Background()
+
+	storedBook := entities.Book{ID: 1, Title: "Title", Description: "Desk", Year: 1900, GenreID: 3, Genre: "Genre"}
+	expectedBook := entities.Book{ID: 1, Title: "Title", Description: "Desc", Year: 1900, GenreID: 3, Genre: "Genre"}
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookRepo.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{storedBook}, nil)
+
+			bookRepo.EXPECT().
+				Update(ctx, entities.Book{ID: 1, Description: "Desc"}, []entities.BookField{entities.BookFieldDescription}).
+				Return(expectedBook, nil)
+
+			bookEventRepo.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(1), nil)
+
+			bookHistoryRepo.EXPECT().
+				Record(gomock.Any(), gomock.Any(), entities.Updated, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookRepo,
+				BookEvent:   bookEventRepo,
+				BookHistory: bookHistoryRepo,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := bookHandler.Update(ctx, &pb.BookUpdateRequest{
+		Id:          1,
+		Description: "Desc",
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBook.ID, res.GetId())
+	assert.Equal(t, expectedBook.Title, res.GetTitle())
+	assert.Equal(t, expectedBook.Description, res.GetDescription())
+}
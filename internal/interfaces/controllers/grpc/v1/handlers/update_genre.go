@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// Update - renames the genre or moves it to another parent based on data from a gRPC request.
+// Returns:
+// - *pb.Genre: the genre state after the update
+// - error: validation or business logic error
+//
+// Errors:
+// - codes.InvalidArgument: input data validation error, the parent genre does not exist or is a descendant of the genre
+// - codes.NotFound: the genre does not exist
+// - codes.AlreadyExists: another genre with the same name exists
+// - codes.Internal: database or usecase level error
+//
+// Logging:
+// - Info level: validation and business logic errors
+func (gh *GenreHandler) Update(ctx context.Context, req *pb.GenreUpdateRequest) (*pb.Genre, error) {
+	start := time.Now()
+	logger := gh.observ.WithContext(ctx)
+	ctx, span := gh.observ.StartSpan(ctx, "v1.GenreService.Update")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "http.method", Value: "PUT"},
+		{Key: "http.route", Value: "v1/genres/{id}"},
+	})
+	defer span.End()
+
+	var statusCode codes.Code = codes.OK
+	defer func() {
+		duration := time.Since(start).Seconds()
+		gh.observ.RecordHanderRequest(ctx, "PUT", "v1/genres/{id}", int(statusCode), duration)
+	}()
+
+	if err := req.Validate(); err != nil {
+		logger.Info("grpcGenre.Update: validate", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "validation.failed", Value: true}})
+		statusCode = codes.InvalidArgument
+
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	span.SetAttributes([]observability.Attribute{
+		{Key: "genre.id", Value: req.GetId()},
+		{Key: "genre.parent_id", Value: req.GetParentId()},
+	})
+
+	updated, err := gh.uc.Update.Execute(ctx, converters.GenreUpdateRequestToGenre(req))
+	if err != nil {
+		logger.Info("grpcGenre.Update: usecase", map[string]any{
+			"error": err.Error(),
+			"id":    req.GetId(),
+		})
+		span.SetAttributes([]observability.Attribute{{Key: "usecase.failed", Value: true}})
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidInput):
+			statusCode = codes.InvalidArgument
+			return nil, status.Error(statusCode, err.Error())
+		case errors.Is(err, errs.ErrNotFound):
+			statusCode = codes.NotFound
+			return nil, status.Error(statusCode, errs.ErrNotFound.Error())
+		case errors.Is(err, errs.ErrAlreadyExists):
+			statusCode = codes.AlreadyExists
+			return nil, status.Error(statusCode, "genre with the same name already exists")
+		}
+
+		statusCode = codes.Internal
+		return nil, status.Error(statusCode, err.Error())
+	}
+
+	return converters.GenreToProtoGenre(&updated), nil
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+	pb "github.com/mathbdw/book/proto"
+)
+
+func TestGenre_Update_ErrorValidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, nil, uowRepo), observ: observHandler}
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).Times(0)
+
+	res, err := genreHandler.Update(context.Background(), &pb.GenreUpdateRequest{Id: 0, Name: "Fantasy"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGenre_Update_ErrorCycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, uowRepo), observ: observHandler}
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreRepo.EXPECT().
+				Subtree(gomock.Any(), int64(1)).
+				Return([]int64{1, 2}, nil)
+
+			return fn(&repositories.Repository{Genre: genreRepo})
+		})
+
+	res, err := genreHandler.Update(context.Background(), &pb.GenreUpdateRequest{Id: 1, Name: "Fiction", ParentId: 2})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGenre_Update_ErrorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, uowRepo), observ: observHandler}
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreRepo.EXPECT().
+				Update(gomock.Any(), entities.Genre{ID: 1, Name: "Fiction"}).
+				Return(entities.Genre{}, errs.ErrNotFound)
+
+			return fn(&repositories.Repository{Genre: genreRepo})
+		})
+
+	res, err := genreHandler.Update(context.Background(), &pb.GenreUpdateRequest{Id: 1, Name: "Fiction"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGenre_Update_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowRepo := mocks.NewMockUnitOfWork(ctrl)
+	genreRepo := mocks.NewMockGenreRepository(ctrl)
+	//createMockHandlerObservability - add_book_test.go
+	observHandler := createMockHandlerObservability(ctrl)
+	//genreMockUC - genre_test.go
+	genreHandler := &GenreHandler{uc: genreMockUC(ctrl, genreRepo, uowRepo), observ: observHandler}
+	parentID := int64(1)
+
+	uowRepo.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreRepo.EXPECT().
+				Subtree(gomock.Any(), int64(2)).
+				Return([]int64{2}, nil)
+			genreRepo.EXPECT().
+				Update(gomock.Any(), entities.Genre{ID: 2, Name: "Fantasy", ParentID: &parentID}).
+				Return(entities.Genre{ID: 2, Name: "Fantasy", ParentID: &parentID}, nil)
+
+			return fn(&repositories.Repository{Genre: genreRepo})
+		})
+
+	res, err := genreHandler.Update(context.Background(), &pb.GenreUpdateRequest{Id: 2, Name: "Fantasy", ParentId: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Fantasy", res.GetName())
+	assert.Equal(t, int64(1), res.GetParentId())
+}
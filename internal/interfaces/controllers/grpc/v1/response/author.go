@@ -0,0 +1,20 @@
+package response
+
+import (
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// GetAuthorsResponse - Sets *pb.AuthorsResponse from slice entities.Author
+func GetAuthorsResponse(authors []entities.Author) *pb.AuthorsResponse {
+	return &pb.AuthorsResponse{Authors: converters.AuthorsToProtoAuthors(authors)}
+}
+
+// GetAuthorListResponse - Sets *pb.AuthorListResponse from *entities.ResponseAuthors
+func GetAuthorListResponse(resp *entities.ResponseAuthors) *pb.AuthorListResponse {
+	return &pb.AuthorListResponse{
+		Authors:     converters.AuthorsToProtoAuthors(resp.Data),
+		NextAfterId: resp.NextID,
+	}
+}
@@ -0,0 +1,20 @@
+package response
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+)
+
+func TestAuthor_GetAuthorListResponse(t *testing.T) {
+	resp := GetAuthorListResponse(&entities.ResponseAuthors{
+		Data:   []entities.Author{{ID: 1, Name: "First"}, {ID: 2, Name: "Second"}},
+		NextID: 2,
+	})
+
+	assert.Len(t, resp.GetAuthors(), 2)
+	assert.Equal(t, "Second", resp.GetAuthors()[1].GetName())
+	assert.Equal(t, int64(2), resp.GetNextAfterId())
+}
@@ -1,6 +1,8 @@
 package response
 
 import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
 	"github.com/mathbdw/book/internal/domain/entities"
 	pb "github.com/mathbdw/book/proto"
@@ -30,8 +32,45 @@ func GetBooks(books []entities.Book) []*pb.Book {
 func GetListResponse(respBook *entities.ResponseBooks) *pb.BookListResponse {
 	return &pb.BookListResponse{
 		Pagination: &pb.BookListResponse_CursorPagination{
-			CursorNext: respBook.PageInfo.NextCursor,
+			CursorNext:  respBook.PageInfo.NextCursor,
+			CursorPrev:  respBook.PageInfo.PrevCursor,
+			HasPrevious: respBook.PageInfo.HasPrevious,
+			HasNext:     respBook.PageInfo.HasNext,
+			TotalCount:  respBook.PageInfo.TotalCount,
 		},
 		Books: GetBooks(respBook.Data),
 	}
 }
+
+// GetSearchResponse - Sets *pb.BookSearchResponse from *entities.ResponseBookSearch
+func GetSearchResponse(resp *entities.ResponseBookSearch) *pb.BookSearchResponse {
+	results := make([]*pb.BookSearchResult, 0, len(resp.Data))
+	for i := range resp.Data {
+		results = append(results, &pb.BookSearchResult{
+			Book:    converters.BookToProtoBook(&resp.Data[i].Book),
+			Rank:    resp.Data[i].Rank,
+			Snippet: resp.Data[i].Snippet,
+		})
+	}
+
+	return &pb.BookSearchResponse{
+		Results:    results,
+		CursorNext: resp.PageInfo.NextCursor,
+	}
+}
+
+// GetHistoryResponse - Sets *pb.BookHistoryResponse from slice entities.BookHistory
+func GetHistoryResponse(history []entities.BookHistory) *pb.BookHistoryResponse {
+	versions := make([]*pb.BookVersion, 0, len(history))
+	for _, version := range history {
+		versions = append(versions, &pb.BookVersion{
+			Book:      converters.BookToProtoBook(&version.Book),
+			Removed:   version.Removed,
+			Change:    converters.EventTypeToProtoBookChangeType(version.Type),
+			Actor:     version.Actor,
+			ChangedAt: timestamppb.New(version.ChangedAt),
+		})
+	}
+
+	return &pb.BookHistoryResponse{Versions: versions}
+}
@@ -41,3 +41,44 @@ func TestBook_GetListResponse(t *testing.T) {
 	assert.Equal(t, respBook.PageInfo.NextCursor, resProto.GetPagination().GetCursorNext())
 	assert.Equal(t, 1, len(resProto.GetBooks()))
 }
+
+func TestBook_GetListResponse_PrevAndTotalCount(t *testing.T) {
+	totalCount := int64(12)
+	respBook := entities.ResponseBooks{
+		PageInfo: entities.PageInfo{
+			NextCursor:  "next",
+			PrevCursor:  "prev",
+			HasNext:     true,
+			HasPrevious: true,
+			TotalCount:  &totalCount,
+		},
+	}
+
+	pagination := GetListResponse(&respBook).GetPagination()
+
+	assert.Equal(t, "next", pagination.GetCursorNext())
+	assert.Equal(t, "prev", pagination.GetCursorPrev())
+	assert.True(t, pagination.GetHasNext())
+	assert.True(t, pagination.GetHasPrevious())
+	assert.Equal(t, int64(12), pagination.GetTotalCount())
+
+	pagination = GetListResponse(&entities.ResponseBooks{}).GetPagination()
+
+	assert.Nil(t, pagination.TotalCount)
+}
+
+func TestBook_GetSearchResponse(t *testing.T) {
+	resp := entities.ResponseBookSearch{
+		PageInfo: entities.PageInfo{NextCursor: "adf"},
+		Data: []entities.BookSearchResult{
+			{Book: entities.Book{ID: 1, Title: "The Sea"}, Rank: 0.4, Snippet: "The <b>Sea</b>"},
+		},
+	}
+
+	resProto := GetSearchResponse(&resp)
+	assert.Equal(t, "adf", resProto.GetCursorNext())
+	assert.Len(t, resProto.GetResults(), 1)
+	assert.Equal(t, "The Sea", resProto.GetResults()[0].GetBook().GetTitle())
+	assert.Equal(t, float32(0.4), resProto.GetResults()[0].GetRank())
+	assert.Equal(t, "The <b>Sea</b>", resProto.GetResults()[0].GetSnippet())
+}
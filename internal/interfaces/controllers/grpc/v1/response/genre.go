@@ -0,0 +1,12 @@
+package response
+
+import (
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/interfaces/controllers/grpc/v1/converters"
+	pb "github.com/mathbdw/book/proto"
+)
+
+// GetGenresResponse - Sets *pb.GenresResponse from slice entities.Genre
+func GetGenresResponse(genres []entities.Genre) *pb.GenresResponse {
+	return &pb.GenresResponse{Genres: converters.GenresToProtoGenres(genres)}
+}
@@ -2,12 +2,19 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
 	"github.com/mathbdw/book/internal/interfaces/controllers/telegram_bot/v1/validate"
 	"github.com/mathbdw/book/internal/interfaces/observability"
+	book_usecase "github.com/mathbdw/book/internal/usecases/book"
 )
 
 func (h *BotHandler) handleCommandAdd(ctx context.Context, mess *tgbotapi.Message) {
@@ -44,32 +51,156 @@ func (h *BotHandler) handleCommandAdd(ctx context.Context, mess *tgbotapi.Messag
 		return
 	}
 
-	err = h.uc.Add.Execute(ctx, book)
-	if err != nil {
-		logger.Info("botHandler.handleCommandAdd: executing usecases", map[string]any{"error": err.Error()})
-		span.RecordError(err)
-		span.SetAttributes([]observability.Attribute{{Key: "usecases.failed", Value: true}})
-		statusCode = 500
+	statusCode = h.addBook(ctx, span, mess.Chat.ID, book, false)
+}
 
-		msg := tgbotapi.NewMessage(mess.Chat.ID, "An error has occurred")
-		_, err := h.bot.Send(msg)
-		if err != nil {
-			logger.Error("botHandler.handleCommandAdd: sending message", map[string]any{"error": err})
+// handleAddCallback - adds the pending book despite the duplicates or drops it
+func (h *BotHandler) handleAddCallback(ctx context.Context, chatID int64, data KeyData) {
+	start := time.Now()
+	logger := h.observ.WithContext(ctx)
+	ctx, span := h.observ.StartSpan(ctx, "v1.HandleCommand")
+	defer span.End()
+
+	statusCode := int(200)
+
+	defer func() {
+		duration := time.Since(start).Seconds()
+		h.observ.RecordHanderRequest(ctx, "send", "v1/add", statusCode, duration)
+	}()
+
+	text := "The book is not added"
+	strBook, ok := h.pendingBooks.Take(data.Book)
+	if !ok {
+		span.SetAttributes([]observability.Attribute{{Key: "pendingBook.expired", Value: true}})
+		text = "The confirmation has expired, send /add again"
+	}
+
+	if ok && data.Action == callbackActionAdd {
+		var book entities.Book
+		if err := json.Unmarshal([]byte(strBook), &book); err != nil {
+			logger.Error("botHandler.handleAddCallback: json unmarshal", map[string]any{"error": err})
 			span.RecordError(err)
-			span.SetAttributes([]observability.Attribute{{Key: "sending.failed", Value: true}})
+			span.SetAttributes([]observability.Attribute{{Key: "json.unmarshal.failed", Value: true}})
+			statusCode = 500
 
 			return
 		}
+
+		statusCode = h.addBook(ctx, span, chatID, book, true)
+
+		return
 	}
 
-	msg := tgbotapi.NewMessage(mess.Chat.ID, "Book added successfully")
-	_, err = h.bot.Send(msg)
+	msg := tgbotapi.NewMessage(chatID, text)
+	if _, err := h.bot.Send(msg); err != nil {
+		logger.Error("botHandler.handleAddCallback: sending message", map[string]any{"error": err})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "sending.failed", Value: true}})
+
+		statusCode = 500
+	}
+}
+
+// addBook - adds the book and replies with its ID. When the book looks like the stored books
+// replies with them and asks to confirm. Returns the status code of the request
+func (h *BotHandler) addBook(ctx context.Context, span observability.Span, chatID int64, book entities.Book, force bool) int {
+	logger := h.observ.WithContext(ctx)
+	statusCode := 200
+
+	var msg tgbotapi.MessageConfig
+	created, err := h.uc.Add.Execute(ctx, book, entities.IdempotencyKey{}, force)
 	if err != nil {
-		logger.Error("botHandler.handleCommandAdd: sending message", map[string]any{"error": err})
+		logger.Info("botHandler.addBook: executing usecases", map[string]any{"error": err.Error()})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "usecases.failed", Value: true}})
+		statusCode = 500
+		msg = tgbotapi.NewMessage(chatID, "An error has occurred")
+
+		var (
+			duplicates      *book_usecase.DuplicatesError
+			genreNotFound   *book_usecase.GenreNotFoundError
+			authorsNotFound *book_usecase.AuthorsNotFoundError
+		)
+		switch {
+		case errors.As(err, &duplicates):
+			statusCode = 409
+			msg, err = h.duplicatesMessage(ctx, chatID, book, duplicates.IDs)
+			if err != nil {
+				logger.Error("botHandler.addBook: duplicates message", map[string]any{"error": err})
+				span.RecordError(err)
+				statusCode = 500
+				msg = tgbotapi.NewMessage(chatID, "An error has occurred")
+			}
+		case errors.As(err, &genreNotFound):
+			statusCode = 422
+			msg.Text = fmt.Sprintf("Genre %s not found", genreNotFound.Genre)
+		case errors.As(err, &authorsNotFound):
+			statusCode = 422
+			msg.Text = fmt.Sprintf("Authors %v not found", authorsNotFound.IDs)
+		case errors.Is(err, errs.ErrInvalidInput):
+			statusCode = 422
+			msg.Text = "The book is invalid, check it and send /add again"
+		case errors.Is(err, errs.ErrAlreadyExists):
+			statusCode = 409
+			msg.Text = fmt.Sprintf("Book with ISBN %s already exists", book.ISBN)
+		}
+	} else {
+		msg = tgbotapi.NewMessage(chatID, fmt.Sprintf("Book added successfully, ID: %d", created.ID))
+	}
+
+	if _, err := h.bot.Send(msg); err != nil {
+		logger.Error("botHandler.addBook: sending message", map[string]any{"error": err})
 		span.RecordError(err)
 		span.SetAttributes([]observability.Attribute{{Key: "sending.failed", Value: true}})
 
 		statusCode = 500
 	}
-	
+
+	return statusCode
+}
+
+// duplicatesMessage - the message with the stored books like the added one and the buttons to add it anyway or to cancel
+func (h *BotHandler) duplicatesMessage(ctx context.Context, chatID int64, book entities.Book, IDs []int64) (tgbotapi.MessageConfig, error) {
+	lines := []string{"The book looks like the stored books:"}
+	duplicates, err := h.uc.Get.GetByIDs(ctx, IDs)
+	if err != nil {
+		// the duplicates are removed in the meantime, their IDs are enough to decide
+		for _, ID := range IDs {
+			lines = append(lines, fmt.Sprintf("ID: %d", ID))
+		}
+	}
+	for _, duplicate := range duplicates {
+		lines = append(lines, duplicate.String())
+	}
+	lines = append(lines, "Add it anyway?")
+
+	strBook, err := json.Marshal(book)
+	if err != nil {
+		return tgbotapi.MessageConfig{}, err
+	}
+
+	token, err := h.pendingBooks.Put(string(strBook))
+	if err != nil {
+		return tgbotapi.MessageConfig{}, err
+	}
+
+	buttons := make([]tgbotapi.InlineKeyboardButton, 0, 2)
+	for _, button := range []struct {
+		text   string
+		action string
+	}{
+		{"Add anyway", callbackActionAdd},
+		{"Cancel", callbackActionCancelAdd},
+	} {
+		jsonData, err := json.Marshal(KeyData{Action: button.action, Book: token})
+		if err != nil {
+			return tgbotapi.MessageConfig{}, err
+		}
+		buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(button.text, string(jsonData)))
+	}
+
+	msg := tgbotapi.NewMessage(chatID, strings.Join(lines, "\n"))
+	msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(buttons...))
+
+	return msg, nil
 }
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"runtime/debug"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
@@ -11,17 +12,21 @@ import (
 )
 
 type BotHandler struct {
-	bot *tgbotapi.BotAPI
-	uc  *book.BookUsecases
+	bot          *tgbotapi.BotAPI
+	uc           *book.BookUsecases
+	pages        *callbackValues // cursors of the list pages
+	pendingBooks *callbackValues // added books waiting for the confirmation of the duplicate
 
 	observ observability.HandlerObservability
 }
 
 func New(bot *tgbotapi.BotAPI, uc *book.BookUsecases, observ observability.HandlerObservability) *BotHandler {
 	return &BotHandler{
-		bot:    bot,
-		uc:     uc,
-		observ: observ,
+		bot:          bot,
+		uc:           uc,
+		pages:        newCallbackValues(pageCursorsLimit),
+		pendingBooks: newCallbackValues(pendingBooksLimit),
+		observ:       observ,
 	}
 }
 
@@ -35,6 +40,10 @@ func (h *BotHandler) Start(ctx context.Context, update tgbotapi.Update) {
 		}
 	}()
 
+	if user := update.SentFrom(); user != nil {
+		ctx = book.WithActor(ctx, fmt.Sprintf("telegram:%d", user.ID))
+	}
+
 	if update.CallbackQuery != nil {
 		h.handleCallback(ctx, update.CallbackQuery)
 		return
@@ -53,6 +62,8 @@ func (h *BotHandler) Start(ctx context.Context, update tgbotapi.Update) {
 		h.handleCommandList(ctx, update.Message)
 	case "remove":
 		h.handleCommandRemove(ctx, update.Message)
+	case "restore":
+		h.handleCommandRestore(ctx, update.Message)
 	case "help":
 		h.handleCommandHelp(ctx, update.Message)
 	default:
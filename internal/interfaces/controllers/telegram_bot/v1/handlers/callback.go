@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 	"time"
 
@@ -12,9 +13,57 @@ import (
 	"github.com/mathbdw/book/internal/interfaces/observability"
 )
 
+const (
+	// callbackActionList - opens the page of the books list
+	callbackActionList = "list"
+	// callbackActionAdd - adds the pending book despite the duplicates
+	callbackActionAdd = "add"
+	// callbackActionCancelAdd - drops the pending book
+	callbackActionCancelAdd = "add_cancel"
+)
+
 type KeyData struct {
 	Action string `json:"action"`
-	Page   string `json:"page"`
+	Page   string `json:"page,omitempty"`
+	Book   string `json:"book,omitempty"` // token of the pending book
+}
+
+// listKeyboard - returns the inline keyboard of the books list page with the Back and More buttons,
+// nil when there is no other page. The buttons carry the tokens of the cursors kept in h.pages.
+func (h *BotHandler) listKeyboard(pageInfo entities.PageInfo) (*tgbotapi.InlineKeyboardMarkup, error) {
+	var buttons []tgbotapi.InlineKeyboardButton
+
+	pages := []struct {
+		text   string
+		cursor string
+	}{
+		{"Back", pageInfo.PrevCursor},
+		{"More", pageInfo.NextCursor},
+	}
+	for _, page := range pages {
+		if page.cursor == "" {
+			continue
+		}
+
+		token, err := h.pages.Put(page.cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		jsonData, err := json.Marshal(KeyData{Action: callbackActionList, Page: token})
+		if err != nil {
+			return nil, err
+		}
+		buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(page.text, string(jsonData)))
+	}
+
+	if len(buttons) == 0 {
+		return nil, nil
+	}
+
+	keyboard := tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(buttons...))
+
+	return &keyboard, nil
 }
 
 func (h *BotHandler) handleCallback(ctx context.Context, callback *tgbotapi.CallbackQuery) {
@@ -41,7 +90,12 @@ func (h *BotHandler) handleCallback(ctx context.Context, callback *tgbotapi.Call
 		return
 	}
 
-	if unData.Action != "list" {
+	if unData.Action == callbackActionAdd || unData.Action == callbackActionCancelAdd {
+		h.handleAddCallback(ctx, callback.Message.Chat.ID, unData)
+		return
+	}
+
+	if unData.Action != callbackActionList {
 		logger.Error("botHandler.handleCallback: format data", map[string]any{"data": unData})
 		span.RecordError(err)
 		span.SetAttributes([]observability.Attribute{{Key: "format.data.failed", Value: true}})
@@ -50,12 +104,39 @@ func (h *BotHandler) handleCallback(ctx context.Context, callback *tgbotapi.Call
 
 		return
 	}
-	cursor, err := postgres.DecodeCursor(unData.Page)
+	strCursor, ok := h.pages.Get(unData.Page)
+	if !ok {
+		logger.Info("botHandler.handleCallback: expired page", map[string]any{"data": unData})
+		span.SetAttributes([]observability.Attribute{{Key: "page.expired", Value: true}})
+
+		msg := tgbotapi.NewMessage(callback.Message.Chat.ID, "The list has expired, send /list again")
+		if _, err := h.bot.Send(msg); err != nil {
+			logger.Error("botHandler.handleCallback: sending message", map[string]any{"error": err})
+			span.RecordError(err)
+			statusCode = 500
+		}
+
+		return
+	}
+
+	cursor, err := postgres.DecodeCursor(strCursor)
 	if err != nil {
-		logger.Error("botHandler.handleCallback: invalid cursor", map[string]any{"data": unData})
+		logger.Error("botHandler.handleCallback: invalid cursor", map[string]any{"data": unData, "error": err.Error()})
 		span.RecordError(err)
 		span.SetAttributes([]observability.Attribute{{Key: "invalid.cursor", Value: true}})
 
+		text := "The page can not be opened, send /list again"
+		if errors.Is(err, postgres.ErrCursorExpired) {
+			text = "The list has expired, send /list again"
+		}
+
+		msg := tgbotapi.NewMessage(callback.Message.Chat.ID, text)
+		if _, err := h.bot.Send(msg); err != nil {
+			logger.Error("botHandler.handleCallback: sending message", map[string]any{"error": err})
+			span.RecordError(err)
+			statusCode = 500
+		}
+
 		return
 	}
 
@@ -83,23 +164,14 @@ func (h *BotHandler) handleCallback(ctx context.Context, callback *tgbotapi.Call
 
 	msg := tgbotapi.NewMessage(callback.Message.Chat.ID, strings.Join(outMess, "\n"))
 
-	if respBooks.PageInfo.NextCursor != "" {
-		var dataKey = KeyData{Action: "list", Page: respBooks.PageInfo.NextCursor}
-		jsonData, err := json.Marshal(dataKey)
-		if err != nil {
-			statusCode = 500
-			logger.Error("botHandler.handleCallback: json marshal", map[string]any{"error": err})
-			span.RecordError(err)
-			span.SetAttributes([]observability.Attribute{{Key: "json.marshal.failed", Value: true}})
-			return
-		}
-
-		var key = tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("More", string(jsonData)),
-			),
-		)
-		msg.ReplyMarkup = key
+	if keyboard, err := h.listKeyboard(respBooks.PageInfo); err != nil {
+		statusCode = 500
+		logger.Error("botHandler.handleCallback: list keyboard", map[string]any{"error": err})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "keyboard.failed", Value: true}})
+		return
+	} else if keyboard != nil {
+		msg.ReplyMarkup = *keyboard
 	}
 
 	_, err = h.bot.Send(msg)
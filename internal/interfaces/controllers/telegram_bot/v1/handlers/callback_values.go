@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"sync"
+)
+
+const (
+	// pageCursorsLimit - number of the list cursors kept by the bot, the oldest are dropped first
+	pageCursorsLimit = 10000
+	// pendingBooksLimit - number of the added books waiting for the confirmation of the duplicate, the oldest are dropped first
+	pendingBooksLimit = 1000
+)
+
+// callbackValues - values of the buttons behind short tokens,
+// the values don't fit into the 64 bytes of the callback data of Telegram
+type callbackValues struct {
+	mu     sync.Mutex
+	limit  int
+	values map[string]string
+	tokens []string // ring of the tokens in the order of adding
+	next   int
+}
+
+func newCallbackValues(limit int) *callbackValues {
+	return &callbackValues{
+		limit:  limit,
+		values: make(map[string]string, limit),
+		tokens: make([]string, 0, limit),
+	}
+}
+
+// Put - keeps the value and returns its token
+func (p *callbackValues) Put(value string) (string, error) {
+	buf := make([]byte, 9)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.tokens) < p.limit {
+		p.tokens = append(p.tokens, token)
+	} else {
+		delete(p.values, p.tokens[p.next])
+		p.tokens[p.next] = token
+		p.next = (p.next + 1) % p.limit
+	}
+	p.values[token] = value
+
+	return token, nil
+}
+
+// Get - returns the value of the token, false when it was dropped or the bot was restarted
+func (p *callbackValues) Get(token string) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	value, ok := p.values[token]
+
+	return value, ok
+}
+
+// Take - returns the value of the token and drops it, so the button works once
+func (p *callbackValues) Take(token string) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	value, ok := p.values[token]
+	delete(p.values, token)
+
+	return value, ok
+}
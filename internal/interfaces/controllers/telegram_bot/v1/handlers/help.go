@@ -25,8 +25,9 @@ func (h *BotHandler) handleCommandHelp(ctx context.Context, mess *tgbotapi.Messa
 
 	var outMess []string
 	outMess = append(outMess, "Available commands")
-	outMess = append(outMess, "/add - The command adds a product. Example:\n/add\nTitle - New title\nDescription - New description\nYear - year write\nGenre - history")
+	outMess = append(outMess, "/add - The command adds a product. Example:\n/add\nTitle - New title\nDescription - New description\nYear - year write\nGenre - history\nISBN - 978-0-306-40615-7 (optional)")
 	outMess = append(outMess, "/delete {ID} - The command deletes a product by ID . Example:\n/delete 1")
+	outMess = append(outMess, "/restore {ID} - The command restores a deleted product by ID . Example:\n/restore 1")
 	outMess = append(outMess, "/help - The command help.")
 	outMess = append(outMess, "/get {ID} - The command gets a product by ID . Example:\n/get 1")
 	outMess = append(outMess, "/list - The command view list of products")
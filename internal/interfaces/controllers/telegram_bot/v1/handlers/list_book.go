@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
 	"strings"
 	"time"
 
@@ -44,9 +43,9 @@ func (h *BotHandler) handleCommandList(ctx context.Context, mess *tgbotapi.Messa
 			logger.Error("botHandler.handleCommandList: sending message", map[string]any{"error": err})
 			span.RecordError(err)
 			span.SetAttributes([]observability.Attribute{{Key: "sending.failed", Value: true}})
-
-			return
 		}
+
+		return
 	}
 
 	var outMess []string
@@ -56,23 +55,14 @@ func (h *BotHandler) handleCommandList(ctx context.Context, mess *tgbotapi.Messa
 	}
 	msg := tgbotapi.NewMessage(mess.Chat.ID, strings.Join(outMess, "\n"))
 
-	if respBooks.PageInfo.NextCursor != "" {
-		var dataKey = KeyData{Action: "list", Page: respBooks.PageInfo.NextCursor}
-		jsonData, err := json.Marshal(dataKey)
-		if err != nil {
-			statusCode = 500
-			logger.Error("botHandler.handleCommandList: json marshal", map[string]any{"error": err})
-			span.RecordError(err)
-			span.SetAttributes([]observability.Attribute{{Key: "json.marshal.failed", Value: true}})
-			return
-		}
-
-		var key = tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("More", string(jsonData)),
-			),
-		)
-		msg.ReplyMarkup = key
+	if keyboard, err := h.listKeyboard(respBooks.PageInfo); err != nil {
+		statusCode = 500
+		logger.Error("botHandler.handleCommandList: list keyboard", map[string]any{"error": err})
+		span.RecordError(err)
+		span.SetAttributes([]observability.Attribute{{Key: "keyboard.failed", Value: true}})
+		return
+	} else if keyboard != nil {
+		msg.ReplyMarkup = *keyboard
 	}
 
 	_, err = h.bot.Send(msg)
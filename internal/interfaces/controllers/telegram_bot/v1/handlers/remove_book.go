@@ -6,6 +6,7 @@ import (
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 
+	"github.com/mathbdw/book/internal/domain/entities"
 	"github.com/mathbdw/book/internal/interfaces/controllers/telegram_bot/v1/validate"
 	"github.com/mathbdw/book/internal/interfaces/observability"
 )
@@ -44,7 +45,7 @@ func (h *BotHandler) handleCommandRemove(ctx context.Context, mess *tgbotapi.Mes
 		return
 	}
 
-	err = h.uc.Remove.Execute(ctx, []int64{bookId})
+	err = h.uc.Remove.Execute(ctx, []int64{bookId}, 0, entities.IdempotencyKey{})
 	if err != nil {
 		logger.Info("botHandler.handleCommandRemove: executing usecases", map[string]any{
 			"error": err.Error(),
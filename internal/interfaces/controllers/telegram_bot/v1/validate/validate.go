@@ -10,12 +10,12 @@ import (
 	errs "github.com/mathbdw/book/internal/errors"
 )
 
-// CreateBook - validate message on field book
+// CreateBook - validate message on field book, the ISBN line is optional
 func CreateBook(mess *tgbotapi.Message) (entities.Book, error) {
 	arg := mess.CommandArguments()
 	str := strings.Split(arg, "\n")
 
-	if len(str) != 4 {
+	if len(str) != 4 && len(str) != 5 {
 		return entities.Book{}, errs.New("Invalid book creation format")
 	}
 
@@ -40,11 +40,26 @@ func CreateBook(mess *tgbotapi.Message) (entities.Book, error) {
 		return entities.Book{}, errs.New("Invalid format year")
 	}
 
+	var isbn string
+	if len(str) == 5 {
+		tmp = strings.Trim(str[4], " ")
+		tmpISBN := strings.Split(tmp, "ISBN - ")
+		if len(tmpISBN) != 2 {
+			return entities.Book{}, errs.New("Invalid book creation format")
+		}
+
+		isbn, err = entities.NormalizeISBN(tmpISBN[1])
+		if err != nil {
+			return entities.Book{}, errs.New("Invalid ISBN")
+		}
+	}
+
 	return entities.Book{
 		Title:       tmpTitle[1],
 		Description: tmpDesc[1],
 		Year:        int(year),
 		Genre:       tmpGenre[1],
+		ISBN:        isbn,
 	}, nil
 }
 
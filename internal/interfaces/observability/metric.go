@@ -14,4 +14,7 @@ type UsecasesMetrics interface {
 
 type RepositoriesMetrics interface {
 	RecordDatabaseQuery(ctx context.Context, operation, table string, duration float64, success bool)
+	RecordEventsReclaimed(ctx context.Context, count int)
+	RecordEventsLockFailed(ctx context.Context)
+	ObserveOutbox(stats func() (workers, busy, inFlight int64)) error
 }
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+)
+
+//go:generate mockgen -destination=./../../../mocks/mock_author_repository.go -package=mocks -source=./author_repository.go
+
+type AuthorRepository interface {
+	Create(ctx context.Context, author entities.Author) (entities.Author, error)
+	GetByIDs(ctx context.Context, IDs []int64) ([]entities.Author, error)
+	List(ctx context.Context, params entities.AuthorListParams) (*entities.ResponseAuthors, error)
+	Update(ctx context.Context, author entities.Author) (entities.Author, error)
+	Remove(ctx context.Context, IDs []int64) error
+	CountBooks(ctx context.Context, IDs []int64) (int64, error)
+	GetByBookIDs(ctx context.Context, bookIDs []int64) (map[int64][]entities.Author, error)
+	SetBookAuthors(ctx context.Context, bookID int64, authorIDs []int64) error
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+)
+
+//go:generate mockgen -destination=./../../../mocks/mock_book_event_listener.go -package=mocks -source=./book_event_listener.go
+
+type BookEventListener interface {
+	// Listen - returns the channel receiving a value when new book events are committed,
+	// it is closed after the context is done
+	Listen(ctx context.Context) <-chan struct{}
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/mathbdw/book/internal/domain/entities"
 )
@@ -12,7 +13,10 @@ import (
 
 type BookEventRepository interface {
 	Create(ctx context.Context, bookEvent entities.BookEvent) (int64, error)
-	Lock(ctx context.Context, batchSize uint64) ([]entities.BookEvent, error)
-	Unlock(ctx context.Context, eventIDs []int64) error
-	Remove(ctx context.Context, eventIDs []int64) error
+	CreateBatch(ctx context.Context, bookEvents []entities.BookEvent) ([]int64, error)
+	Lock(ctx context.Context, batchSize uint64, owner string, lease time.Duration) ([]entities.BookEvent, error)
+	Unlock(ctx context.Context, eventIDs []int64, owner string) error
+	Retry(ctx context.Context, eventID int64, owner string, delay time.Duration, lastError string) error
+	Bury(ctx context.Context, eventID int64, owner string, lastError string) error
+	Remove(ctx context.Context, eventIDs []int64, owner string) error
 }
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+)
+
+//go:generate mockgen -destination=./../../../mocks/mock_book_history_repository.go -package=mocks -source=./book_history_repository.go
+
+type BookHistoryRepository interface {
+	Record(ctx context.Context, bookIDs []int64, eventType entities.EventType, actor string) error
+	GetByBookID(ctx context.Context, bookID int64) ([]entities.BookHistory, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+)
+
+//go:generate mockgen -destination=./../../../mocks/mock_book_redirect_repository.go -package=mocks -source=./book_redirect_repository.go
+
+type BookRedirectRepository interface {
+	Add(ctx context.Context, sourceIDs []int64, targetID int64) error
+	GetTargets(ctx context.Context, IDs []int64) (map[int64]int64, error)
+	Delete(ctx context.Context, sourceIDs []int64) error
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/mathbdw/book/internal/domain/entities"
 )
@@ -9,8 +10,18 @@ import (
 //go:generate mockgen -destination=./../../../mocks/mock_book_repository.go -package=mocks -source=./book_repository.go
 
 type BookRepository interface {
-	Create(ctx context.Context, book entities.Book) (int64, error)
+	Create(ctx context.Context, book entities.Book) (entities.Book, error)
+	CreateBatch(ctx context.Context, books []entities.Book) ([]entities.Book, error)
 	GetByIDs(ctx context.Context, IDs []int64) ([]entities.Book, error)
+	GetByIDsAsOf(ctx context.Context, IDs []int64, asOf time.Time) ([]entities.Book, error)
+	FindDuplicates(ctx context.Context, book entities.Book, minSimilarity float64, limit uint64) ([]int64, error)
+	GetByISBN(ctx context.Context, isbn string) (entities.Book, error)
 	List(ctx context.Context, params entities.PaginationParams) (*entities.ResponseBooks, error)
-	Remove(ctx context.Context, IDs []int64) error
+	Search(ctx context.Context, text string, params entities.PaginationParams) (*entities.ResponseBookSearch, error)
+	Update(ctx context.Context, book entities.Book, fields []entities.BookField) (entities.Book, error)
+	Remove(ctx context.Context, IDs []int64, expectedVersion int64) error
+	Restore(ctx context.Context, IDs []int64, expectedVersion int64) error
+	CountPurgeable(ctx context.Context, removedBefore time.Time) (int64, error)
+	LockPurgeable(ctx context.Context, removedBefore time.Time, limit uint64) ([]int64, error)
+	Purge(ctx context.Context, IDs []int64) error
 }
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+)
+
+//go:generate mockgen -destination=./../../../mocks/mock_genre_repository.go -package=mocks -source=./genre_repository.go
+
+type GenreRepository interface {
+	Create(ctx context.Context, genre entities.Genre) (entities.Genre, error)
+	GetByIDs(ctx context.Context, IDs []int64) ([]entities.Genre, error)
+	GetByName(ctx context.Context, name string) (entities.Genre, error)
+	List(ctx context.Context) ([]entities.Genre, error)
+	Update(ctx context.Context, genre entities.Genre) (entities.Genre, error)
+	Remove(ctx context.Context, IDs []int64) error
+	Subtree(ctx context.Context, ID int64) ([]int64, error)
+	CountBooks(ctx context.Context, IDs []int64) (int64, error)
+	CountChildren(ctx context.Context, IDs []int64) (int64, error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+)
+
+//go:generate mockgen -destination=./../../../mocks/mock_idempotency_repository.go -package=mocks -source=./idempotency_repository.go
+
+type IdempotencyRepository interface {
+	Reserve(ctx context.Context, key entities.IdempotencyKey, now time.Time) (bool, error)
+	Get(ctx context.Context, operation entities.IdempotencyOperation, key string) (entities.IdempotencyKey, error)
+	SaveResponse(ctx context.Context, operation entities.IdempotencyOperation, key string, response []byte) error
+	DeleteExpired(ctx context.Context, now time.Time, limit uint64) (int64, error)
+}
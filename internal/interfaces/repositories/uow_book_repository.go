@@ -7,8 +7,14 @@ import (
 //go:generate mockgen -destination=./../../../mocks/mock_uow_book_repository.go -package=mocks -source=./uow_book_repository.go
 
 type Repository struct {
-	Book      BookRepository
-	BookEvent BookEventRepository
+	Book         BookRepository
+	BookEvent    BookEventRepository
+	BookHistory  BookHistoryRepository
+	BookRedirect BookRedirectRepository
+	Author       AuthorRepository
+	Genre        GenreRepository
+
+	Idempotency IdempotencyRepository
 }
 
 type UnitOfWork interface {
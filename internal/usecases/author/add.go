@@ -0,0 +1,36 @@
+package author
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type AddAuthorUsecase struct {
+	repoAuthor repositories.AuthorRepository
+	observ     observability.UsecaseObservability
+}
+
+// NewAddAuthorUsecase - Constructor AddAuthorUsecase
+func NewAddAuthorUsecase(repo repositories.AuthorRepository, observ observability.UsecaseObservability) AddAuthorUsecase {
+	return AddAuthorUsecase{repoAuthor: repo, observ: observ}
+}
+
+// Execute - Adds new author, returns the created author.
+func (uc *AddAuthorUsecase) Execute(ctx context.Context, author entities.Author) (entities.Author, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "AddAuthorUsecase")
+
+	defer span.End()
+
+	created, err := uc.repoAuthor.Create(ctx, author)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.author.failed", Value: true}})
+
+		return entities.Author{}, errors.Wrap(err, "AddAuthorUsecase.Execute: create author")
+	}
+
+	return created, nil
+}
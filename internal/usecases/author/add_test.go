@@ -0,0 +1,65 @@
+package author
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+)
+
+func createMockUsecaseObservability(ctrl *gomock.Controller) *mocks.MockUsecaseObservability {
+	observ := mocks.NewMockUsecaseObservability(ctrl)
+	mockSpan := mocks.NewMockSpan(ctrl)
+
+	observ.EXPECT().StartSpan(gomock.Any(), gomock.Any()).Return(context.Background(), mockSpan).AnyTimes()
+
+	mockSpan.EXPECT().End().AnyTimes()
+	mockSpan.EXPECT().RecordError(gomock.Any()).AnyTimes()
+	mockSpan.EXPECT().SetAttributes(gomock.Any()).AnyTimes()
+
+	return observ
+}
+
+func TestAuthor_Add_ErrorRepo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	authorMock.EXPECT().
+		Create(ctx, entities.Author{Name: "Jules Verne"}).
+		Return(entities.Author{}, errs.New("error repoAuthor"))
+
+	us := NewAddAuthorUsecase(authorMock, observUsecase)
+	author, err := us.Execute(ctx, entities.Author{Name: "Jules Verne"})
+
+	assert.Error(t, err)
+	assert.Equal(t, entities.Author{}, author)
+	assert.Contains(t, err.Error(), "AddAuthorUsecase.Execute: create author")
+}
+
+func TestAuthor_Add_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	authorMock.EXPECT().
+		Create(ctx, entities.Author{Name: "Jules Verne"}).
+		Return(entities.Author{ID: 1, Name: "Jules Verne"}, nil)
+
+	us := NewAddAuthorUsecase(authorMock, observUsecase)
+	author, err := us.Execute(ctx, entities.Author{Name: "Jules Verne"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.Author{ID: 1, Name: "Jules Verne"}, author)
+}
@@ -0,0 +1,20 @@
+package author
+
+type AuthorUsecases struct {
+	Add    AddAuthorUsecase
+	Get    GetAuthorUsecase
+	List   ListAuthorUsecase
+	Update UpdateAuthorUsecase
+	Remove RemoveAuthorUsecase
+}
+
+// New - constructor
+func New(opts ...AuthorOptions) *AuthorUsecases {
+	uc := &AuthorUsecases{}
+
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
@@ -0,0 +1,36 @@
+package author
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type GetAuthorUsecase struct {
+	repoAuthor repositories.AuthorRepository
+	observ     observability.UsecaseObservability
+}
+
+// NewGetAuthorUsecase - Constructor GetAuthorUsecase
+func NewGetAuthorUsecase(repo repositories.AuthorRepository, observ observability.UsecaseObservability) GetAuthorUsecase {
+	return GetAuthorUsecase{repoAuthor: repo, observ: observ}
+}
+
+// GetByIDs - Returns slice author by IDs
+func (uc *GetAuthorUsecase) GetByIDs(ctx context.Context, IDs []int64) ([]entities.Author, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "GetAuthorUsecase")
+
+	defer span.End()
+
+	authors, err := uc.repoAuthor.GetByIDs(ctx, IDs)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.author.failed", Value: true}})
+
+		return []entities.Author{}, errors.Wrap(err, "GetAuthorUsecase.GetByIDs: get authors")
+	}
+
+	return authors, nil
+}
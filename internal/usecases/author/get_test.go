@@ -0,0 +1,55 @@
+package author
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestAuthor_GetByIDs_ErrorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	authorMock.EXPECT().
+		GetByIDs(ctx, []int64{1, 2}).
+		Return(nil, errs.ErrNotFound)
+
+	us := NewGetAuthorUsecase(authorMock, observUsecase)
+	authors, err := us.GetByIDs(ctx, []int64{1, 2})
+
+	assert.Empty(t, authors)
+	assert.Contains(t, err.Error(), "GetAuthorUsecase.GetByIDs: get authors")
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestAuthor_GetByIDs_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	authorMock.EXPECT().
+		GetByIDs(ctx, []int64{1, 2}).
+		Return([]entities.Author{{ID: 1, Name: "First"}, {ID: 2, Name: "Second"}}, nil)
+
+	us := NewGetAuthorUsecase(authorMock, observUsecase)
+	authors, err := us.GetByIDs(ctx, []int64{1, 2})
+
+	assert.NoError(t, err)
+	assert.Len(t, authors, 2)
+}
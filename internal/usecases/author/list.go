@@ -0,0 +1,36 @@
+package author
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type ListAuthorUsecase struct {
+	repoAuthor repositories.AuthorRepository
+	observ     observability.UsecaseObservability
+}
+
+// NewListAuthorUsecase - Constructor ListAuthorUsecase
+func NewListAuthorUsecase(repo repositories.AuthorRepository, observ observability.UsecaseObservability) ListAuthorUsecase {
+	return ListAuthorUsecase{repoAuthor: repo, observ: observ}
+}
+
+// Execute - Returns page of authors ordered by ID
+func (uc *ListAuthorUsecase) Execute(ctx context.Context, params entities.AuthorListParams) (*entities.ResponseAuthors, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "ListAuthorUsecase")
+
+	defer span.End()
+
+	resp, err := uc.repoAuthor.List(ctx, params)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.author.failed", Value: true}})
+
+		return nil, errors.Wrap(err, "ListAuthorUsecase.Execute: list authors")
+	}
+
+	return resp, nil
+}
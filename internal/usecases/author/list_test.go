@@ -0,0 +1,56 @@
+package author
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestAuthor_List_ErrorRepo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	params := entities.AuthorListParams{Limit: 2}
+
+	authorMock.EXPECT().
+		List(ctx, params).
+		Return(nil, errs.New("error repoAuthor"))
+
+	us := NewListAuthorUsecase(authorMock, observUsecase)
+	resp, err := us.Execute(ctx, params)
+
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "ListAuthorUsecase.Execute: list authors")
+}
+
+func TestAuthor_List_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	params := entities.AuthorListParams{Limit: 2}
+	expected := &entities.ResponseAuthors{Data: []entities.Author{{ID: 1}, {ID: 2}}, NextID: 2}
+
+	authorMock.EXPECT().
+		List(ctx, params).
+		Return(expected, nil)
+
+	us := NewListAuthorUsecase(authorMock, observUsecase)
+	resp, err := us.Execute(ctx, params)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp)
+}
@@ -0,0 +1,39 @@
+package author
+
+// AuthorOptions -.
+type AuthorOptions func(*AuthorUsecases)
+
+// WithAddAuthorUsecase - Set usecase add_author
+func WithAddAuthorUsecase(uc AddAuthorUsecase) AuthorOptions {
+	return func(a *AuthorUsecases) {
+		a.Add = uc
+	}
+}
+
+// WithGetAuthorUsecase - Set usecase get_author
+func WithGetAuthorUsecase(uc GetAuthorUsecase) AuthorOptions {
+	return func(a *AuthorUsecases) {
+		a.Get = uc
+	}
+}
+
+// WithListAuthorUsecase - Set usecase list_author
+func WithListAuthorUsecase(uc ListAuthorUsecase) AuthorOptions {
+	return func(a *AuthorUsecases) {
+		a.List = uc
+	}
+}
+
+// WithUpdateAuthorUsecase - Set usecase update_author
+func WithUpdateAuthorUsecase(uc UpdateAuthorUsecase) AuthorOptions {
+	return func(a *AuthorUsecases) {
+		a.Update = uc
+	}
+}
+
+// WithRemoveAuthorUsecase - Set usecase remove_author
+func WithRemoveAuthorUsecase(uc RemoveAuthorUsecase) AuthorOptions {
+	return func(a *AuthorUsecases) {
+		a.Remove = uc
+	}
+}
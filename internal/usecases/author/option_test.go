@@ -0,0 +1,37 @@
+package author
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestWithAuthorUsecases(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+	mockUoWRepo := mocks.NewMockUnitOfWork(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+
+	addUC := NewAddAuthorUsecase(mockAuthorRepo, observUsecase)
+	getUC := NewGetAuthorUsecase(mockAuthorRepo, observUsecase)
+	listUC := NewListAuthorUsecase(mockAuthorRepo, observUsecase)
+	updateUC := NewUpdateAuthorUsecase(mockAuthorRepo, observUsecase)
+	removeUC := NewRemoveAuthorUsecase(mockUoWRepo, observUsecase)
+
+	uc := New(
+		WithAddAuthorUsecase(addUC),
+		WithGetAuthorUsecase(getUC),
+		WithListAuthorUsecase(listUC),
+		WithUpdateAuthorUsecase(updateUC),
+		WithRemoveAuthorUsecase(removeUC),
+	)
+
+	assert.Equal(t, addUC, uc.Add)
+	assert.Equal(t, getUC, uc.Get)
+	assert.Equal(t, listUC, uc.List)
+	assert.Equal(t, updateUC, uc.Update)
+	assert.Equal(t, removeUC, uc.Remove)
+}
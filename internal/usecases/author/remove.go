@@ -0,0 +1,53 @@
+package author
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type RemoveAuthorUsecase struct {
+	repoUOW repositories.UnitOfWork
+	observ  observability.UsecaseObservability
+}
+
+// NewRemoveAuthorUsecase - Constructor RemoveAuthorUsecase
+func NewRemoveAuthorUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) RemoveAuthorUsecase {
+	return RemoveAuthorUsecase{repoUOW: uow, observ: observ}
+}
+
+// Execute - Deletes the authors. Authors linked to any book are not deleted.
+func (uc *RemoveAuthorUsecase) Execute(ctx context.Context, IDs []int64) error {
+	ctx, span := uc.observ.StartSpan(ctx, "RemoveAuthorUsecase")
+
+	defer span.End()
+
+	err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
+		count, err := repo.Author.CountBooks(ctx, IDs)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.author.failed", Value: true}})
+
+			return errors.Wrap(err, "RemoveAuthorUsecase.Execute: count books")
+		}
+
+		if count > 0 {
+			span.SetAttributes([]observability.Attribute{{Key: "author.linked", Value: true}})
+
+			return errors.Wrap(errors.ErrConflict, fmt.Sprintf("RemoveAuthorUsecase.Execute: authors linked to %d books", count))
+		}
+
+		err = repo.Author.Remove(ctx, IDs)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.author.failed", Value: true}})
+
+			return errors.Wrap(err, "RemoveAuthorUsecase.Execute: remove authors")
+		}
+
+		return nil
+	})
+
+	return err
+}
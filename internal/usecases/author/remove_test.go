@@ -0,0 +1,103 @@
+package author
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestAuthor_Remove_ErrorLinked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			authorMock.EXPECT().
+				CountBooks(ctx, []int64{1, 2}).
+				Return(int64(3), nil)
+
+			authorMock.EXPECT().
+				Remove(ctx, gomock.Any()).
+				Times(0)
+
+			return fn(&repositories.Repository{Author: authorMock})
+		})
+
+	us := NewRemoveAuthorUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1, 2})
+
+	assert.True(t, errors.Is(err, errs.ErrConflict))
+	assert.Contains(t, err.Error(), "RemoveAuthorUsecase.Execute: authors linked to 3 books")
+}
+
+func TestAuthor_Remove_ErrorRemove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			authorMock.EXPECT().
+				CountBooks(ctx, []int64{1, 2}).
+				Return(int64(0), nil)
+
+			authorMock.EXPECT().
+				Remove(ctx, []int64{1, 2}).
+				Return(errs.ErrNotFound)
+
+			return fn(&repositories.Repository{Author: authorMock})
+		})
+
+	us := NewRemoveAuthorUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1, 2})
+
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+	assert.Contains(t, err.Error(), "RemoveAuthorUsecase.Execute: remove authors")
+}
+
+func TestAuthor_Remove_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			authorMock.EXPECT().
+				CountBooks(ctx, []int64{1, 2}).
+				Return(int64(0), nil)
+
+			authorMock.EXPECT().
+				Remove(ctx, []int64{1, 2}).
+				Return(nil)
+
+			return fn(&repositories.Repository{Author: authorMock})
+		})
+
+	us := NewRemoveAuthorUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1, 2})
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,38 @@
+package author
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type UpdateAuthorUsecase struct {
+	repoAuthor repositories.AuthorRepository
+	observ     observability.UsecaseObservability
+}
+
+// NewUpdateAuthorUsecase - Constructor UpdateAuthorUsecase
+func NewUpdateAuthorUsecase(repo repositories.AuthorRepository, observ observability.UsecaseObservability) UpdateAuthorUsecase {
+	return UpdateAuthorUsecase{repoAuthor: repo, observ: observ}
+}
+
+// Execute - Updates the author, returns the updated author.
+func (uc *UpdateAuthorUsecase) Execute(ctx context.Context, author entities.Author) (entities.Author, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "UpdateAuthorUsecase")
+
+	defer span.End()
+
+	span.SetAttributes([]observability.Attribute{{Key: "author.id", Value: author.ID}})
+
+	updated, err := uc.repoAuthor.Update(ctx, author)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.author.failed", Value: true}})
+
+		return entities.Author{}, errors.Wrap(err, "UpdateAuthorUsecase.Execute: update author")
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,56 @@
+package author
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestAuthor_Update_ErrorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	author := entities.Author{ID: 1, Name: "New name"}
+
+	authorMock.EXPECT().
+		Update(ctx, author).
+		Return(entities.Author{}, errs.ErrNotFound)
+
+	us := NewUpdateAuthorUsecase(authorMock, observUsecase)
+	_, err := us.Execute(ctx, author)
+
+	assert.Contains(t, err.Error(), "UpdateAuthorUsecase.Execute: update author")
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestAuthor_Update_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	author := entities.Author{ID: 1, Name: "New name"}
+
+	authorMock.EXPECT().
+		Update(ctx, author).
+		Return(author, nil)
+
+	us := NewUpdateAuthorUsecase(authorMock, observUsecase)
+	updated, err := us.Execute(ctx, author)
+
+	assert.NoError(t, err)
+	assert.Equal(t, author, updated)
+}
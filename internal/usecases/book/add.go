@@ -12,35 +12,94 @@ import (
 )
 
 type AddBookUsecase struct {
-	repoUOW repositories.UnitOfWork
-	observ  observability.UsecaseObservability
+	repoUOW        repositories.UnitOfWork
+	observ         observability.UsecaseObservability
+	idempotencyTTL time.Duration
 }
 
-// NewBookUsecase - Constructor AddBookUsecase
-func NewAddBookUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) AddBookUsecase {
-	return AddBookUsecase{repoUOW: uow, observ: observ}
+// NewBookUsecase - Constructor AddBookUsecase, idempotencyTTL - lifetime of the idempotency keys
+func NewAddBookUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability, idempotencyTTL time.Duration) AddBookUsecase {
+	return AddBookUsecase{repoUOW: uow, observ: observ, idempotencyTTL: idempotencyTTL}
 }
 
-// Add - Adds new book and book_event.
-func (uc *AddBookUsecase) Execute(ctx context.Context, book entities.Book) error {
+// Add - Adds new book, book_event and book_history, returns the created book.
+// With the idempotency key the repeated request gets the book created by the first one.
+// Returns DuplicatesError when the book looks like the stored books unless force is set.
+func (uc *AddBookUsecase) Execute(ctx context.Context, book entities.Book, idempotency entities.IdempotencyKey, force bool) (entities.Book, error) {
+	var replayed bool
 	start := time.Now()
 	ctx, span := uc.observ.StartSpan(ctx, "AddBookUsecase")
 
 	defer span.End()
 
 	defer func() {
+		if replayed {
+			return
+		}
 		duration := time.Since(start).Seconds()
 		uc.observ.RecordBookCreated(ctx, book.Genre, duration)
 	}()
 
+	idempotency.Operation = entities.IdempotencyOperationAddBook
 	err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
-		id, err := repo.Book.Create(ctx, book)
+		if !idempotency.IsEmpty() {
+			stored, err := reserveIdempotencyKey(ctx, repo, idempotency, uc.idempotencyTTL)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.idempotency.failed", Value: true}})
+
+				return errors.Wrap(err, "addBookUsecases.Execute: idempotency key")
+			}
+
+			if stored != nil {
+				replayed = true
+				span.SetAttributes([]observability.Attribute{{Key: "idempotency.replayed", Value: true}})
+
+				if err := json.Unmarshal(stored.Response, &book); err != nil {
+					span.RecordError(err)
+					span.SetAttributes([]observability.Attribute{{Key: "json.unmarshal.failed", Value: true}})
+
+					return errors.Wrap(err, "addBookUsecases.Execute: json unmarshal stored book")
+				}
+
+				return nil
+			}
+		}
+
+		genre, err := resolveGenre(ctx, repo, book)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+			return errors.Wrap(err, "addBookUsecases.Execute: resolve genre")
+		}
+		book.GenreID, book.Genre = genre.ID, genre.Name
+
+		if !force {
+			err = checkDuplicates(ctx, repo, book)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.book.duplicates", Value: true}})
+
+				return errors.Wrap(err, "addBookUsecases.Execute: check duplicates")
+			}
+		}
+
+		created, err := repo.Book.Create(ctx, book)
 		if err != nil {
 			span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
 
 			return errors.Wrap(err, "addBookUsecases.Execute: failed to save book")
 		}
-		book.ID = id
+
+		created.Genre = genre.Name
+
+		if len(book.Authors) > 0 {
+			created.Authors, err = linkAuthors(ctx, repo, created.ID, book.Authors)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.author.failed", Value: true}})
+
+				return errors.Wrap(err, "addBookUsecases.Execute: link authors")
+			}
+		}
+		book = created
 
 		strBook, err := json.Marshal(book)
 		if err != nil {
@@ -58,8 +117,27 @@ func (uc *AddBookUsecase) Execute(ctx context.Context, book entities.Book) error
 			return errors.Wrap(err, "addBookUsecases.Execute: create book event")
 		}
 
+		err = repo.BookHistory.Record(ctx, []int64{book.ID}, entities.Created, actorFromContext(ctx))
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookHistory.failed", Value: true}})
+
+			return errors.Wrap(err, "addBookUsecases.Execute: record book history")
+		}
+
+		if !idempotency.IsEmpty() {
+			err = repo.Idempotency.SaveResponse(ctx, idempotency.Operation, idempotency.Key, strBook)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.idempotency.failed", Value: true}})
+
+				return errors.Wrap(err, "addBookUsecases.Execute: save idempotency response")
+			}
+		}
+
 		return nil
 	})
+	if err != nil {
+		return entities.Book{}, err
+	}
 
-	return err
+	return book, nil
 }
@@ -2,7 +2,10 @@ package book
 
 import (
 	"context"
+	"encoding/json"
+	stderrors "errors"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
@@ -34,18 +37,27 @@ func TestBook_Create_ErrorRepoBook(t *testing.T) {
 	uowMock := mocks.NewMockUnitOfWork(ctrl)
 	bookMock := mocks.NewMockBookRepository(ctrl)
 	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
 	observUsecase := createMockUsecaseObservability(ctrl)
-	us := NewAddBookUsecase(uowMock, observUsecase)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
 
-	book := entities.Book{Title: "Test", Description: "Test Desc", Genre: "Test Genre", Year: 2019}
+	book := entities.Book{Title: "Test", Description: "Test Desc", GenreID: 3, Genre: "Test Genre", Year: 2019}
 
 	ctx := context.Background()
 	uowMock.EXPECT().
 		Do(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Test Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), duplicateTitleSimilarity, uint64(duplicatesLimit)).
+				Return([]int64{}, nil)
+
 			bookMock.EXPECT().
 				Create(ctx, book).
-				Return(int64(0), errors.New("error repoBook"))
+				Return(entities.Book{}, errors.New("error repoBook"))
 
 			bookEventMock.EXPECT().
 				Create(ctx, gomock.Any()).
@@ -55,14 +67,16 @@ func TestBook_Create_ErrorRepoBook(t *testing.T) {
 			repo := &repositories.Repository{
 				Book:      bookMock,
 				BookEvent: bookEventMock,
+				Genre:     genreMock,
 			}
 
 			return fn(repo)
 		})
 
-	err := us.Execute(ctx, book)
+	res, err := us.Execute(ctx, book, entities.IdempotencyKey{}, false)
 
 	assert.Error(t, err)
+	assert.Equal(t, entities.Book{}, res)
 	assert.Contains(t, err.Error(), "addBookUsecases.Execute: failed to save book")
 }
 
@@ -73,18 +87,30 @@ func TestBook_Create_ErrorRepoBookEvent(t *testing.T) {
 	uowMock := mocks.NewMockUnitOfWork(ctrl)
 	bookMock := mocks.NewMockBookRepository(ctrl)
 	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
 	observUsecase := createMockUsecaseObservability(ctrl)
-	us := NewAddBookUsecase(uowMock, observUsecase)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
 
-	book := entities.Book{Title: "Test", Description: "Test Desc", Genre: "Test Genre", Year: 2019}
+	book := entities.Book{Title: "Test", Description: "Test Desc", GenreID: 3, Genre: "Test Genre", Year: 2019}
+	created := book
+	created.ID = 1
+	created.CreatedAt = time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
 
 	ctx := context.Background()
 	uowMock.EXPECT().
 		Do(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Test Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), duplicateTitleSimilarity, uint64(duplicatesLimit)).
+				Return([]int64{}, nil)
+
 			bookMock.EXPECT().
 				Create(ctx, book).
-				Return(int64(1), nil)
+				Return(created, nil)
 
 			bookEventMock.EXPECT().
 				Create(ctx, gomock.Any()).
@@ -93,14 +119,16 @@ func TestBook_Create_ErrorRepoBookEvent(t *testing.T) {
 			repo := &repositories.Repository{
 				Book:      bookMock,
 				BookEvent: bookEventMock,
+				Genre:     genreMock,
 			}
 
 			return fn(repo)
 		})
 
-	err := us.Execute(ctx, book)
+	res, err := us.Execute(ctx, book, entities.IdempotencyKey{}, false)
 
 	assert.Error(t, err)
+	assert.Equal(t, entities.Book{}, res)
 	assert.Contains(t, err.Error(), "addBookUsecases.Execute: create book event")
 }
 
@@ -111,32 +139,526 @@ func TestBook_Create_Success(t *testing.T) {
 	uowMock := mocks.NewMockUnitOfWork(ctrl)
 	bookMock := mocks.NewMockBookRepository(ctrl)
 	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
 	observUsecase := createMockUsecaseObservability(ctrl)
-	us := NewAddBookUsecase(uowMock, observUsecase)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
 
-	book := entities.Book{Title: "Test", Description: "Test Desc", Genre: "Test Genre", Year: 2019}
+	book := entities.Book{Title: "Test", Description: "Test Desc", GenreID: 3, Genre: "Test Genre", Year: 2019}
+	created := book
+	created.ID = 1
+	created.CreatedAt = time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
 
 	ctx := context.Background()
 	uowMock.EXPECT().
 		Do(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Test Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), duplicateTitleSimilarity, uint64(duplicatesLimit)).
+				Return([]int64{}, nil)
+
 			bookMock.EXPECT().
 				Create(ctx, book).
+				Return(created, nil)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
 				Return(int64(1), nil)
 
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Genre:       genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := us.Execute(ctx, book, entities.IdempotencyKey{}, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, res)
+}
+
+func TestBook_Create_ErrorDuplicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
+
+	book := entities.Book{Title: "Tset", Description: "Test Desc", GenreID: 3, Year: 2019}
+
+	ctx := context.Background()
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Test Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, entities.Book{Title: "Tset", Description: "Test Desc", GenreID: 3, Genre: "Test Genre", Year: 2019}, duplicateTitleSimilarity, uint64(duplicatesLimit)).
+				Return([]int64{4, 2}, nil)
+
+			bookMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:  bookMock,
+				Genre: genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := us.Execute(ctx, book, entities.IdempotencyKey{}, false)
+
+	var duplicates *DuplicatesError
+	assert.ErrorAs(t, err, &duplicates)
+	assert.Equal(t, []int64{4, 2}, duplicates.IDs)
+	assert.ErrorIs(t, err, errors.ErrAlreadyExists)
+	assert.Equal(t, entities.Book{}, res)
+}
+
+func TestBook_Create_SuccessForce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
+
+	book := entities.Book{Title: "Tset", Description: "Test Desc", GenreID: 3, Genre: "Test Genre", Year: 2019}
+	created := book
+	created.ID = 5
+
+	ctx := context.Background()
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Test Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(0)
+
+			bookMock.EXPECT().
+				Create(ctx, book).
+				Return(created, nil)
+
 			bookEventMock.EXPECT().
 				Create(ctx, gomock.Any()).
 				Return(int64(1), nil)
 
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{5}, entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Genre:       genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := us.Execute(ctx, book, entities.IdempotencyKey{}, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, res)
+}
+
+func TestBook_Create_ErrorAuthorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
+
+	book := entities.Book{Title: "Test", GenreID: 3, Genre: "Test Genre", Year: 2019, Authors: []entities.Author{{ID: 7}, {ID: 2}}}
+
+	ctx := context.Background()
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Test Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), duplicateTitleSimilarity, uint64(duplicatesLimit)).
+				Return([]int64{}, nil)
+
+			bookMock.EXPECT().
+				Create(ctx, book).
+				Return(entities.Book{ID: 1}, nil)
+
+			authorMock.EXPECT().
+				GetByIDs(ctx, []int64{7, 2}).
+				Return([]entities.Author{{ID: 2, Name: "Second"}}, nil)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Times(0)
+
 			repo := &repositories.Repository{
 				Book:      bookMock,
 				BookEvent: bookEventMock,
+				Genre:     genreMock,
+				Author:    authorMock,
+			}
+
+			return fn(repo)
+		})
+
+	_, err := us.Execute(ctx, book, entities.IdempotencyKey{}, false)
+
+	assert.Error(t, err)
+	assert.True(t, stderrors.Is(err, errors.ErrInvalidInput))
+	assert.Contains(t, err.Error(), "addBookUsecases.Execute: link authors")
+
+	var notFound *AuthorsNotFoundError
+	assert.True(t, stderrors.As(err, &notFound))
+	assert.Equal(t, []int64{7}, notFound.IDs)
+}
+
+func TestBook_Create_SuccessWithAuthors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
+
+	book := entities.Book{Title: "Test", GenreID: 3, Genre: "Test Genre", Year: 2019, Authors: []entities.Author{{ID: 7}, {ID: 2}}}
+	authors := []entities.Author{{ID: 7, Name: "First"}, {ID: 2, Name: "Second"}}
+	expected := entities.Book{ID: 1, Title: "Test", GenreID: 3, Genre: "Test Genre", Year: 2019, Authors: authors}
+
+	ctx := context.Background()
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Test Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), duplicateTitleSimilarity, uint64(duplicatesLimit)).
+				Return([]int64{}, nil)
+
+			bookMock.EXPECT().
+				Create(ctx, book).
+				Return(entities.Book{ID: 1, Title: "Test", GenreID: 3, Genre: "Test Genre", Year: 2019}, nil)
+
+			authorMock.EXPECT().
+				GetByIDs(ctx, []int64{7, 2}).
+				Return([]entities.Author{authors[1], authors[0]}, nil)
+
+			authorMock.EXPECT().
+				SetBookAuthors(ctx, int64(1), []int64{7, 2}).
+				Return(nil)
+
+			payload, _ := json.Marshal(expected)
+			bookEventMock.EXPECT().
+				Create(ctx, entities.BookEvent{BookId: 1, Type: entities.Created, Status: entities.EventStatusNew, Payload: payload}).
+				Return(int64(1), nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Genre:       genreMock,
+				Author:      authorMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := us.Execute(ctx, book, entities.IdempotencyKey{}, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, res)
+}
+
+func TestBook_Create_ErrorGenreNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
+
+	book := entities.Book{Title: "Test", Description: "Test Desc", GenreID: 9, Year: 2019}
+
+	ctx := context.Background()
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{9}).
+				Return(nil, errors.ErrNotFound)
+
+			bookMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:  bookMock,
+				Genre: genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	_, err := us.Execute(ctx, book, entities.IdempotencyKey{}, false)
+
+	assert.Error(t, err)
+	assert.True(t, stderrors.Is(err, errors.ErrInvalidInput))
+	assert.Contains(t, err.Error(), "addBookUsecases.Execute: resolve genre")
+
+	var notFound *GenreNotFoundError
+	assert.True(t, stderrors.As(err, &notFound))
+	assert.Equal(t, "9", notFound.Genre)
+}
+
+func TestBook_Create_SuccessGenreByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
+
+	book := entities.Book{Title: "Test", Description: "Test Desc", Genre: "fantasy", Year: 2019}
+	resolved := entities.Book{Title: "Test", Description: "Test Desc", GenreID: 4, Genre: "Fantasy", Year: 2019}
+	created := resolved
+	created.ID = 1
+
+	ctx := context.Background()
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByName(ctx, "fantasy").
+				Return(entities.Genre{ID: 4, Name: "Fantasy"}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), duplicateTitleSimilarity, uint64(duplicatesLimit)).
+				Return([]int64{}, nil)
+
+			bookMock.EXPECT().
+				Create(ctx, resolved).
+				Return(entities.Book{ID: 1, Title: "Test", Description: "Test Desc", GenreID: 4, Year: 2019}, nil)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(1), nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Genre:       genreMock,
 			}
 
 			return fn(repo)
 		})
 
-	err := us.Execute(ctx, book)
+	res, err := us.Execute(ctx, book, entities.IdempotencyKey{}, false)
 
 	assert.NoError(t, err)
+	assert.Equal(t, created, res)
+}
+
+func TestBook_Create_IdempotencySaved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	idempotencyMock := mocks.NewMockIdempotencyRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
+
+	book := entities.Book{Title: "Test", GenreID: 3, Genre: "Test Genre", Year: 2019}
+	created := book
+	created.ID = 1
+	strCreated, _ := json.Marshal(created)
+	key := entities.IdempotencyKey{Key: "key-1", RequestHash: "hash"}
+
+	ctx := context.Background()
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			idempotencyMock.EXPECT().
+				Reserve(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, reserved entities.IdempotencyKey, now time.Time) (bool, error) {
+					assert.Equal(t, entities.IdempotencyOperationAddBook, reserved.Operation)
+					assert.Equal(t, now.Add(time.Hour), reserved.ExpiresAt)
+
+					return true, nil
+				})
+
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Test Genre"}}, nil)
+
+			bookMock.EXPECT().
+				FindDuplicates(ctx, gomock.Any(), duplicateTitleSimilarity, uint64(duplicatesLimit)).
+				Return([]int64{}, nil)
+
+			bookMock.EXPECT().
+				Create(ctx, book).
+				Return(created, nil)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(1), nil)
+
+			idempotencyMock.EXPECT().
+				SaveResponse(ctx, entities.IdempotencyOperationAddBook, "key-1", strCreated).
+				Return(nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Genre:       genreMock,
+				Idempotency: idempotencyMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := us.Execute(ctx, book, key, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, res)
+}
+
+func TestBook_Create_IdempotencyReplayed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	idempotencyMock := mocks.NewMockIdempotencyRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
+
+	created := entities.Book{ID: 1, Title: "Test", GenreID: 3, Genre: "Test Genre", Year: 2019}
+	strCreated, _ := json.Marshal(created)
+	key := entities.IdempotencyKey{Key: "key-1", RequestHash: "hash"}
+
+	ctx := context.Background()
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			idempotencyMock.EXPECT().
+				Reserve(ctx, gomock.Any(), gomock.Any()).
+				Return(false, nil)
+
+			idempotencyMock.EXPECT().
+				Get(ctx, entities.IdempotencyOperationAddBook, "key-1").
+				Return(entities.IdempotencyKey{Key: "key-1", RequestHash: "hash", Response: strCreated}, nil)
+
+			bookMock.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				Idempotency: idempotencyMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, err := us.Execute(ctx, entities.Book{Title: "Test", GenreID: 3}, key, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, res)
+}
+
+func TestBook_Create_IdempotencyConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	idempotencyMock := mocks.NewMockIdempotencyRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewAddBookUsecase(uowMock, observUsecase, time.Hour)
+
+	ctx := context.Background()
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			idempotencyMock.EXPECT().
+				Reserve(ctx, gomock.Any(), gomock.Any()).
+				Return(false, nil)
+
+			idempotencyMock.EXPECT().
+				Get(ctx, entities.IdempotencyOperationAddBook, "key-1").
+				Return(entities.IdempotencyKey{Key: "key-1", RequestHash: "other"}, nil)
+
+			return fn(&repositories.Repository{Idempotency: idempotencyMock})
+		})
+
+	res, err := us.Execute(ctx, entities.Book{Title: "Test", GenreID: 3}, entities.IdempotencyKey{Key: "key-1", RequestHash: "hash"}, false)
+
+	assert.ErrorIs(t, err, errors.ErrConflict)
+	assert.Equal(t, entities.Book{}, res)
 }
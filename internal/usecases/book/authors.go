@@ -0,0 +1,97 @@
+package book
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+// linkAuthors - Resolves the book authors by their IDs and replaces the authors linked to the book.
+// Returns the authors in the requested order.
+func linkAuthors(ctx context.Context, repo *repositories.Repository, bookID int64, authors []entities.Author) ([]entities.Author, error) {
+	if len(authors) == 0 {
+		err := repo.Author.SetBookAuthors(ctx, bookID, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "set book authors")
+		}
+
+		return nil, nil
+	}
+
+	resolved, err := resolveAuthors(ctx, repo, authors)
+	if err != nil {
+		return nil, err
+	}
+
+	IDs := make([]int64, 0, len(resolved))
+	for _, author := range resolved {
+		IDs = append(IDs, author.ID)
+	}
+
+	err = repo.Author.SetBookAuthors(ctx, bookID, IDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "set book authors")
+	}
+
+	return resolved, nil
+}
+
+// AuthorsNotFoundError - the authors of the book are not stored, it is errors.ErrInvalidInput
+type AuthorsNotFoundError struct {
+	IDs []int64
+}
+
+// Error - implements the error interface
+func (e *AuthorsNotFoundError) Error() string {
+	return fmt.Sprintf("authors %v not found", e.IDs)
+}
+
+// Unwrap - the missing authors are errors.ErrInvalidInput
+func (e *AuthorsNotFoundError) Unwrap() error {
+	return errors.ErrInvalidInput
+}
+
+// resolveAuthors - Returns the stored authors by the IDs of the authors in the same order,
+// a missing author is AuthorsNotFoundError.
+func resolveAuthors(ctx context.Context, repo *repositories.Repository, authors []entities.Author) ([]entities.Author, error) {
+	IDs := make([]int64, 0, len(authors))
+	for _, author := range authors {
+		IDs = append(IDs, author.ID)
+	}
+
+	stored, err := repo.Author.GetByIDs(ctx, IDs)
+	if err != nil {
+		if stderrors.Is(err, errors.ErrNotFound) {
+			return nil, &AuthorsNotFoundError{IDs: IDs}
+		}
+
+		return nil, errors.Wrap(err, "get authors")
+	}
+
+	byID := make(map[int64]entities.Author, len(stored))
+	for _, author := range stored {
+		byID[author.ID] = author
+	}
+
+	resolved := make([]entities.Author, 0, len(IDs))
+	var missing []int64
+	for _, id := range IDs {
+		author, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+
+			continue
+		}
+		resolved = append(resolved, author)
+	}
+
+	if len(missing) > 0 {
+		return nil, &AuthorsNotFoundError{IDs: missing}
+	}
+
+	return resolved, nil
+}
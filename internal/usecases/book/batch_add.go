@@ -0,0 +1,197 @@
+package book
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type BatchAddBookUsecase struct {
+	repoUOW repositories.UnitOfWork
+	observ  observability.UsecaseObservability
+}
+
+// NewBatchAddBookUsecase - Constructor BatchAddBookUsecase
+func NewBatchAddBookUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) BatchAddBookUsecase {
+	return BatchAddBookUsecase{repoUOW: uow, observ: observ}
+}
+
+// Execute - Adds books and their book_event and book_history rows in one transaction, returns the created books in the same order.
+// In best-effort mode the books with an unknown genre or author or an ISBN that is taken are checked before the insert
+// and skipped: their errors are returned at their positions, the created book at such a position is empty.
+func (uc *BatchAddBookUsecase) Execute(ctx context.Context, books []entities.Book, bestEffort bool) ([]entities.Book, []error, error) {
+	start := time.Now()
+	ctx, span := uc.observ.StartSpan(ctx, "BatchAddBookUsecase")
+	span.SetAttributes([]observability.Attribute{
+		{Key: "books.count", Value: len(books)},
+		{Key: "batch.best_effort", Value: bestEffort},
+	})
+
+	defer span.End()
+
+	var inserted []entities.Book
+	created := make([]entities.Book, len(books))
+	skipped := make([]error, len(books))
+	err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
+		type genreKey struct {
+			ID   int64
+			Name string
+		}
+
+		genres := make(map[genreKey]entities.Genre)
+		isbns := make(map[string]struct{})
+		positions := make([]int, 0, len(books))
+		valid := make([]entities.Book, 0, len(books))
+		for i := range books {
+			key := genreKey{ID: books[i].GenreID, Name: strings.ToLower(books[i].Genre)}
+			genre, ok := genres[key]
+			if !ok {
+				var err error
+				genre, err = resolveGenre(ctx, repo, books[i])
+				if err != nil {
+					if bestEffort && stderrors.Is(err, errors.ErrInvalidInput) {
+						skipped[i] = err
+						continue
+					}
+					span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+					return errors.Wrap(err, fmt.Sprintf("batchAddBookUsecase.Execute: resolve genre of book = %d", i))
+				}
+				genres[key] = genre
+			}
+			books[i].GenreID, books[i].Genre = genre.ID, genre.Name
+
+			if bestEffort {
+				err := checkBatchBook(ctx, repo, books[i], isbns)
+				if err != nil {
+					if stderrors.Is(err, errors.ErrInvalidInput) || stderrors.Is(err, errors.ErrAlreadyExists) {
+						skipped[i] = err
+						continue
+					}
+					span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+					return errors.Wrap(err, fmt.Sprintf("batchAddBookUsecase.Execute: check book = %d", i))
+				}
+			}
+
+			positions = append(positions, i)
+			valid = append(valid, books[i])
+		}
+
+		span.SetAttributes([]observability.Attribute{{Key: "batch.skipped", Value: len(books) - len(valid)}})
+		if len(valid) == 0 {
+			return nil
+		}
+
+		var err error
+		inserted, err = repo.Book.CreateBatch(ctx, valid)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+			return errors.Wrap(err, "batchAddBookUsecase.Execute: failed to save books")
+		}
+
+		for i := range inserted {
+			inserted[i].Genre = valid[i].Genre
+			if len(valid[i].Authors) == 0 {
+				continue
+			}
+
+			inserted[i].Authors, err = linkAuthors(ctx, repo, inserted[i].ID, valid[i].Authors)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.author.failed", Value: true}})
+
+				return errors.Wrap(err, fmt.Sprintf("batchAddBookUsecase.Execute: link authors of book = %d", positions[i]))
+			}
+		}
+
+		events := make([]entities.BookEvent, 0, len(inserted))
+		for _, book := range inserted {
+			strBook, err := json.Marshal(book)
+			if err != nil {
+				span.RecordError(err)
+				span.SetAttributes([]observability.Attribute{{Key: "json.marshal.failed", Value: true}})
+
+				return errors.Wrap(err, fmt.Sprintf("batchAddBookUsecase.Execute: json marshal book = %d", book.ID))
+			}
+
+			events = append(events, entities.BookEvent{BookId: book.ID, Type: entities.Created, Status: entities.EventStatusNew, Payload: strBook})
+		}
+
+		_, err = repo.BookEvent.CreateBatch(ctx, events)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookEvent.failed", Value: true}})
+
+			return errors.Wrap(err, "batchAddBookUsecase.Execute: create book events")
+		}
+
+		IDs := make([]int64, 0, len(inserted))
+		for _, book := range inserted {
+			IDs = append(IDs, book.ID)
+		}
+
+		err = repo.BookHistory.Record(ctx, IDs, entities.Created, actorFromContext(ctx))
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookHistory.failed", Value: true}})
+
+			return errors.Wrap(err, "batchAddBookUsecase.Execute: record book history")
+		}
+
+		for j, i := range positions {
+			created[i] = inserted[j]
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(inserted) > 0 {
+		duration := time.Since(start).Seconds() / float64(len(inserted))
+		for _, book := range inserted {
+			uc.observ.RecordBookCreated(ctx, book.Genre, duration)
+		}
+	}
+
+	return created, skipped, nil
+}
+
+// checkBatchBook - Checks the authors and the ISBN of the book before it is saved,
+// isbns holds the ISBNs of the books of the batch that passed the check.
+func checkBatchBook(ctx context.Context, repo *repositories.Repository, book entities.Book, isbns map[string]struct{}) error {
+	if book.ISBN != "" {
+		if _, ok := isbns[book.ISBN]; ok {
+			return errors.Wrap(errors.ErrAlreadyExists, fmt.Sprintf("isbn %s is repeated in the batch", book.ISBN))
+		}
+
+		_, err := repo.Book.GetByISBN(ctx, book.ISBN)
+		if err == nil {
+			return errors.Wrap(errors.ErrAlreadyExists, fmt.Sprintf("book with isbn %s already exists", book.ISBN))
+		}
+		if !stderrors.Is(err, errors.ErrNotFound) {
+			return errors.Wrap(err, "get book by isbn")
+		}
+	}
+
+	if len(book.Authors) > 0 {
+		_, err := resolveAuthors(ctx, repo, book.Authors)
+		if err != nil {
+			return err
+		}
+	}
+
+	if book.ISBN != "" {
+		isbns[book.ISBN] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package book
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestBook_BatchAdd_ErrorRepoBook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewBatchAddBookUsecase(uowMock, observUsecase)
+	ctx := context.Background()
+
+	books := []entities.Book{{Title: "First", Description: "Desc", GenreID: 3, Genre: "Genre", Year: 2001}}
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Genre"}}, nil)
+
+			bookMock.EXPECT().
+				CreateBatch(ctx, books).
+				Return(nil, errors.New("error repoBook"))
+
+			bookEventMock.EXPECT().
+				CreateBatch(ctx, gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				BookEvent: bookEventMock,
+				Genre:     genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, skipped, err := us.Execute(ctx, books, false)
+
+	assert.Error(t, err)
+	assert.Nil(t, res)
+	assert.Nil(t, skipped)
+	assert.Contains(t, err.Error(), "batchAddBookUsecase.Execute: failed to save books")
+}
+
+func TestBook_BatchAdd_ErrorRepoBookEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewBatchAddBookUsecase(uowMock, observUsecase)
+	ctx := context.Background()
+
+	books := []entities.Book{{Title: "First", Description: "Desc", GenreID: 3, Genre: "Genre", Year: 2001}}
+	created := []entities.Book{{ID: 1, Title: "First", Description: "Desc", GenreID: 3, Genre: "Genre", Year: 2001}}
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Genre"}}, nil)
+
+			bookMock.EXPECT().
+				CreateBatch(ctx, books).
+				Return(created, nil)
+
+			bookEventMock.EXPECT().
+				CreateBatch(ctx, gomock.Any()).
+				Return(nil, errors.New("error repoBookEvent"))
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				BookEvent: bookEventMock,
+				Genre:     genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, skipped, err := us.Execute(ctx, books, false)
+
+	assert.Error(t, err)
+	assert.Nil(t, res)
+	assert.Nil(t, skipped)
+	assert.Contains(t, err.Error(), "batchAddBookUsecase.Execute: create book events")
+}
+
+func TestBook_BatchAdd_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewBatchAddBookUsecase(uowMock, observUsecase)
+	ctx := context.Background()
+
+	books := []entities.Book{
+		{Title: "First", Description: "Desc", GenreID: 3, Genre: "Genre", Year: 2001},
+		{Title: "Second", Description: "Desc", GenreID: 3, Genre: "Genre", Year: 2002},
+	}
+	created := []entities.Book{
+		{ID: 1, Title: "First", Description: "Desc", GenreID: 3, Genre: "Genre", Year: 2001},
+		{ID: 2, Title: "Second", Description: "Desc", GenreID: 3, Genre: "Genre", Year: 2002},
+	}
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Genre"}}, nil)
+
+			bookMock.EXPECT().
+				CreateBatch(ctx, books).
+				Return(created, nil)
+
+			bookEventMock.EXPECT().
+				CreateBatch(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, events []entities.BookEvent) ([]int64, error) {
+					assert.Len(t, events, 2)
+					for i, event := range events {
+						assert.Equal(t, created[i].ID, event.BookId)
+						assert.Equal(t, entities.Created, event.Type)
+						assert.Equal(t, entities.EventStatusNew, event.Status)
+					}
+
+					return []int64{10, 11}, nil
+				})
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1, 2}, entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Genre:       genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, skipped, err := us.Execute(ctx, books, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, res)
+	assert.Equal(t, []error{nil, nil}, skipped)
+}
+
+func TestBook_BatchAdd_BestEffortSkipped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewBatchAddBookUsecase(uowMock, observUsecase)
+	ctx := context.Background()
+
+	books := []entities.Book{
+		{Title: "First", GenreID: 3, Year: 2001, ISBN: "9780306406157"},
+		{Title: "Second", GenreID: 9, Year: 2002},
+		{Title: "Third", GenreID: 3, Year: 2003, ISBN: "9780131103627", Authors: []entities.Author{{ID: 5}}},
+		{Title: "Fourth", GenreID: 3, Year: 2004, Authors: []entities.Author{{ID: 6}}},
+	}
+	third := entities.Book{Title: "Third", GenreID: 3, Genre: "Genre", Year: 2003, ISBN: "9780131103627", Authors: []entities.Author{{ID: 5}}}
+	created := entities.Book{ID: 3, Title: "Third", GenreID: 3, Year: 2003, ISBN: "9780131103627"}
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{3}).
+				Return([]entities.Genre{{ID: 3, Name: "Genre"}}, nil)
+
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{9}).
+				Return(nil, errs.ErrNotFound)
+
+			bookMock.EXPECT().
+				GetByISBN(ctx, "9780306406157").
+				Return(entities.Book{ID: 1, ISBN: "9780306406157"}, nil)
+
+			bookMock.EXPECT().
+				GetByISBN(ctx, "9780131103627").
+				Return(entities.Book{}, errs.ErrNotFound)
+
+			authorMock.EXPECT().
+				GetByIDs(ctx, []int64{5}).
+				Return([]entities.Author{{ID: 5, Name: "Author"}}, nil).
+				Times(2)
+
+			authorMock.EXPECT().
+				GetByIDs(ctx, []int64{6}).
+				Return(nil, errs.ErrNotFound)
+
+			bookMock.EXPECT().
+				CreateBatch(ctx, []entities.Book{third}).
+				Return([]entities.Book{created}, nil)
+
+			authorMock.EXPECT().
+				SetBookAuthors(ctx, int64(3), []int64{5}).
+				Return(nil)
+
+			bookEventMock.EXPECT().
+				CreateBatch(ctx, gomock.Any()).
+				Return([]int64{10}, nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{3}, entities.Created, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Author:      authorMock,
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Genre:       genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, skipped, err := us.Execute(ctx, books, true)
+
+	assert.NoError(t, err)
+	assert.Len(t, res, 4)
+	assert.Equal(t, int64(3), res[2].ID)
+	assert.Equal(t, "Genre", res[2].Genre)
+	assert.Equal(t, []entities.Author{{ID: 5, Name: "Author"}}, res[2].Authors)
+	assert.Empty(t, res[0])
+	assert.ErrorIs(t, skipped[0], errs.ErrAlreadyExists)
+	assert.ErrorIs(t, skipped[1], errs.ErrInvalidInput)
+	assert.Contains(t, skipped[1].Error(), "genre 9 not found")
+	assert.NoError(t, skipped[2])
+	assert.ErrorIs(t, skipped[3], errs.ErrInvalidInput)
+}
+
+func TestBook_BatchAdd_AllOrNothingUnknownGenre(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewBatchAddBookUsecase(uowMock, observUsecase)
+	ctx := context.Background()
+
+	books := []entities.Book{{Title: "First", GenreID: 9, Year: 2001}}
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{9}).
+				Return(nil, errs.ErrNotFound)
+
+			bookMock.EXPECT().
+				CreateBatch(ctx, gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:  bookMock,
+				Genre: genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	res, skipped, err := us.Execute(ctx, books, false)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "batchAddBookUsecase.Execute: resolve genre of book = 0")
+	assert.Nil(t, res)
+	assert.Nil(t, skipped)
+}
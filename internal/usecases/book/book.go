@@ -6,6 +6,12 @@ type BookUsecases struct {
 	Get GetBookUsecase
 	List ListBookUsecase
 	Remove RemoveBookUsecase
+	Update UpdateBookUsecase
+	Restore RestoreBookUsecase
+	BatchAdd BatchAddBookUsecase
+	Search SearchBookUsecase
+	History HistoryBookUsecase
+	Merge MergeBookUsecase
 }
 
 // New - constructor 
@@ -0,0 +1,46 @@
+package book
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+const (
+	// duplicateTitleSimilarity - the trigram similarity of the titles from which the books of the same year and genre are duplicates
+	duplicateTitleSimilarity = 0.5
+	// duplicatesLimit - max number of the returned duplicates
+	duplicatesLimit = 5
+)
+
+// DuplicatesError - the added book looks like the stored books, it is errors.ErrAlreadyExists
+type DuplicatesError struct {
+	IDs []int64 // the stored books, the most similar first
+}
+
+// Error - implements the error interface
+func (e *DuplicatesError) Error() string {
+	return fmt.Sprintf("likely duplicate of the books %v", e.IDs)
+}
+
+// Unwrap - the duplicates are errors.ErrAlreadyExists
+func (e *DuplicatesError) Unwrap() error {
+	return errors.ErrAlreadyExists
+}
+
+// checkDuplicates - returns DuplicatesError when stored books have a similar title, the same year and genre
+func checkDuplicates(ctx context.Context, repo *repositories.Repository, book entities.Book) error {
+	IDs, err := repo.Book.FindDuplicates(ctx, book, duplicateTitleSimilarity, duplicatesLimit)
+	if err != nil {
+		return errors.Wrap(err, "find duplicates")
+	}
+
+	if len(IDs) > 0 {
+		return &DuplicatesError{IDs: IDs}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package book
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strconv"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+// GenreNotFoundError - the genre of the book is not stored, it is errors.ErrInvalidInput
+type GenreNotFoundError struct {
+	Genre string // the name or the ID of the genre
+}
+
+// Error - implements the error interface
+func (e *GenreNotFoundError) Error() string {
+	return fmt.Sprintf("genre %s not found", e.Genre)
+}
+
+// Unwrap - the missing genre is errors.ErrInvalidInput
+func (e *GenreNotFoundError) Unwrap() error {
+	return errors.ErrInvalidInput
+}
+
+// resolveGenre - Returns the genre of the book by its ID, or by its name ignoring case when the ID is not set.
+func resolveGenre(ctx context.Context, repo *repositories.Repository, book entities.Book) (entities.Genre, error) {
+	if book.GenreID == 0 {
+		if book.Genre == "" {
+			return entities.Genre{}, errors.Wrap(errors.ErrInvalidInput, "genre is required")
+		}
+
+		genre, err := repo.Genre.GetByName(ctx, book.Genre)
+		if err != nil {
+			if stderrors.Is(err, errors.ErrNotFound) {
+				return entities.Genre{}, &GenreNotFoundError{Genre: book.Genre}
+			}
+
+			return entities.Genre{}, errors.Wrap(err, "get genre by name")
+		}
+
+		return genre, nil
+	}
+
+	genres, err := repo.Genre.GetByIDs(ctx, []int64{book.GenreID})
+	if err != nil {
+		if stderrors.Is(err, errors.ErrNotFound) {
+			return entities.Genre{}, &GenreNotFoundError{Genre: strconv.FormatInt(book.GenreID, 10)}
+		}
+
+		return entities.Genre{}, errors.Wrap(err, "get genre")
+	}
+
+	return genres[0], nil
+}
@@ -2,6 +2,8 @@ package book
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/mathbdw/book/internal/domain/entities"
 	"github.com/mathbdw/book/internal/errors"
@@ -10,21 +12,34 @@ import (
 )
 
 type GetBookUsecase struct {
-	repoBook repositories.BookRepository
-	observ   observability.UsecaseObservability
+	repoBook     repositories.BookRepository
+	repoRedirect repositories.BookRedirectRepository
+	observ       observability.UsecaseObservability
 }
 
 // NewGetBookUsecase - Constructor GetBookUsecase
-func NewGetBookUsecase(repo repositories.BookRepository, observ observability.UsecaseObservability) GetBookUsecase {
-	return GetBookUsecase{repoBook: repo, observ: observ}
+func NewGetBookUsecase(repo repositories.BookRepository, repoRedirect repositories.BookRedirectRepository, observ observability.UsecaseObservability) GetBookUsecase {
+	return GetBookUsecase{repoBook: repo, repoRedirect: repoRedirect, observ: observ}
 }
 
-// GetByIDs - Returns slice book by IDs
+// GetByIDs - Returns slice book by IDs, the IDs of the merged books return the books they were merged into
 func (uc *GetBookUsecase) GetByIDs(ctx context.Context, IDs []int64) ([]entities.Book, error) {
 	ctx, span := uc.observ.StartSpan(ctx, "GetBookUsecase")
 
 	defer span.End()
 
+	targets, err := uc.repoRedirect.GetTargets(ctx, IDs)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.bookRedirect.failed", Value: true}})
+
+		return []entities.Book{}, errors.Wrap(err, "GetBookUsecase.GetByIds: get redirects")
+	}
+
+	if len(targets) > 0 {
+		span.SetAttributes([]observability.Attribute{{Key: "book.redirected", Value: true}})
+		IDs = redirectIDs(IDs, targets)
+	}
+
 	books, err := uc.repoBook.GetByIDs(ctx, IDs)
 	if err != nil {
 		span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
@@ -34,3 +49,51 @@ func (uc *GetBookUsecase) GetByIDs(ctx context.Context, IDs []int64) ([]entities
 
 	return books, nil
 }
+
+// GetByIDsAsOf - Returns slice book by IDs in the state they had at asOf
+func (uc *GetBookUsecase) GetByIDsAsOf(ctx context.Context, IDs []int64, asOf time.Time) ([]entities.Book, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "GetBookUsecase.GetByIDsAsOf")
+
+	defer span.End()
+
+	books, err := uc.repoBook.GetByIDsAsOf(ctx, IDs, asOf)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+		return []entities.Book{}, errors.Wrap(err, "GetBookUsecase.GetByIDsAsOf: get books")
+	}
+
+	return books, nil
+}
+
+// GetByISBN - Returns the book by ISBN-13
+func (uc *GetBookUsecase) GetByISBN(ctx context.Context, isbn string) (entities.Book, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "GetBookUsecase.GetByISBN")
+
+	defer span.End()
+
+	book, err := uc.repoBook.GetByISBN(ctx, isbn)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+		return entities.Book{}, errors.Wrap(err, "GetBookUsecase.GetByISBN: get book")
+	}
+
+	return book, nil
+}
+
+// redirectIDs - replaces the redirected IDs by their targets, every ID is kept once in the first place it appears
+func redirectIDs(IDs []int64, targets map[int64]int64) []int64 {
+	redirected := make([]int64, 0, len(IDs))
+	for _, id := range IDs {
+		if target, ok := targets[id]; ok {
+			id = target
+		}
+
+		if !slices.Contains(redirected, id) {
+			redirected = append(redirected, id)
+		}
+	}
+
+	return redirected
+}
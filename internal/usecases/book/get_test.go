@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
@@ -18,15 +19,19 @@ func TestBook_GetByIDs_ErrorNoRows(t *testing.T) {
 	defer ctrl.Finish()
 
 	bookMock := mocks.NewMockBookRepository(ctrl)
+	redirectMock := mocks.NewMockBookRedirectRepository(ctrl)
 	//observUsecase - add_book_test.go
 	observUsecase := createMockUsecaseObservability(ctrl)
 	ctx := context.Background()
 
+	redirectMock.EXPECT().
+		GetTargets(gomock.Any(), []int64{1, 2}).
+		Return(map[int64]int64{}, nil)
 	bookMock.EXPECT().
 		GetByIDs(gomock.Any(), []int64{1, 2}).
 		Return([]entities.Book{}, errs.New("not found"))
 
-	us := NewGetBookUsecase(bookMock, observUsecase)
+	us := NewGetBookUsecase(bookMock, redirectMock, observUsecase)
 	books, err := us.GetByIDs(ctx, []int64{1, 2})
 
 	assert.Empty(t, books)
@@ -40,10 +45,14 @@ func TestBook_GetByIDs_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	bookMock := mocks.NewMockBookRepository(ctrl)
+	redirectMock := mocks.NewMockBookRedirectRepository(ctrl)
 	//observUsecase - add_book_test.go
 	observUsecase := createMockUsecaseObservability(ctrl)
 	ctx := context.Background()
 
+	redirectMock.EXPECT().
+		GetTargets(gomock.Any(), []int64{1, 2}).
+		Return(map[int64]int64{}, nil)
 	bookMock.EXPECT().
 		GetByIDs(gomock.Any(), []int64{1, 2}).
 		Return([]entities.Book{
@@ -51,9 +60,148 @@ func TestBook_GetByIDs_Success(t *testing.T) {
 			{ID: 2, Title: "Title 2", Description: "Desc 2", Year: 2000, Genre: "Genre"},
 		}, nil)
 
-	us := NewGetBookUsecase(bookMock, observUsecase)
+	us := NewGetBookUsecase(bookMock, redirectMock, observUsecase)
 	books, err := us.GetByIDs(ctx, []int64{1, 2})
 
 	assert.NoError(t, err)
 	assert.Equal(t, len(books), 2)
 }
+
+func TestBook_GetByIDs_SuccessRedirect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	redirectMock := mocks.NewMockBookRedirectRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	redirectMock.EXPECT().
+		GetTargets(gomock.Any(), []int64{3, 1, 2}).
+		Return(map[int64]int64{3: 1}, nil)
+	bookMock.EXPECT().
+		GetByIDs(gomock.Any(), []int64{1, 2}).
+		Return([]entities.Book{
+			{ID: 1, Title: "Title", Description: "Desc", Year: 1900, Genre: "Genre"},
+			{ID: 2, Title: "Title 2", Description: "Desc 2", Year: 2000, Genre: "Genre"},
+		}, nil)
+
+	us := NewGetBookUsecase(bookMock, redirectMock, observUsecase)
+	books, err := us.GetByIDs(ctx, []int64{3, 1, 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(books), 2)
+}
+
+func TestBook_GetByIDs_ErrorRedirect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	redirectMock := mocks.NewMockBookRedirectRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	redirectMock.EXPECT().
+		GetTargets(gomock.Any(), []int64{1, 2}).
+		Return(nil, errs.New("db error"))
+
+	us := NewGetBookUsecase(bookMock, redirectMock, observUsecase)
+	books, err := us.GetByIDs(ctx, []int64{1, 2})
+
+	assert.Empty(t, books)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "GetBookUsecase.GetByIds: get redirects")
+}
+
+func TestRedirectIDs(t *testing.T) {
+	assert.Equal(t, []int64{1, 2}, redirectIDs([]int64{1, 2}, map[int64]int64{}))
+	assert.Equal(t, []int64{5, 2}, redirectIDs([]int64{1, 2, 3}, map[int64]int64{1: 5, 3: 5}))
+	assert.Equal(t, []int64{2, 4}, redirectIDs([]int64{2, 3}, map[int64]int64{3: 4}))
+}
+
+func TestBook_GetByIDsAsOf_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	asOf := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	bookMock.EXPECT().
+		GetByIDsAsOf(gomock.Any(), []int64{1}, asOf).
+		Return([]entities.Book{{ID: 1, Title: "Old Title", Version: 2}}, nil)
+
+	us := NewGetBookUsecase(bookMock, nil, observUsecase)
+	books, err := us.GetByIDsAsOf(ctx, []int64{1}, asOf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Old Title", books[0].Title)
+}
+
+func TestBook_GetByIDsAsOf_ErrorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	asOf := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	bookMock.EXPECT().
+		GetByIDsAsOf(gomock.Any(), []int64{1}, asOf).
+		Return([]entities.Book{}, errs.ErrNotFound)
+
+	us := NewGetBookUsecase(bookMock, nil, observUsecase)
+	books, err := us.GetByIDsAsOf(ctx, []int64{1}, asOf)
+
+	assert.Empty(t, books)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Contains(t, err.Error(), "GetBookUsecase.GetByIDsAsOf: get books")
+}
+
+func TestBook_GetByISBN_ErrorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	bookMock.EXPECT().
+		GetByISBN(gomock.Any(), "9780306406157").
+		Return(entities.Book{}, errs.Wrap(errs.ErrNotFound, "book"))
+
+	us := NewGetBookUsecase(bookMock, nil, observUsecase)
+	book, err := us.GetByISBN(ctx, "9780306406157")
+
+	assert.Empty(t, book)
+	assert.Contains(t, err.Error(), "GetBookUsecase.GetByISBN: get book")
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestBook_GetByISBN_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	bookMock.EXPECT().
+		GetByISBN(gomock.Any(), "9780306406157").
+		Return(entities.Book{ID: 1, Title: "Title", ISBN: "9780306406157"}, nil)
+
+	us := NewGetBookUsecase(bookMock, nil, observUsecase)
+	book, err := us.GetByISBN(ctx, "9780306406157")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), book.ID)
+}
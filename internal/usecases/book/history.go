@@ -0,0 +1,55 @@
+package book
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+// actorKey - context key of the caller identity written to the book history
+type actorKey struct{}
+
+// WithActor - returns the context carrying the caller of the writes, empty actor is ignored
+func WithActor(ctx context.Context, actor string) context.Context {
+	if actor == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// actorFromContext - returns the caller set by WithActor, empty when unknown
+func actorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+
+	return actor
+}
+
+type HistoryBookUsecase struct {
+	repoHistory repositories.BookHistoryRepository
+	observ      observability.UsecaseObservability
+}
+
+// NewHistoryBookUsecase - Constructor HistoryBookUsecase
+func NewHistoryBookUsecase(repo repositories.BookHistoryRepository, observ observability.UsecaseObservability) HistoryBookUsecase {
+	return HistoryBookUsecase{repoHistory: repo, observ: observ}
+}
+
+// Execute - Returns the versions of the book from the oldest one
+func (uc *HistoryBookUsecase) Execute(ctx context.Context, bookID int64) ([]entities.BookHistory, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "HistoryBookUsecase")
+
+	defer span.End()
+
+	history, err := uc.repoHistory.GetByBookID(ctx, bookID)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.bookHistory.failed", Value: true}})
+
+		return nil, errors.Wrap(err, "HistoryBookUsecase.Execute: get history")
+	}
+
+	return history, nil
+}
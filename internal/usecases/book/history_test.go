@@ -0,0 +1,62 @@
+package book
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestBook_History_ErrorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	historyMock := mocks.NewMockBookHistoryRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	historyMock.EXPECT().
+		GetByBookID(gomock.Any(), int64(5)).
+		Return(nil, errs.Wrap(errs.ErrNotFound, "book 5"))
+
+	us := NewHistoryBookUsecase(historyMock, observUsecase)
+	history, err := us.Execute(ctx, 5)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Nil(t, history)
+}
+
+func TestBook_History_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	historyMock := mocks.NewMockBookHistoryRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	expected := []entities.BookHistory{{Book: entities.Book{ID: 5, Version: 1}, Type: entities.Created}}
+
+	historyMock.EXPECT().
+		GetByBookID(gomock.Any(), int64(5)).
+		Return(expected, nil)
+
+	us := NewHistoryBookUsecase(historyMock, observUsecase)
+	history, err := us.Execute(ctx, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, history)
+}
+
+func TestBook_WithActor(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, "", actorFromContext(ctx))
+	assert.Equal(t, ctx, WithActor(ctx, ""))
+	assert.Equal(t, "telegram:42", actorFromContext(WithActor(ctx, "telegram:42")))
+}
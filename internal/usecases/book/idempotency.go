@@ -0,0 +1,37 @@
+package book
+
+import (
+	"context"
+	"time"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+// reserveIdempotencyKey - reserves the key in the transaction for ttl.
+// Returns the stored key when the request has already been done, nil when it must run now
+func reserveIdempotencyKey(ctx context.Context, repo *repositories.Repository, key entities.IdempotencyKey, ttl time.Duration) (*entities.IdempotencyKey, error) {
+	now := time.Now().UTC()
+	key.ExpiresAt = now.Add(ttl)
+
+	reserved, err := repo.Idempotency.Reserve(ctx, key, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "reserve idempotency key")
+	}
+
+	if reserved {
+		return nil, nil
+	}
+
+	stored, err := repo.Idempotency.Get(ctx, key.Operation, key.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "get idempotency key")
+	}
+
+	if stored.RequestHash != key.RequestHash {
+		return nil, errors.Wrap(errors.ErrConflict, "idempotency key is used by another request")
+	}
+
+	return &stored, nil
+}
@@ -25,6 +25,18 @@ func (uc *ListBookUsecase) Execute(ctx context.Context, params entities.Paginati
 
 	defer span.End()
 
+	if params.Cursor != nil && !params.Cursor.MatchesFilter(params.Filter) {
+		span.SetAttributes([]observability.Attribute{{Key: "cursor.filter.mismatch", Value: true}})
+
+		return nil, errors.Wrap(errors.ErrInvalidInput, "listBookUsecase.Execute: cursor does not match the filter")
+	}
+
+	if params.Cursor != nil && !params.Cursor.MatchesSort(params.SortFields()) {
+		span.SetAttributes([]observability.Attribute{{Key: "cursor.sort.mismatch", Value: true}})
+
+		return nil, errors.Wrap(errors.ErrInvalidInput, "listBookUsecase.Execute: cursor does not match the sort")
+	}
+
 	resp, err := uc.repoBook.List(ctx, params)
 	if err != nil {
 		span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
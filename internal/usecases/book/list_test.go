@@ -54,3 +54,100 @@ func TestBook_List_Success(t *testing.T) {
 	assert.Equal(t, len(responseBooks.Data), 1)
 	assert.NoError(t, err)
 }
+
+var idSort = []entities.SortField{{Field: entities.CursorTypeBookID, Order: entities.SortOrderTypeAsc}}
+
+func TestBook_List_ErrorCursorFilterMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	us := NewListBookUsecase(bookMock, observUsecase)
+	responseBooks, err := us.Execute(ctx, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Sort: idSort, Keys: []any{"1"}, ID: 1, Filter: entities.BookFilter{GenreID: 2}.Key()},
+		SortBy:    entities.CursorTypeBookID,
+		SortOrder: entities.SortOrderTypeAsc,
+		Filter:    entities.BookFilter{GenreID: 3},
+	})
+
+	assert.Nil(t, responseBooks)
+	assert.True(t, errors.Is(err, errs.ErrInvalidInput))
+	assert.Contains(t, err.Error(), "cursor does not match the filter")
+}
+
+func TestBook_List_SuccessCursorFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	filter := entities.BookFilter{GenreID: 2, YearFrom: 1900}
+	params := entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Sort: idSort, Keys: []any{"1"}, ID: 1, Filter: filter.Key()},
+		SortBy:    entities.CursorTypeBookID,
+		SortOrder: entities.SortOrderTypeAsc,
+		Filter:    filter,
+	}
+
+	bookMock.EXPECT().
+		List(gomock.Any(), params).
+		Return(&entities.ResponseBooks{Data: []entities.Book{{ID: 2, Title: "Test"}}}, nil)
+
+	us := NewListBookUsecase(bookMock, observUsecase)
+	responseBooks, err := us.Execute(ctx, params)
+
+	assert.NoError(t, err)
+	assert.Len(t, responseBooks.Data, 1)
+}
+
+func TestBook_List_SuccessCursorV1DefaultFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	params := entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion1, Keys: []any{"1"}},
+		SortBy:    entities.CursorTypeBookID,
+		SortOrder: entities.SortOrderTypeAsc,
+	}
+
+	bookMock.EXPECT().
+		List(gomock.Any(), params).
+		Return(&entities.ResponseBooks{Data: []entities.Book{{ID: 2, Title: "Test"}}}, nil)
+
+	us := NewListBookUsecase(bookMock, observUsecase)
+	responseBooks, err := us.Execute(ctx, params)
+
+	assert.NoError(t, err)
+	assert.Len(t, responseBooks.Data, 1)
+}
+
+func TestBook_List_ErrorCursorSortMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	us := NewListBookUsecase(bookMock, observUsecase)
+	responseBooks, err := us.Execute(ctx, entities.PaginationParams{
+		Cursor:    &entities.Cursor{Version: entities.CursorVersion2, Sort: idSort, Keys: []any{"1"}, ID: 1, Filter: entities.BookFilter{}.Key()},
+		SortBy:    entities.CursorTypeBookTitle,
+		SortOrder: entities.SortOrderTypeAsc,
+	})
+
+	assert.Nil(t, responseBooks)
+	assert.True(t, errors.Is(err, errs.ErrInvalidInput))
+	assert.Contains(t, err.Error(), "cursor does not match the sort")
+}
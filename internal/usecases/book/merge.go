@@ -0,0 +1,135 @@
+package book
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type MergeBookUsecase struct {
+	repoUOW repositories.UnitOfWork
+	observ  observability.UsecaseObservability
+}
+
+// NewMergeBookUsecase - Constructor MergeBookUsecase
+func NewMergeBookUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) MergeBookUsecase {
+	return MergeBookUsecase{repoUOW: uow, observ: observ}
+}
+
+// Execute - Merges the source books into the target: removes the sources, fills the empty fields of the target
+// from the sources in their order and redirects the IDs of the sources to the target.
+// Creates the Merged book_event of the target listing the sources, the sources and the target are appended to book_history.
+// Returns the target.
+func (uc *MergeBookUsecase) Execute(ctx context.Context, sourceIDs []int64, targetID int64) (entities.Book, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "MergeBookUsecase")
+	defer span.End()
+
+	span.SetAttributes([]observability.Attribute{
+		{Key: "book.id", Value: targetID},
+		{Key: "book.sourceIDs", Value: sourceIDs},
+	})
+
+	if slices.Contains(sourceIDs, targetID) {
+		return entities.Book{}, errors.Wrap(errors.ErrInvalidInput, fmt.Sprintf("MergeBookUsecase.Execute: book %d is merged into itself", targetID))
+	}
+
+	var merged entities.Book
+	err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
+		books, err := repo.Book.GetByIDs(ctx, append([]int64{targetID}, sourceIDs...))
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+			return errors.Wrap(err, "MergeBookUsecase.Execute: get books")
+		}
+
+		byID := make(map[int64]entities.Book, len(books))
+		for _, book := range books {
+			byID[book.ID] = book
+		}
+
+		target, ok := byID[targetID]
+		if !ok {
+			return errors.Wrap(errors.ErrNotFound, fmt.Sprintf("MergeBookUsecase.Execute: book %d", targetID))
+		}
+
+		sources := make([]entities.Book, 0, len(sourceIDs))
+		for _, id := range sourceIDs {
+			source, ok := byID[id]
+			if !ok {
+				return errors.Wrap(errors.ErrNotFound, fmt.Sprintf("MergeBookUsecase.Execute: book %d", id))
+			}
+			sources = append(sources, source)
+		}
+
+		// the sources are removed first, so the ISBN taken from them stays unique among the books
+		err = repo.Book.Remove(ctx, sourceIDs, 0)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+			return errors.Wrap(err, "MergeBookUsecase.Execute: remove sources")
+		}
+
+		filled, changed := target.FillMissing(sources)
+		// the target is written even when nothing is filled, its new version records the merge
+		merged, err = repo.Book.Update(ctx, filled, changed)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+			return errors.Wrap(err, "MergeBookUsecase.Execute: update target")
+		}
+
+		merged.Genre = target.Genre
+		merged.Authors = target.Authors
+		if slices.Contains(changed, entities.BookFieldAuthors) {
+			merged.Authors, err = linkAuthors(ctx, repo, targetID, filled.Authors)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.author.failed", Value: true}})
+
+				return errors.Wrap(err, "MergeBookUsecase.Execute: link authors")
+			}
+		}
+
+		err = repo.BookRedirect.Add(ctx, sourceIDs, targetID)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookRedirect.failed", Value: true}})
+
+			return errors.Wrap(err, "MergeBookUsecase.Execute: add redirects")
+		}
+
+		strBook, err := json.Marshal(entities.BookMerged{Book: merged, ChangedFields: changed, SourceIDs: sourceIDs})
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes([]observability.Attribute{{Key: "json.marshal.failed", Value: true}})
+
+			return errors.Wrap(err, "MergeBookUsecase.Execute: json marshal book")
+		}
+
+		event := entities.BookEvent{BookId: targetID, Type: entities.Merged, Status: entities.EventStatusNew, Payload: strBook}
+		event.ID, err = repo.BookEvent.Create(ctx, event)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookEvent.failed", Value: true}})
+
+			return errors.Wrap(err, "MergeBookUsecase.Execute: create book event")
+		}
+
+		err = repo.BookHistory.Record(ctx, append([]int64{targetID}, sourceIDs...), entities.Merged, actorFromContext(ctx))
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookHistory.failed", Value: true}})
+
+			return errors.Wrap(err, "MergeBookUsecase.Execute: record book history")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entities.Book{}, err
+	}
+
+	return merged, nil
+}
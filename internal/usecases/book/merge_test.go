@@ -0,0 +1,211 @@
+package book
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestBook_Merge_ErrorTargetInSources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewMergeBookUsecase(uowMock, observUsecase)
+
+	merged, err := us.Execute(context.Background(), []int64{2, 1}, 1)
+
+	assert.Empty(t, merged)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, errs.ErrInvalidInput))
+}
+
+func TestBook_Merge_ErrorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewMergeBookUsecase(uowMock, observUsecase)
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1, 2, 3}).
+				Return([]entities.Book{{ID: 1, Title: "Test"}, {ID: 2, Title: "Test"}}, nil)
+
+			return fn(&repositories.Repository{Book: bookMock})
+		})
+
+	merged, err := us.Execute(ctx, []int64{2, 3}, 1)
+
+	assert.Empty(t, merged)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+	assert.Contains(t, err.Error(), "book 3")
+}
+
+func TestBook_Merge_ErrorRemove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewMergeBookUsecase(uowMock, observUsecase)
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1, 2}).
+				Return([]entities.Book{{ID: 1, Title: "Test"}, {ID: 2, Title: "Test"}}, nil)
+
+			bookMock.EXPECT().
+				Remove(ctx, []int64{2}, int64(0)).
+				Return(errs.New("db error"))
+
+			return fn(&repositories.Repository{Book: bookMock})
+		})
+
+	merged, err := us.Execute(ctx, []int64{2}, 1)
+
+	assert.Empty(t, merged)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "MergeBookUsecase.Execute: remove sources")
+}
+
+func TestBook_Merge_ErrorRedirect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookRedirectMock := mocks.NewMockBookRedirectRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewMergeBookUsecase(uowMock, observUsecase)
+	target := entities.Book{ID: 1, Title: "Test", Year: 2019, Version: 3}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1, 2}).
+				Return([]entities.Book{target, {ID: 2, Title: "Test"}}, nil)
+
+			bookMock.EXPECT().
+				Remove(ctx, []int64{2}, int64(0)).
+				Return(nil)
+
+			bookMock.EXPECT().
+				Update(ctx, target, []entities.BookField{}).
+				Return(entities.Book{ID: 1, Title: "Test", Year: 2019, Version: 4}, nil)
+
+			bookRedirectMock.EXPECT().
+				Add(ctx, []int64{2}, int64(1)).
+				Return(errs.New("db error"))
+
+			return fn(&repositories.Repository{Book: bookMock, BookRedirect: bookRedirectMock})
+		})
+
+	merged, err := us.Execute(ctx, []int64{2}, 1)
+
+	assert.Empty(t, merged)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "MergeBookUsecase.Execute: add redirects")
+}
+
+func TestBook_Merge_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	bookRedirectMock := mocks.NewMockBookRedirectRepository(ctrl)
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewMergeBookUsecase(uowMock, observUsecase)
+	target := entities.Book{ID: 1, Title: "Test", Genre: "Test Genre", Year: 2019, Version: 3}
+	sources := []entities.Book{
+		{ID: 2, Title: "Test", Description: "Test Desc", Year: 2020},
+		{ID: 3, Title: "Test", ISBN: "9780306406157", Authors: []entities.Author{{ID: 7}}},
+	}
+	filled := entities.Book{ID: 1, Title: "Test", Genre: "Test Genre", Description: "Test Desc", Year: 2019, ISBN: "9780306406157", Authors: []entities.Author{{ID: 7}}, Version: 3}
+	fields := []entities.BookField{entities.BookFieldDescription, entities.BookFieldISBN, entities.BookFieldAuthors}
+	expected := entities.Book{ID: 1, Title: "Test", Genre: "Test Genre", Description: "Test Desc", Year: 2019, ISBN: "9780306406157", Authors: []entities.Author{{ID: 7, Name: "First"}}, Version: 4}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1, 2, 3}).
+				Return(append([]entities.Book{target}, sources...), nil)
+
+			bookMock.EXPECT().
+				Remove(ctx, []int64{2, 3}, int64(0)).
+				Return(nil)
+
+			bookMock.EXPECT().
+				Update(ctx, filled, fields).
+				Return(entities.Book{ID: 1, Title: "Test", Description: "Test Desc", Year: 2019, ISBN: "9780306406157", Version: 4}, nil)
+
+			authorMock.EXPECT().
+				GetByIDs(ctx, []int64{7}).
+				Return([]entities.Author{{ID: 7, Name: "First"}}, nil)
+
+			authorMock.EXPECT().
+				SetBookAuthors(ctx, int64(1), []int64{7}).
+				Return(nil)
+
+			bookRedirectMock.EXPECT().
+				Add(ctx, []int64{2, 3}, int64(1)).
+				Return(nil)
+
+			payload, _ := json.Marshal(entities.BookMerged{Book: expected, ChangedFields: fields, SourceIDs: []int64{2, 3}})
+			bookEventMock.EXPECT().
+				Create(ctx, entities.BookEvent{BookId: 1, Type: entities.Merged, Status: entities.EventStatusNew, Payload: payload}).
+				Return(int64(1), nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1, 2, 3}, entities.Merged, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:         bookMock,
+				BookEvent:    bookEventMock,
+				BookHistory:  bookHistoryMock,
+				BookRedirect: bookRedirectMock,
+				Author:       authorMock,
+			}
+
+			return fn(repo)
+		})
+
+	merged, err := us.Execute(ctx, []int64{2, 3}, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, merged)
+}
@@ -30,3 +30,45 @@ func WithRemoveBookUsecase(uc RemoveBookUsecase) BookOptions {
 		b.Remove = uc
 	}
 }
+
+// WithUpdateBookUsecase - Set usecase update_book
+func WithUpdateBookUsecase(uc UpdateBookUsecase) BookOptions {
+	return func(b *BookUsecases) {
+		b.Update = uc
+	}
+}
+
+// WithRestoreBookUsecase - Set usecase restore_book
+func WithRestoreBookUsecase(uc RestoreBookUsecase) BookOptions {
+	return func(b *BookUsecases) {
+		b.Restore = uc
+	}
+}
+
+// WithBatchAddBookUsecase - Set usecase batch_add_book
+func WithBatchAddBookUsecase(uc BatchAddBookUsecase) BookOptions {
+	return func(b *BookUsecases) {
+		b.BatchAdd = uc
+	}
+}
+
+// WithSearchBookUsecase - Set usecase search_book
+func WithSearchBookUsecase(uc SearchBookUsecase) BookOptions {
+	return func(b *BookUsecases) {
+		b.Search = uc
+	}
+}
+
+// WithHistoryBookUsecase - Set usecase history_book
+func WithHistoryBookUsecase(uc HistoryBookUsecase) BookOptions {
+	return func(b *BookUsecases) {
+		b.History = uc
+	}
+}
+
+// WithMergeBookUsecase - Set usecase merge_book
+func WithMergeBookUsecase(uc MergeBookUsecase) BookOptions {
+	return func(b *BookUsecases) {
+		b.Merge = uc
+	}
+}
@@ -2,6 +2,7 @@ package book
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mathbdw/book/mocks"
 	"github.com/stretchr/testify/assert"
@@ -12,7 +13,7 @@ func TestWithAddBookUsecase(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockUoWRepo := mocks.NewMockUnitOfWork(ctrl)
 	observUsecase := createMockUsecaseObservability(ctrl)
-	addUC := NewAddBookUsecase(mockUoWRepo, observUsecase)
+	addUC := NewAddBookUsecase(mockUoWRepo, observUsecase, time.Hour)
 
 	uc := &BookUsecases{}
 	opt := WithAddBookUsecase(addUC)
@@ -25,7 +26,7 @@ func TestWithGetBookUsecase(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockBookRepo := mocks.NewMockBookRepository(ctrl)
 	observUsecase := createMockUsecaseObservability(ctrl)
-	getUC := NewGetBookUsecase(mockBookRepo, observUsecase)
+	getUC := NewGetBookUsecase(mockBookRepo, nil, observUsecase)
 
 	uc := &BookUsecases{}
 	opt := WithGetBookUsecase(getUC)
@@ -51,7 +52,7 @@ func TestWithRemoveBookUsecase(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockUoWRepo := mocks.NewMockUnitOfWork(ctrl)
 	observUsecase := createMockUsecaseObservability(ctrl)
-	removeUC := NewRemoveBookUsecase(mockUoWRepo, observUsecase)
+	removeUC := NewRemoveBookUsecase(mockUoWRepo, observUsecase, time.Hour)
 
 	uc := &BookUsecases{}
 	opt := WithRemoveBookUsecase(removeUC)
@@ -59,3 +60,68 @@ func TestWithRemoveBookUsecase(t *testing.T) {
 
 	assert.Equal(t, removeUC, uc.Remove)
 }
+
+func TestWithUpdateBookUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUoWRepo := mocks.NewMockUnitOfWork(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	updateUC := NewUpdateBookUsecase(mockUoWRepo, observUsecase)
+	uc := &BookUsecases{}
+
+	opt := WithUpdateBookUsecase(updateUC)
+	opt(uc)
+
+	assert.Equal(t, updateUC, uc.Update)
+}
+
+func TestWithRestoreBookUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUoWRepo := mocks.NewMockUnitOfWork(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	restoreUC := NewRestoreBookUsecase(mockUoWRepo, observUsecase)
+	uc := &BookUsecases{}
+
+	opt := WithRestoreBookUsecase(restoreUC)
+	opt(uc)
+
+	assert.Equal(t, restoreUC, uc.Restore)
+}
+
+func TestWithBatchAddBookUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUoWRepo := mocks.NewMockUnitOfWork(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	batchAddUC := NewBatchAddBookUsecase(mockUoWRepo, observUsecase)
+	uc := &BookUsecases{}
+
+	opt := WithBatchAddBookUsecase(batchAddUC)
+	opt(uc)
+
+	assert.Equal(t, batchAddUC, uc.BatchAdd)
+}
+
+func TestWithSearchBookUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockBookRepo := mocks.NewMockBookRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	searchUC := NewSearchBookUsecase(mockBookRepo, observUsecase)
+	uc := &BookUsecases{}
+
+	opt := WithSearchBookUsecase(searchUC)
+	opt(uc)
+
+	assert.Equal(t, searchUC, uc.Search)
+}
+
+func TestWithMergeBookUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUoWRepo := mocks.NewMockUnitOfWork(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	mergeUC := NewMergeBookUsecase(mockUoWRepo, observUsecase)
+	uc := &BookUsecases{}
+
+	opt := WithMergeBookUsecase(mergeUC)
+	opt(uc)
+
+	assert.Equal(t, mergeUC, uc.Merge)
+}
@@ -0,0 +1,121 @@
+package book
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type PurgeBookUsecase struct {
+	repoUOW repositories.UnitOfWork
+	observ  observability.UsecaseObservability
+}
+
+// NewPurgeBookUsecase - Constructor PurgeBookUsecase
+func NewPurgeBookUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) PurgeBookUsecase {
+	return PurgeBookUsecase{repoUOW: uow, observ: observ}
+}
+
+// Execute - Deletes books removed longer than retention ago in batches, appends them to book_history as purged
+// and creates rows book_event.
+// In dry-run mode nothing is deleted, returns count of books which would be purged.
+// The batch size is required out of dry-run mode.
+func (uc *PurgeBookUsecase) Execute(ctx context.Context, retention time.Duration, batchSize uint64, dryRun bool) (int64, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "PurgeBookUsecase")
+	defer span.End()
+
+	removedBefore := time.Now().UTC().Add(-retention)
+	span.SetAttributes([]observability.Attribute{{Key: "purge.dryRun", Value: dryRun}})
+
+	if dryRun {
+		var count int64
+		err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
+			var err error
+			count, err = repo.Book.CountPurgeable(ctx, removedBefore)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+				return errors.Wrap(err, "PurgeBookUsecase.Execute: count Book")
+			}
+
+			return nil
+		})
+
+		return count, err
+	}
+
+	if batchSize == 0 {
+		return 0, errors.Wrap(errors.ErrInvalidInput, "PurgeBookUsecase.Execute: batch size is zero")
+	}
+
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, errors.Wrap(err, "PurgeBookUsecase.Execute: context")
+		}
+
+		var IDs []int64
+		err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
+			var err error
+			IDs, err = repo.Book.LockPurgeable(ctx, removedBefore, batchSize)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+				return errors.Wrap(err, "PurgeBookUsecase.Execute: lock Book")
+			}
+
+			if len(IDs) == 0 {
+				return nil
+			}
+
+			// the history keeps the purge, it is recorded from the books before they are deleted
+			err = repo.BookHistory.Record(ctx, IDs, entities.Purged, actorFromContext(ctx))
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.bookHistory.failed", Value: true}})
+
+				return errors.Wrap(err, "PurgeBookUsecase.Execute: record book history")
+			}
+
+			err = repo.Book.Purge(ctx, IDs)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+				return errors.Wrap(err, "PurgeBookUsecase.Execute: purge Book")
+			}
+
+			for _, id := range IDs {
+				payload, err := json.Marshal(entities.BookPurged{ID: id})
+				if err != nil {
+					span.RecordError(err)
+					span.SetAttributes([]observability.Attribute{{Key: "json.marshal.failed", Value: true}})
+
+					return errors.Wrap(err, "PurgeBookUsecase.Execute: json marshal book")
+				}
+
+				event := entities.BookEvent{BookId: id, Type: entities.Purged, Status: entities.EventStatusNew, Payload: payload}
+				event.ID, err = repo.BookEvent.Create(ctx, event)
+				if err != nil {
+					span.SetAttributes([]observability.Attribute{{Key: "repo.bookEvent.failed", Value: true}})
+
+					return errors.Wrap(err, fmt.Sprintf("PurgeBookUsecase.Execute: create Book Event = %d", id))
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += int64(len(IDs))
+		if uint64(len(IDs)) < batchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package book
+
+import (
+	"context"
+	"time"
+
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type PurgeIdempotencyUsecase struct {
+	repoUOW repositories.UnitOfWork
+	observ  observability.UsecaseObservability
+}
+
+// NewPurgeIdempotencyUsecase - Constructor PurgeIdempotencyUsecase
+func NewPurgeIdempotencyUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) PurgeIdempotencyUsecase {
+	return PurgeIdempotencyUsecase{repoUOW: uow, observ: observ}
+}
+
+// Execute - Deletes the expired idempotency keys in batches, returns count of deleted keys.
+func (uc *PurgeIdempotencyUsecase) Execute(ctx context.Context, batchSize uint64) (int64, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "PurgeIdempotencyUsecase")
+	defer span.End()
+
+	now := time.Now().UTC()
+
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, errors.Wrap(err, "PurgeIdempotencyUsecase.Execute: context")
+		}
+
+		var count int64
+		err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
+			var err error
+			count, err = repo.Idempotency.DeleteExpired(ctx, now, batchSize)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.idempotency.failed", Value: true}})
+
+				return errors.Wrap(err, "PurgeIdempotencyUsecase.Execute: delete expired keys")
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += count
+		if uint64(count) < batchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package book
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestBook_Purge_DryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				CountPurgeable(ctx, gomock.Any()).
+				Return(int64(5), nil)
+
+			bookMock.EXPECT().
+				LockPurgeable(gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(0)
+
+			bookMock.EXPECT().
+				Purge(gomock.Any(), gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				BookEvent: bookEventMock,
+			}
+
+			return fn(repo)
+		})
+
+	us := NewPurgeBookUsecase(uowMock, observUsecase)
+	count, err := us.Execute(ctx, time.Hour, 10, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), count)
+}
+
+func TestBook_Purge_ErrorBook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				LockPurgeable(ctx, gomock.Any(), uint64(10)).
+				Return(nil, errs.ErrNotFound)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				BookEvent: bookEventMock,
+			}
+
+			return fn(repo)
+		})
+
+	us := NewPurgeBookUsecase(uowMock, observUsecase)
+	count, err := us.Execute(ctx, time.Hour, 10, false)
+
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), count)
+	assert.Contains(t, err.Error(), "PurgeBookUsecase.Execute: lock Book")
+}
+
+func TestBook_Purge_ErrorBookHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				LockPurgeable(ctx, gomock.Any(), uint64(10)).
+				Return([]int64{1}, nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Purged, "").
+				Return(errs.New("db error"))
+
+			bookMock.EXPECT().
+				Purge(gomock.Any(), gomock.Any()).
+				Times(0)
+
+			return fn(&repositories.Repository{Book: bookMock, BookHistory: bookHistoryMock})
+		})
+
+	us := NewPurgeBookUsecase(uowMock, observUsecase)
+	count, err := us.Execute(ctx, time.Hour, 10, false)
+
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), count)
+	assert.Contains(t, err.Error(), "PurgeBookUsecase.Execute: record book history")
+}
+
+func TestBook_Purge_ErrorPurge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				LockPurgeable(ctx, gomock.Any(), uint64(10)).
+				Return([]int64{1}, nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Purged, "").
+				Return(nil)
+
+			bookMock.EXPECT().
+				Purge(ctx, []int64{1}).
+				Return(errs.New("db error"))
+
+			return fn(&repositories.Repository{Book: bookMock, BookHistory: bookHistoryMock})
+		})
+
+	us := NewPurgeBookUsecase(uowMock, observUsecase)
+	count, err := us.Execute(ctx, time.Hour, 10, false)
+
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), count)
+	assert.Contains(t, err.Error(), "PurgeBookUsecase.Execute: purge Book")
+}
+
+func TestBook_Purge_ErrorBookEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				LockPurgeable(ctx, gomock.Any(), uint64(10)).
+				Return([]int64{1}, nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Purged, "").
+				Return(nil)
+
+			bookMock.EXPECT().
+				Purge(ctx, []int64{1}).
+				Return(nil)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(0), errs.ErrNotFound)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+			}
+
+			return fn(repo)
+		})
+
+	us := NewPurgeBookUsecase(uowMock, observUsecase)
+	count, err := us.Execute(ctx, time.Hour, 10, false)
+
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), count)
+	assert.Contains(t, err.Error(), "PurgeBookUsecase.Execute: create Book Event")
+}
+
+func TestBook_Purge_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	retention := 24 * time.Hour
+
+	repo := &repositories.Repository{
+		Book:        bookMock,
+		BookEvent:   bookEventMock,
+		BookHistory: bookHistoryMock,
+	}
+
+	gomock.InOrder(
+		bookMock.EXPECT().
+			LockPurgeable(ctx, gomock.Any(), uint64(2)).
+			DoAndReturn(func(_ context.Context, removedBefore time.Time, _ uint64) ([]int64, error) {
+				assert.WithinDuration(t, time.Now().UTC().Add(-retention), removedBefore, time.Minute)
+
+				return []int64{1, 2}, nil
+			}),
+		bookHistoryMock.EXPECT().
+			Record(ctx, []int64{1, 2}, entities.Purged, "").
+			Return(nil),
+		bookMock.EXPECT().
+			Purge(ctx, []int64{1, 2}).
+			Return(nil),
+		bookMock.EXPECT().
+			LockPurgeable(ctx, gomock.Any(), uint64(2)).
+			Return([]int64{3}, nil),
+		bookHistoryMock.EXPECT().
+			Record(ctx, []int64{3}, entities.Purged, "").
+			Return(nil),
+		bookMock.EXPECT().
+			Purge(ctx, []int64{3}).
+			Return(nil),
+	)
+
+	for _, id := range []int64{1, 2, 3} {
+		payload, _ := json.Marshal(entities.BookPurged{ID: id})
+		bookEvent := entities.BookEvent{BookId: id, Type: entities.Purged, Status: entities.EventStatusNew, Payload: payload}
+		bookEventMock.EXPECT().
+			Create(ctx, bookEvent).
+			Return(id, nil)
+	}
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			return fn(repo)
+		}).
+		Times(2)
+
+	us := NewPurgeBookUsecase(uowMock, observUsecase)
+	count, err := us.Execute(ctx, retention, 2, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+}
+
+func TestBook_Purge_ErrorBatchSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+
+	us := NewPurgeBookUsecase(uowMock, observUsecase)
+	count, err := us.Execute(context.Background(), time.Hour, 0, false)
+
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, errs.ErrInvalidInput))
+	assert.Equal(t, int64(0), count)
+}
+
+func TestBook_PurgeIdempotency_Batches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	idempotencyMock := mocks.NewMockIdempotencyRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	counts := []int64{10, 3}
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			idempotencyMock.EXPECT().
+				DeleteExpired(ctx, gomock.Any(), uint64(10)).
+				Return(counts[0], nil)
+			counts = counts[1:]
+
+			return fn(&repositories.Repository{Idempotency: idempotencyMock})
+		}).
+		Times(2)
+
+	us := NewPurgeIdempotencyUsecase(uowMock, observUsecase)
+	count, err := us.Execute(ctx, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(13), count)
+}
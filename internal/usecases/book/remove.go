@@ -3,6 +3,7 @@ package book
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/mathbdw/book/internal/domain/entities"
 	"github.com/mathbdw/book/internal/errors"
@@ -11,23 +12,42 @@ import (
 )
 
 type RemoveBookUsecase struct {
-	repoUOW repositories.UnitOfWork
-	observ  observability.UsecaseObservability
+	repoUOW        repositories.UnitOfWork
+	observ         observability.UsecaseObservability
+	idempotencyTTL time.Duration
 }
 
-// NewRemoveBookUsecase - Constructor RemoveBookUsecase
-func NewRemoveBookUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) RemoveBookUsecase {
-	return RemoveBookUsecase{repoUOW: uow, observ: observ}
+// NewRemoveBookUsecase - Constructor RemoveBookUsecase, idempotencyTTL - lifetime of the idempotency keys
+func NewRemoveBookUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability, idempotencyTTL time.Duration) RemoveBookUsecase {
+	return RemoveBookUsecase{repoUOW: uow, observ: observ, idempotencyTTL: idempotencyTTL}
 }
 
-// Remove - Update field removed of Book and create rows book_event.
-func (uc *RemoveBookUsecase) Execute(ctx context.Context, IDs []int64) error {
+// Remove - Update field removed of Book and create rows book_event and book_history.
+// expectedVersion > 0 removes the only book when it is at the version.
+// With the idempotency key the repeated request succeeds without removing again.
+func (uc *RemoveBookUsecase) Execute(ctx context.Context, IDs []int64, expectedVersion int64, idempotency entities.IdempotencyKey) error {
 	ctx, span := uc.observ.StartSpan(ctx, "RemoveBookUsecase")
 
 	defer span.End()
 
+	idempotency.Operation = entities.IdempotencyOperationRemoveBook
 	err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
-		err := repo.Book.Remove(ctx, IDs)
+		if !idempotency.IsEmpty() {
+			stored, err := reserveIdempotencyKey(ctx, repo, idempotency, uc.idempotencyTTL)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.idempotency.failed", Value: true}})
+
+				return errors.Wrap(err, "RemoveBookUsecase.Execute: idempotency key")
+			}
+
+			if stored != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "idempotency.replayed", Value: true}})
+
+				return nil
+			}
+		}
+
+		err := repo.Book.Remove(ctx, IDs, expectedVersion)
 		if err != nil {
 			span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
 
@@ -44,6 +64,22 @@ func (uc *RemoveBookUsecase) Execute(ctx context.Context, IDs []int64) error {
 			}
 		}
 
+		err = repo.BookHistory.Record(ctx, IDs, entities.Deleted, actorFromContext(ctx))
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookHistory.failed", Value: true}})
+
+			return errors.Wrap(err, "RemoveBookUsecase.Execute: record Book History")
+		}
+
+		if !idempotency.IsEmpty() {
+			err = repo.Idempotency.SaveResponse(ctx, idempotency.Operation, idempotency.Key, nil)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.idempotency.failed", Value: true}})
+
+				return errors.Wrap(err, "RemoveBookUsecase.Execute: save idempotency response")
+			}
+		}
+
 		return nil
 	})
 
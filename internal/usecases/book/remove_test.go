@@ -3,6 +3,7 @@ package book
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -27,7 +28,7 @@ func TestBook_Remove_ErrorBook(t *testing.T) {
 	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
 			bookMock.EXPECT().
-				Remove(ctx, gomock.Any()).
+				Remove(ctx, gomock.Any(), int64(0)).
 				Return(errs.ErrNotFound)
 
 			bookEventMock.EXPECT().
@@ -43,8 +44,8 @@ func TestBook_Remove_ErrorBook(t *testing.T) {
 			return fn(repo)
 		})
 
-	us := NewRemoveBookUsecase(uowMock, observUsecase)
-	err := us.Execute(ctx, []int64{1, 2})
+	us := NewRemoveBookUsecase(uowMock, observUsecase, time.Hour)
+	err := us.Execute(ctx, []int64{1, 2}, 0, entities.IdempotencyKey{})
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "RemoveBookUsecase.Execute: remove Book")
@@ -64,7 +65,7 @@ func TestBook_Remove_ErrorBookEvent(t *testing.T) {
 	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
 			bookMock.EXPECT().
-				Remove(ctx, gomock.Any()).
+				Remove(ctx, gomock.Any(), int64(0)).
 				Return(nil)
 
 			bookEventMock.EXPECT().
@@ -79,8 +80,8 @@ func TestBook_Remove_ErrorBookEvent(t *testing.T) {
 			return fn(repo)
 		})
 
-	us := NewRemoveBookUsecase(uowMock, observUsecase)
-	err := us.Execute(ctx, []int64{1, 2})
+	us := NewRemoveBookUsecase(uowMock, observUsecase, time.Hour)
+	err := us.Execute(ctx, []int64{1, 2}, 0, entities.IdempotencyKey{})
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "RemoveBookUsecase.Execute: create Book Event")
@@ -93,6 +94,7 @@ func TestBook_Remove_Success(t *testing.T) {
 	uowMock := mocks.NewMockUnitOfWork(ctrl)
 	bookMock := mocks.NewMockBookRepository(ctrl)
 	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
 	//observUsecase - add_book_test.go
 	observUsecase := createMockUsecaseObservability(ctrl)
 	ctx := context.Background()
@@ -101,7 +103,7 @@ func TestBook_Remove_Success(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
 			ids := []int64{1, 2}
 			bookMock.EXPECT().
-				Remove(ctx, ids).
+				Remove(ctx, ids, int64(0)).
 				Return(nil)
 
 			for _, id := range ids {
@@ -111,6 +113,46 @@ func TestBook_Remove_Success(t *testing.T) {
 					Return(int64(1), nil)
 			}
 
+			bookHistoryMock.EXPECT().
+				Record(ctx, ids, entities.Deleted, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+			}
+
+			return fn(repo)
+		})
+
+	us := NewRemoveBookUsecase(uowMock, observUsecase, time.Hour)
+	err := us.Execute(ctx, []int64{1, 2}, 0, entities.IdempotencyKey{})
+
+	assert.NoError(t, err)
+}
+
+func TestBook_Remove_ErrorVersionMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				Remove(ctx, []int64{1}, int64(3)).
+				Return(errs.Wrap(errs.ErrVersionMismatch, "bookPostgres.Remove"))
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Times(0)
+
 			repo := &repositories.Repository{
 				Book:      bookMock,
 				BookEvent: bookEventMock,
@@ -119,8 +161,47 @@ func TestBook_Remove_Success(t *testing.T) {
 			return fn(repo)
 		})
 
-	us := NewRemoveBookUsecase(uowMock, observUsecase)
-	err := us.Execute(ctx, []int64{1, 2})
+	us := NewRemoveBookUsecase(uowMock, observUsecase, time.Hour)
+	err := us.Execute(ctx, []int64{1}, 3, entities.IdempotencyKey{})
+
+	assert.ErrorIs(t, err, errs.ErrVersionMismatch)
+}
+
+func TestBook_Remove_IdempotencyReplayed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	idempotencyMock := mocks.NewMockIdempotencyRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			idempotencyMock.EXPECT().
+				Reserve(ctx, gomock.Any(), gomock.Any()).
+				Return(false, nil)
+
+			idempotencyMock.EXPECT().
+				Get(ctx, entities.IdempotencyOperationRemoveBook, "key-1").
+				Return(entities.IdempotencyKey{Key: "key-1", RequestHash: "hash"}, nil)
+
+			bookMock.EXPECT().
+				Remove(gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				Idempotency: idempotencyMock,
+			}
+
+			return fn(repo)
+		})
+
+	us := NewRemoveBookUsecase(uowMock, observUsecase, time.Hour)
+	err := us.Execute(ctx, []int64{1}, 0, entities.IdempotencyKey{Key: "key-1", RequestHash: "hash"})
 
 	assert.NoError(t, err)
 }
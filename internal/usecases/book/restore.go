@@ -0,0 +1,83 @@
+package book
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type RestoreBookUsecase struct {
+	repoUOW repositories.UnitOfWork
+	observ  observability.UsecaseObservability
+}
+
+// NewRestoreBookUsecase - Constructor RestoreBookUsecase
+func NewRestoreBookUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) RestoreBookUsecase {
+	return RestoreBookUsecase{repoUOW: uow, observ: observ}
+}
+
+// Execute - Clears field removed of Book and create rows book_event and book_history, the redirects of the merged books are dropped.
+// expectedVersion > 0 restores the only book when it is at the version.
+func (uc *RestoreBookUsecase) Execute(ctx context.Context, IDs []int64, expectedVersion int64) error {
+	ctx, span := uc.observ.StartSpan(ctx, "RestoreBookUsecase")
+	defer span.End()
+
+	err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
+		err := repo.Book.Restore(ctx, IDs, expectedVersion)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+			return errors.Wrap(err, "RestoreBookUsecase.Execute: restore Book")
+		}
+
+		// the restored merged books are served by their IDs again
+		err = repo.BookRedirect.Delete(ctx, IDs)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookRedirect.failed", Value: true}})
+
+			return errors.Wrap(err, "RestoreBookUsecase.Execute: delete redirects")
+		}
+
+		// the events carry the restored books like the Created ones
+		books, err := repo.Book.GetByIDs(ctx, IDs)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+			return errors.Wrap(err, "RestoreBookUsecase.Execute: get Books")
+		}
+
+		for _, book := range books {
+			strBook, err := json.Marshal(book)
+			if err != nil {
+				span.RecordError(err)
+				span.SetAttributes([]observability.Attribute{{Key: "json.marshal.failed", Value: true}})
+
+				return errors.Wrap(err, fmt.Sprintf("RestoreBookUsecase.Execute: json marshal Book = %d", book.ID))
+			}
+
+			event := entities.BookEvent{BookId: book.ID, Type: entities.Restored, Status: entities.EventStatusNew, Payload: strBook}
+			event.ID, err = repo.BookEvent.Create(ctx, event)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.bookEvent.failed", Value: true}})
+
+				return errors.Wrap(err, fmt.Sprintf("RestoreBookUsecase.Execute: create Book Event = %d", book.ID))
+			}
+		}
+
+		err = repo.BookHistory.Record(ctx, IDs, entities.Restored, actorFromContext(ctx))
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookHistory.failed", Value: true}})
+
+			return errors.Wrap(err, "RestoreBookUsecase.Execute: record Book History")
+		}
+
+		return nil
+	})
+
+	return err
+}
@@ -0,0 +1,231 @@
+package book
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestBook_Restore_ErrorBook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				Restore(ctx, gomock.Any(), int64(0)).
+				Return(errs.ErrNotFound)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(1), nil).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				BookEvent: bookEventMock,
+			}
+
+			return fn(repo)
+		})
+
+	us := NewRestoreBookUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1, 2}, 0)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "RestoreBookUsecase.Execute: restore Book")
+}
+
+func TestBook_Restore_ErrorBookEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookRedirectMock := mocks.NewMockBookRedirectRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				Restore(ctx, gomock.Any(), int64(0)).
+				Return(nil)
+
+			bookRedirectMock.EXPECT().
+				Delete(ctx, gomock.Any()).
+				Return(nil)
+
+			bookMock.EXPECT().
+				GetByIDs(ctx, gomock.Any()).
+				Return([]entities.Book{{ID: 1}, {ID: 2}}, nil)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(0), errs.New("error"))
+
+			repo := &repositories.Repository{
+				Book:         bookMock,
+				BookEvent:    bookEventMock,
+				BookRedirect: bookRedirectMock,
+			}
+
+			return fn(repo)
+		})
+
+	us := NewRestoreBookUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1, 2}, 0)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "RestoreBookUsecase.Execute: create Book Event")
+}
+
+func TestBook_Restore_ErrorBookRedirect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookRedirectMock := mocks.NewMockBookRedirectRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				Restore(ctx, gomock.Any(), int64(0)).
+				Return(nil)
+
+			bookRedirectMock.EXPECT().
+				Delete(ctx, gomock.Any()).
+				Return(errs.New("error"))
+
+			repo := &repositories.Repository{
+				Book:         bookMock,
+				BookRedirect: bookRedirectMock,
+			}
+
+			return fn(repo)
+		})
+
+	us := NewRestoreBookUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1, 2}, 0)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "RestoreBookUsecase.Execute: delete redirects")
+}
+
+func TestBook_Restore_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	bookRedirectMock := mocks.NewMockBookRedirectRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			ids := []int64{1, 2}
+			bookMock.EXPECT().
+				Restore(ctx, ids, int64(0)).
+				Return(nil)
+
+			bookRedirectMock.EXPECT().
+				Delete(ctx, ids).
+				Return(nil)
+
+			books := []entities.Book{{ID: 1, Title: "title 1"}, {ID: 2, Title: "title 2"}}
+			bookMock.EXPECT().
+				GetByIDs(ctx, ids).
+				Return(books, nil)
+
+			for _, book := range books {
+				payload, _ := json.Marshal(book)
+				bookEvent := entities.BookEvent{BookId: book.ID, Type: entities.Restored, Status: entities.EventStatusNew, Payload: payload}
+				bookEventMock.EXPECT().
+					Create(ctx, bookEvent).
+					Return(int64(1), nil)
+			}
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, ids, entities.Restored, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:         bookMock,
+				BookEvent:    bookEventMock,
+				BookHistory:  bookHistoryMock,
+				BookRedirect: bookRedirectMock,
+			}
+
+			return fn(repo)
+		})
+
+	us := NewRestoreBookUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1, 2}, 0)
+
+	assert.NoError(t, err)
+}
+
+func TestBook_Restore_ErrorGetBooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookRedirectMock := mocks.NewMockBookRedirectRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				Restore(ctx, gomock.Any(), int64(0)).
+				Return(nil)
+
+			bookRedirectMock.EXPECT().
+				Delete(ctx, gomock.Any()).
+				Return(nil)
+
+			bookMock.EXPECT().
+				GetByIDs(ctx, gomock.Any()).
+				Return(nil, errs.New("error"))
+
+			repo := &repositories.Repository{
+				Book:         bookMock,
+				BookRedirect: bookRedirectMock,
+			}
+
+			return fn(repo)
+		})
+
+	us := NewRestoreBookUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1, 2}, 0)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "RestoreBookUsecase.Execute: get Books")
+}
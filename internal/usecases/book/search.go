@@ -0,0 +1,42 @@
+package book
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type SearchBookUsecase struct {
+	repoBook repositories.BookRepository
+	observ   observability.UsecaseObservability
+}
+
+// NewSearchBookUsecase - Constructor SearchBookUsecase
+func NewSearchBookUsecase(repo repositories.BookRepository, observ observability.UsecaseObservability) SearchBookUsecase {
+	return SearchBookUsecase{repoBook: repo, observ: observ}
+}
+
+// Execute - Returns books matching the full-text query, the most relevant first
+func (uc *SearchBookUsecase) Execute(ctx context.Context, text string, params entities.PaginationParams) (*entities.ResponseBookSearch, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "SearchBookUsecase")
+
+	defer span.End()
+
+	if params.Cursor != nil && params.Cursor.Filter != (entities.BookFilter{Query: text}).Key() {
+		span.SetAttributes([]observability.Attribute{{Key: "cursor.query.mismatch", Value: true}})
+
+		return nil, errors.Wrap(errors.ErrInvalidInput, "searchBookUsecase.Execute: cursor does not match the query")
+	}
+
+	resp, err := uc.repoBook.Search(ctx, text, params)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+		return nil, errors.Wrap(err, "searchBookUsecase.Execute: search books")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+package book
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBook_Search_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	bookMock.EXPECT().
+		Search(gomock.Any(), "sea", gomock.Any()).
+		Return(nil, errs.New("error"))
+
+	us := NewSearchBookUsecase(bookMock, observUsecase)
+	resp, err := us.Execute(ctx, "sea", entities.PaginationParams{Limit: 2})
+
+	assert.Nil(t, resp)
+	assert.Contains(t, err.Error(), "searchBookUsecase.Execute: search books")
+}
+
+func TestBook_Search_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	bookMock.EXPECT().
+		Search(gomock.Any(), "sea", entities.PaginationParams{Limit: 2}).
+		Return(&entities.ResponseBookSearch{
+			Data: []entities.BookSearchResult{{Book: entities.Book{ID: 1, Title: "The Sea"}, Rank: 0.4, Snippet: "The <b>Sea</b>"}},
+		}, nil)
+
+	us := NewSearchBookUsecase(bookMock, observUsecase)
+	resp, err := us.Execute(ctx, "sea", entities.PaginationParams{Limit: 2})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Data, 1)
+}
+
+func TestBook_Search_ErrorCursorQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	cursor := &entities.Cursor{Version: entities.CursorVersion2, Keys: []any{"0.4"}, ID: 1, Filter: entities.BookFilter{Query: "sea"}.Key()}
+
+	us := NewSearchBookUsecase(bookMock, observUsecase)
+	resp, err := us.Execute(ctx, "ocean", entities.PaginationParams{Limit: 2, Cursor: cursor})
+
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, errs.ErrInvalidInput))
+	assert.Contains(t, err.Error(), "cursor does not match the query")
+}
@@ -0,0 +1,131 @@
+package book
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type UpdateBookUsecase struct {
+	repoUOW repositories.UnitOfWork
+	observ  observability.UsecaseObservability
+}
+
+// NewUpdateBookUsecase - Constructor UpdateBookUsecase
+func NewUpdateBookUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) UpdateBookUsecase {
+	return UpdateBookUsecase{repoUOW: uow, observ: observ}
+}
+
+// Execute - Updates the listed fields of the book and creates book_event with the new state
+// and the fields that have actually changed, the new state is appended to book_history. Nothing is written if no field changes.
+// book.Version > 0 is the expected version, the update fails with ErrVersionMismatch when the book is at another one.
+func (uc *UpdateBookUsecase) Execute(ctx context.Context, book entities.Book, fields []entities.BookField) (entities.Book, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "UpdateBookUsecase")
+	defer span.End()
+
+	span.SetAttributes([]observability.Attribute{{Key: "book.id", Value: book.ID}})
+
+	var updated entities.Book
+	err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
+		stored, err := repo.Book.GetByIDs(ctx, []int64{book.ID})
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+			return errors.Wrap(err, "updateBookUsecase.Execute: get book")
+		}
+
+		if book.Version > 0 && stored[0].Version != book.Version {
+			span.SetAttributes([]observability.Attribute{{Key: "book.version.mismatch", Value: true}})
+
+			return errors.Wrap(errors.ErrVersionMismatch, fmt.Sprintf("updateBookUsecase.Execute: book %d is at version %d, expected %d", book.ID, stored[0].Version, book.Version))
+		}
+
+		// the name of the deprecated genre field is compared as its genre_id, the stored genre needs no lookup
+		genre, genreResolved := stored[0].Genre, false
+		if book.GenreID == 0 && book.Genre != "" && slices.Contains(fields, entities.BookFieldGenre) {
+			book.GenreID = stored[0].GenreID
+			if !strings.EqualFold(book.Genre, stored[0].Genre) {
+				resolved, err := resolveGenre(ctx, repo, entities.Book{Genre: book.Genre})
+				if err != nil {
+					span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+					return errors.Wrap(err, "updateBookUsecase.Execute: resolve genre")
+				}
+				book.GenreID, genre, genreResolved = resolved.ID, resolved.Name, true
+			}
+		}
+
+		changed := stored[0].ChangedFields(book, fields)
+		if len(changed) == 0 {
+			span.SetAttributes([]observability.Attribute{{Key: "book.unchanged", Value: true}})
+			updated = stored[0]
+
+			return nil
+		}
+
+		if slices.Contains(changed, entities.BookFieldGenre) && !genreResolved {
+			resolved, err := resolveGenre(ctx, repo, entities.Book{GenreID: book.GenreID})
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+				return errors.Wrap(err, "updateBookUsecase.Execute: resolve genre")
+			}
+			genre = resolved.Name
+		}
+
+		updated, err = repo.Book.Update(ctx, book, changed)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.book.failed", Value: true}})
+
+			return errors.Wrap(err, "updateBookUsecase.Execute: update book")
+		}
+
+		updated.Genre = genre
+		updated.Authors = stored[0].Authors
+		if slices.Contains(changed, entities.BookFieldAuthors) {
+			updated.Authors, err = linkAuthors(ctx, repo, book.ID, book.Authors)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.author.failed", Value: true}})
+
+				return errors.Wrap(err, "updateBookUsecase.Execute: link authors")
+			}
+		}
+
+		strBook, err := json.Marshal(entities.BookUpdated{Book: updated, ChangedFields: changed})
+		if err != nil {
+			span.RecordError(err)
+			span.SetAttributes([]observability.Attribute{{Key: "json.marshal.failed", Value: true}})
+
+			return errors.Wrap(err, "updateBookUsecase.Execute: json marshal book")
+		}
+
+		event := entities.BookEvent{BookId: updated.ID, Type: entities.Updated, Status: entities.EventStatusNew, Payload: strBook}
+		event.ID, err = repo.BookEvent.Create(ctx, event)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookEvent.failed", Value: true}})
+
+			return errors.Wrap(err, "updateBookUsecase.Execute: create book event")
+		}
+
+		err = repo.BookHistory.Record(ctx, []int64{updated.ID}, entities.Updated, actorFromContext(ctx))
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.bookHistory.failed", Value: true}})
+
+			return errors.Wrap(err, "updateBookUsecase.Execute: record book history")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entities.Book{}, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,558 @@
+package book
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestBook_Update_ErrorGetBook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	book := entities.Book{ID: 1, Title: "Test", Description: "Test Desc", Genre: "Test Genre", Year: 2019}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{}, errs.ErrNotFound)
+
+			bookMock.EXPECT().
+				Update(ctx, gomock.Any(), gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				BookEvent: bookEventMock,
+			}
+
+			return fn(repo)
+		})
+
+	updated, err := us.Execute(ctx, book, entities.BookUpdatableFields)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "updateBookUsecase.Execute: get book")
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+	assert.Empty(t, updated)
+}
+
+func TestBook_Update_ErrorVersionMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	stored := entities.Book{ID: 1, Title: "Test", Description: "Test Desc", Year: 2019, Version: 4}
+	book := entities.Book{ID: 1, Title: "Test", Description: "Test Desc", Year: 2019, Version: 3}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{stored}, nil)
+
+			bookMock.EXPECT().
+				Update(ctx, gomock.Any(), gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				BookEvent: bookEventMock,
+			}
+
+			return fn(repo)
+		})
+
+	updated, err := us.Execute(ctx, book, entities.BookUpdatableFields)
+
+	assert.ErrorIs(t, err, errs.ErrVersionMismatch)
+	assert.Contains(t, err.Error(), "book 1 is at version 4, expected 3")
+	assert.Empty(t, updated)
+}
+
+func TestBook_Update_Unchanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	stored := entities.Book{ID: 1, Title: "Test", Description: "Test Desc", Genre: "Test Genre", Year: 2019}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{stored}, nil)
+
+			bookMock.EXPECT().
+				Update(ctx, gomock.Any(), gomock.Any()).
+				Times(0)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				BookEvent: bookEventMock,
+			}
+
+			return fn(repo)
+		})
+
+	updated, err := us.Execute(ctx, entities.Book{ID: 1, Title: "Test"}, []entities.BookField{entities.BookFieldTitle})
+
+	assert.NoError(t, err)
+	assert.Equal(t, stored, updated)
+}
+
+func TestBook_Update_ErrorRepoBook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	stored := entities.Book{ID: 1, Title: "Test", Description: "Test Desc", Genre: "Test Genre", Year: 2019}
+	book := entities.Book{ID: 1, Title: "New Test", Description: "Test Desc", Genre: "Test Genre", Year: 2019}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{stored}, nil)
+
+			bookMock.EXPECT().
+				Update(ctx, book, []entities.BookField{entities.BookFieldTitle}).
+				Return(entities.Book{}, errs.ErrNotFound)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				BookEvent: bookEventMock,
+			}
+
+			return fn(repo)
+		})
+
+	updated, err := us.Execute(ctx, book, entities.BookUpdatableFields)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "updateBookUsecase.Execute: update book")
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+	assert.Empty(t, updated)
+}
+
+func TestBook_Update_ErrorRepoBookEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	stored := entities.Book{ID: 1, Title: "Test", Description: "Test Desc", Genre: "Test Genre", Year: 2019}
+	book := entities.Book{ID: 1, Title: "New Test", Description: "Test Desc", Genre: "Test Genre", Year: 2019}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{stored}, nil)
+
+			bookMock.EXPECT().
+				Update(ctx, book, []entities.BookField{entities.BookFieldTitle}).
+				Return(book, nil)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(0), errs.New("error repoBookEvent"))
+
+			repo := &repositories.Repository{
+				Book:      bookMock,
+				BookEvent: bookEventMock,
+			}
+
+			return fn(repo)
+		})
+
+	updated, err := us.Execute(ctx, book, entities.BookUpdatableFields)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "updateBookUsecase.Execute: create book event")
+	assert.Empty(t, updated)
+}
+
+func TestBook_Update_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	stored := entities.Book{ID: 1, Title: "Test", Description: "Test Desk", Genre: "Test Genre", Year: 2019}
+	book := entities.Book{ID: 1, Description: "Test Desc"}
+	fields := []entities.BookField{entities.BookFieldDescription}
+	expected := entities.Book{ID: 1, Title: "Test", Description: "Test Desc", Genre: "Test Genre", Year: 2019}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{stored}, nil)
+
+			bookMock.EXPECT().
+				Update(ctx, book, fields).
+				Return(expected, nil)
+
+			payload, _ := json.Marshal(entities.BookUpdated{Book: expected, ChangedFields: fields})
+			bookEventMock.EXPECT().
+				Create(ctx, entities.BookEvent{BookId: 1, Type: entities.Updated, Status: entities.EventStatusNew, Payload: payload}).
+				Return(int64(1), nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Updated, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+			}
+
+			return fn(repo)
+		})
+
+	updated, err := us.Execute(ctx, book, fields)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, updated)
+}
+
+func TestBook_Update_SuccessAuthors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	stored := entities.Book{ID: 1, Title: "Test", Authors: []entities.Author{{ID: 2, Name: "Second"}}}
+	book := entities.Book{ID: 1, Authors: []entities.Author{{ID: 7}}}
+	fields := []entities.BookField{entities.BookFieldAuthors}
+	expected := entities.Book{ID: 1, Title: "Test", Authors: []entities.Author{{ID: 7, Name: "First"}}}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{stored}, nil)
+
+			bookMock.EXPECT().
+				Update(ctx, book, fields).
+				Return(entities.Book{ID: 1, Title: "Test"}, nil)
+
+			authorMock.EXPECT().
+				GetByIDs(ctx, []int64{7}).
+				Return([]entities.Author{{ID: 7, Name: "First"}}, nil)
+
+			authorMock.EXPECT().
+				SetBookAuthors(ctx, int64(1), []int64{7}).
+				Return(nil)
+
+			payload, _ := json.Marshal(entities.BookUpdated{Book: expected, ChangedFields: fields})
+			bookEventMock.EXPECT().
+				Create(ctx, entities.BookEvent{BookId: 1, Type: entities.Updated, Status: entities.EventStatusNew, Payload: payload}).
+				Return(int64(1), nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Updated, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Author:      authorMock,
+			}
+
+			return fn(repo)
+		})
+
+	updated, err := us.Execute(ctx, book, fields)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, updated)
+}
+
+func TestBook_Update_ClearAuthors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	authorMock := mocks.NewMockAuthorRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	stored := entities.Book{ID: 1, Title: "Test", Authors: []entities.Author{{ID: 2, Name: "Second"}}}
+	book := entities.Book{ID: 1}
+	fields := []entities.BookField{entities.BookFieldAuthors}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{stored}, nil)
+
+			bookMock.EXPECT().
+				Update(ctx, book, fields).
+				Return(entities.Book{ID: 1, Title: "Test"}, nil)
+
+			authorMock.EXPECT().
+				SetBookAuthors(ctx, int64(1), []int64(nil)).
+				Return(nil)
+
+			bookEventMock.EXPECT().
+				Create(ctx, gomock.Any()).
+				Return(int64(1), nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Updated, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Author:      authorMock,
+			}
+
+			return fn(repo)
+		})
+
+	updated, err := us.Execute(ctx, book, fields)
+
+	assert.NoError(t, err)
+	assert.Empty(t, updated.Authors)
+}
+
+func TestBook_Update_ErrorGenreNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	stored := entities.Book{ID: 1, Title: "Test", GenreID: 3, Genre: "Test Genre", Year: 2019}
+	book := entities.Book{ID: 1, GenreID: 9}
+	fields := []entities.BookField{entities.BookFieldGenre}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{stored}, nil)
+
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{9}).
+				Return(nil, errs.ErrNotFound)
+
+			bookMock.EXPECT().
+				Update(ctx, gomock.Any(), gomock.Any()).
+				Times(0)
+
+			repo := &repositories.Repository{
+				Book:  bookMock,
+				Genre: genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	_, err := us.Execute(ctx, book, fields)
+
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, errs.ErrInvalidInput))
+	assert.Contains(t, err.Error(), "updateBookUsecase.Execute: resolve genre")
+}
+
+func TestBook_Update_SuccessGenre(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	stored := entities.Book{ID: 1, Title: "Test", GenreID: 3, Genre: "Test Genre", Year: 2019}
+	book := entities.Book{ID: 1, GenreID: 4}
+	fields := []entities.BookField{entities.BookFieldGenre}
+	expected := entities.Book{ID: 1, Title: "Test", GenreID: 4, Genre: "Fantasy", Year: 2019}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{stored}, nil)
+
+			genreMock.EXPECT().
+				GetByIDs(ctx, []int64{4}).
+				Return([]entities.Genre{{ID: 4, Name: "Fantasy"}}, nil)
+
+			bookMock.EXPECT().
+				Update(ctx, book, fields).
+				Return(entities.Book{ID: 1, Title: "Test", GenreID: 4, Year: 2019}, nil)
+
+			payload, _ := json.Marshal(entities.BookUpdated{Book: expected, ChangedFields: fields})
+			bookEventMock.EXPECT().
+				Create(ctx, entities.BookEvent{BookId: 1, Type: entities.Updated, Status: entities.EventStatusNew, Payload: payload}).
+				Return(int64(1), nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Updated, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Genre:       genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	updated, err := us.Execute(ctx, book, fields)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, updated)
+}
+
+func TestBook_Update_SuccessDeprecatedGenre(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	bookMock := mocks.NewMockBookRepository(ctrl)
+	bookEventMock := mocks.NewMockBookEventRepository(ctrl)
+	bookHistoryMock := mocks.NewMockBookHistoryRepository(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_book_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	us := NewUpdateBookUsecase(uowMock, observUsecase)
+	stored := entities.Book{ID: 1, Title: "Test", GenreID: 3, Genre: "Test Genre", Year: 2019}
+	book := entities.Book{ID: 1, Genre: "fantasy"}
+	fields := []entities.BookField{entities.BookFieldGenre}
+	expected := entities.Book{ID: 1, Title: "Test", GenreID: 4, Genre: "Fantasy", Year: 2019}
+	ctx := context.Background()
+
+	uowMock.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			bookMock.EXPECT().
+				GetByIDs(ctx, []int64{1}).
+				Return([]entities.Book{stored}, nil)
+
+			genreMock.EXPECT().
+				GetByName(ctx, "fantasy").
+				Return(entities.Genre{ID: 4, Name: "Fantasy"}, nil)
+
+			bookMock.EXPECT().
+				Update(ctx, entities.Book{ID: 1, GenreID: 4, Genre: "fantasy"}, fields).
+				Return(entities.Book{ID: 1, Title: "Test", GenreID: 4, Year: 2019}, nil)
+
+			payload, _ := json.Marshal(entities.BookUpdated{Book: expected, ChangedFields: fields})
+			bookEventMock.EXPECT().
+				Create(ctx, entities.BookEvent{BookId: 1, Type: entities.Updated, Status: entities.EventStatusNew, Payload: payload}).
+				Return(int64(1), nil)
+
+			bookHistoryMock.EXPECT().
+				Record(ctx, []int64{1}, entities.Updated, "").
+				Return(nil)
+
+			repo := &repositories.Repository{
+				Book:        bookMock,
+				BookEvent:   bookEventMock,
+				BookHistory: bookHistoryMock,
+				Genre:       genreMock,
+			}
+
+			return fn(repo)
+		})
+
+	updated, err := us.Execute(ctx, book, fields)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, updated)
+}
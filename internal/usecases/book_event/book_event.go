@@ -2,6 +2,7 @@ package bookevent
 
 import (
 	"context"
+	"time"
 
 	"github.com/mathbdw/book/internal/domain/entities"
 	errs "github.com/mathbdw/book/internal/errors"
@@ -11,8 +12,8 @@ import (
 
 type BookEventUsecaseInterface interface {
 	Lock(ctx context.Context, eventIds []int64)
-	Unlock(ctx context.Context, eventIDs []int64)
-	Remove(ctx context.Context, eventIDs []int64)
+	Unlock(ctx context.Context, eventIDs []int64, owner string)
+	Remove(ctx context.Context, eventIDs []int64, owner string)
 }
 
 type BookEventUsecase struct {
@@ -25,16 +26,16 @@ func NewBookEventUsecase(repo repositories.BookEventRepository, observ observabi
 	return BookEventUsecase{repo: repo, observ: observ}
 }
 
-// Lock - locks book events by the specified identifiers
+// Lock - locks book events for owner till the lease ends
 // Returns a list of locked events or an error
-func (uc *BookEventUsecase) Lock(ctx context.Context, batchSize uint64) ([]entities.BookEvent, error) {
+func (uc *BookEventUsecase) Lock(ctx context.Context, batchSize uint64, owner string, lease time.Duration) ([]entities.BookEvent, error) {
 	ctx, span := uc.observ.StartSpan(ctx, "BookEventUsecase.lock")
 
 	defer span.End()
 
 	span.SetAttributes([]observability.Attribute{{Key: "batchSize", Value: batchSize}})
 
-	events, err := uc.repo.Lock(ctx, batchSize)
+	events, err := uc.repo.Lock(ctx, batchSize, owner, lease)
 	if err != nil {
 		span.SetAttributes([]observability.Attribute{{Key: "repo.BookEvent.failed", Value: true}})
 
@@ -44,16 +45,16 @@ func (uc *BookEventUsecase) Lock(ctx context.Context, batchSize uint64) ([]entit
 	return events, nil
 }
 
-// Unlock - unlocks book events for the specified IDs
+// Unlock - unlocks book events locked by owner for the specified IDs
 // Returns an error if the execution fails
-func (uc *BookEventUsecase) Unlock(ctx context.Context, eventIDs []int64) error {
+func (uc *BookEventUsecase) Unlock(ctx context.Context, eventIDs []int64, owner string) error {
 	ctx, span := uc.observ.StartSpan(ctx, "BookEventUsecase.unlock")
 
 	defer span.End()
 
 	span.SetAttributes([]observability.Attribute{{Key: "eventIds", Value: eventIDs}})
 
-	err := uc.repo.Unlock(ctx, eventIDs)
+	err := uc.repo.Unlock(ctx, eventIDs, owner)
 	if err != nil {
 		span.SetAttributes([]observability.Attribute{{Key: "repo.BookEvent.failed", Value: true}})
 
@@ -63,16 +64,16 @@ func (uc *BookEventUsecase) Unlock(ctx context.Context, eventIDs []int64) error
 	return nil
 }
 
-// Remove - deletes rows book events for the specified IDs
+// Remove - deletes rows book events locked by owner for the specified IDs
 // Returns an error if the execution fails
-func (uc *BookEventUsecase) Remove(ctx context.Context, eventIDs []int64) error {
+func (uc *BookEventUsecase) Remove(ctx context.Context, eventIDs []int64, owner string) error {
 	ctx, span := uc.observ.StartSpan(ctx, "BookEventUsecase.remove")
 
 	defer span.End()
 
 	span.SetAttributes([]observability.Attribute{{Key: "eventIds", Value: eventIDs}})
 
-	err := uc.repo.Remove(ctx, eventIDs)
+	err := uc.repo.Remove(ctx, eventIDs, owner)
 	if err != nil {
 		span.SetAttributes([]observability.Attribute{{Key: "repo.BookEvent.failed", Value: true}})
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -36,12 +37,12 @@ func TestBookEvent_Lock_Error(t *testing.T) {
 	ctx := context.Background()
 
 	bookEventMock.EXPECT().
-		Lock(gomock.Any(), uint64(2)).
+		Lock(gomock.Any(), uint64(2), "outbox/1", time.Minute).
 		Return([]entities.BookEvent{}, errs.New("error"))
 
 	us := NewBookEventUsecase(bookEventMock, observUsecase)
 
-	bookEvents, err := us.Lock(ctx, 2)
+	bookEvents, err := us.Lock(ctx, 2, "outbox/1", time.Minute)
 
 	assert.Empty(t, bookEvents)
 	assert.Error(t, err)
@@ -62,12 +63,12 @@ func TestBookEvent_Lock_Success(t *testing.T) {
 	}
 
 	bookEventMock.EXPECT().
-		Lock(gomock.Any(), uint64(2)).
+		Lock(gomock.Any(), uint64(2), "outbox/1", time.Minute).
 		Return(events, nil)
 
 	us := NewBookEventUsecase(bookEventMock, observUsecase)
 
-	bookEvents, err := us.Lock(ctx, 2)
+	bookEvents, err := us.Lock(ctx, 2, "outbox/1", time.Minute)
 
 	assert.NoError(t, err)
 	assert.Equal(t, len(bookEvents), 2)
@@ -81,11 +82,11 @@ func TestBookEvent_Unlock_Error(t *testing.T) {
 	observUsecase := createMockUsecaseObservability(ctrl)
 	ctx := context.Background()
 	bookEventMock.EXPECT().
-		Unlock(gomock.Any(), []int64{1, 2}).
+		Unlock(gomock.Any(), []int64{1, 2}, "outbox/1").
 		Return(errs.New("error"))
 
 	uc := NewBookEventUsecase(bookEventMock, observUsecase)
-	err := uc.Unlock(ctx, []int64{1, 2})
+	err := uc.Unlock(ctx, []int64{1, 2}, "outbox/1")
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "bookEventUsecase.Unlock: set unlock events")
@@ -100,11 +101,11 @@ func TestBookEvent_Unlock_Success(t *testing.T) {
 	observUsecase := createMockUsecaseObservability(ctrl)
 	ctx := context.Background()
 	bookEventMock.EXPECT().
-		Unlock(gomock.Any(), []int64{1, 2}).
+		Unlock(gomock.Any(), []int64{1, 2}, "outbox/1").
 		Return(nil)
 
 	uc := NewBookEventUsecase(bookEventMock, observUsecase)
-	err := uc.Unlock(ctx, []int64{1, 2})
+	err := uc.Unlock(ctx, []int64{1, 2}, "outbox/1")
 
 	assert.NoError(t, err)
 }
@@ -117,11 +118,11 @@ func TestBookEvent_Remove_Error(t *testing.T) {
 	observUsecase := createMockUsecaseObservability(ctrl)
 	ctx := context.Background()
 	bookEventMock.EXPECT().
-		Remove(gomock.Any(), []int64{1, 2}).
+		Remove(gomock.Any(), []int64{1, 2}, "outbox/1").
 		Return(errs.New("error"))
 
 	uc := NewBookEventUsecase(bookEventMock, observUsecase)
-	err := uc.Remove(ctx, []int64{1, 2})
+	err := uc.Remove(ctx, []int64{1, 2}, "outbox/1")
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "bookEventUsecase.Remove: delete rows events")
@@ -136,11 +137,11 @@ func TestBookEvent_Remove_Success(t *testing.T) {
 	observUsecase := createMockUsecaseObservability(ctrl)
 	ctx := context.Background()
 	bookEventMock.EXPECT().
-		Remove(gomock.Any(), []int64{1, 2}).
+		Remove(gomock.Any(), []int64{1, 2}, "outbox/1").
 		Return(nil)
 
 	uc := NewBookEventUsecase(bookEventMock, observUsecase)
-	err := uc.Remove(ctx, []int64{1, 2})
+	err := uc.Remove(ctx, []int64{1, 2}, "outbox/1")
 
 	assert.NoError(t, err)
 }
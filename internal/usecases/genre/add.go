@@ -0,0 +1,36 @@
+package genre
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type AddGenreUsecase struct {
+	repoGenre repositories.GenreRepository
+	observ    observability.UsecaseObservability
+}
+
+// NewAddGenreUsecase - Constructor AddGenreUsecase
+func NewAddGenreUsecase(repo repositories.GenreRepository, observ observability.UsecaseObservability) AddGenreUsecase {
+	return AddGenreUsecase{repoGenre: repo, observ: observ}
+}
+
+// Execute - Adds new genre, returns the created genre.
+func (uc *AddGenreUsecase) Execute(ctx context.Context, genre entities.Genre) (entities.Genre, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "AddGenreUsecase")
+
+	defer span.End()
+
+	created, err := uc.repoGenre.Create(ctx, genre)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+		return entities.Genre{}, errors.Wrap(err, "AddGenreUsecase.Execute: create genre")
+	}
+
+	return created, nil
+}
@@ -0,0 +1,66 @@
+package genre
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+)
+
+func createMockUsecaseObservability(ctrl *gomock.Controller) *mocks.MockUsecaseObservability {
+	observ := mocks.NewMockUsecaseObservability(ctrl)
+	mockSpan := mocks.NewMockSpan(ctrl)
+
+	observ.EXPECT().StartSpan(gomock.Any(), gomock.Any()).Return(context.Background(), mockSpan).AnyTimes()
+
+	mockSpan.EXPECT().End().AnyTimes()
+	mockSpan.EXPECT().RecordError(gomock.Any()).AnyTimes()
+	mockSpan.EXPECT().SetAttributes(gomock.Any()).AnyTimes()
+
+	return observ
+}
+
+func TestGenre_Add_ErrorAlreadyExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	genreMock.EXPECT().
+		Create(ctx, entities.Genre{Name: "Fantasy"}).
+		Return(entities.Genre{}, errs.ErrAlreadyExists)
+
+	us := NewAddGenreUsecase(genreMock, observUsecase)
+	genre, err := us.Execute(ctx, entities.Genre{Name: "Fantasy"})
+
+	assert.True(t, errors.Is(err, errs.ErrAlreadyExists))
+	assert.Equal(t, entities.Genre{}, genre)
+	assert.Contains(t, err.Error(), "AddGenreUsecase.Execute: create genre")
+}
+
+func TestGenre_Add_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	genreMock.EXPECT().
+		Create(ctx, entities.Genre{Name: "Fantasy"}).
+		Return(entities.Genre{ID: 1, Name: "Fantasy"}, nil)
+
+	us := NewAddGenreUsecase(genreMock, observUsecase)
+	genre, err := us.Execute(ctx, entities.Genre{Name: "Fantasy"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.Genre{ID: 1, Name: "Fantasy"}, genre)
+}
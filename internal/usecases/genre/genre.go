@@ -0,0 +1,20 @@
+package genre
+
+type GenreUsecases struct {
+	Add    AddGenreUsecase
+	Get    GetGenreUsecase
+	List   ListGenreUsecase
+	Update UpdateGenreUsecase
+	Remove RemoveGenreUsecase
+}
+
+// New - constructor
+func New(opts ...GenreOptions) *GenreUsecases {
+	uc := &GenreUsecases{}
+
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
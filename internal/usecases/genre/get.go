@@ -0,0 +1,36 @@
+package genre
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type GetGenreUsecase struct {
+	repoGenre repositories.GenreRepository
+	observ    observability.UsecaseObservability
+}
+
+// NewGetGenreUsecase - Constructor GetGenreUsecase
+func NewGetGenreUsecase(repo repositories.GenreRepository, observ observability.UsecaseObservability) GetGenreUsecase {
+	return GetGenreUsecase{repoGenre: repo, observ: observ}
+}
+
+// GetByIDs - Returns slice genre by IDs
+func (uc *GetGenreUsecase) GetByIDs(ctx context.Context, IDs []int64) ([]entities.Genre, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "GetGenreUsecase")
+
+	defer span.End()
+
+	genres, err := uc.repoGenre.GetByIDs(ctx, IDs)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+		return []entities.Genre{}, errors.Wrap(err, "GetGenreUsecase.GetByIDs: get genres")
+	}
+
+	return genres, nil
+}
@@ -0,0 +1,56 @@
+package genre
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestGenre_GetByIDs_ErrorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	genreMock.EXPECT().
+		GetByIDs(ctx, []int64{1, 2}).
+		Return(nil, errs.ErrNotFound)
+
+	us := NewGetGenreUsecase(genreMock, observUsecase)
+	genres, err := us.GetByIDs(ctx, []int64{1, 2})
+
+	assert.Empty(t, genres)
+	assert.Contains(t, err.Error(), "GetGenreUsecase.GetByIDs: get genres")
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+}
+
+func TestGenre_GetByIDs_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	expected := []entities.Genre{{ID: 1, Name: "Fiction"}, {ID: 2, Name: "Fantasy"}}
+
+	genreMock.EXPECT().
+		GetByIDs(ctx, []int64{1, 2}).
+		Return(expected, nil)
+
+	us := NewGetGenreUsecase(genreMock, observUsecase)
+	genres, err := us.GetByIDs(ctx, []int64{1, 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, genres)
+}
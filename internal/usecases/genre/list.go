@@ -0,0 +1,36 @@
+package genre
+
+import (
+	"context"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type ListGenreUsecase struct {
+	repoGenre repositories.GenreRepository
+	observ    observability.UsecaseObservability
+}
+
+// NewListGenreUsecase - Constructor ListGenreUsecase
+func NewListGenreUsecase(repo repositories.GenreRepository, observ observability.UsecaseObservability) ListGenreUsecase {
+	return ListGenreUsecase{repoGenre: repo, observ: observ}
+}
+
+// Execute - Returns all genres ordered by ID, the tree is built from their parent IDs
+func (uc *ListGenreUsecase) Execute(ctx context.Context) ([]entities.Genre, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "ListGenreUsecase")
+
+	defer span.End()
+
+	genres, err := uc.repoGenre.List(ctx)
+	if err != nil {
+		span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+		return nil, errors.Wrap(err, "ListGenreUsecase.Execute: list genres")
+	}
+
+	return genres, nil
+}
@@ -0,0 +1,56 @@
+package genre
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestGenre_List_ErrorRepo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	genreMock.EXPECT().
+		List(ctx).
+		Return(nil, errs.New("error repoGenre"))
+
+	us := NewListGenreUsecase(genreMock, observUsecase)
+	genres, err := us.Execute(ctx)
+
+	assert.Error(t, err)
+	assert.Nil(t, genres)
+	assert.Contains(t, err.Error(), "ListGenreUsecase.Execute: list genres")
+}
+
+func TestGenre_List_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	parentID := int64(1)
+	expected := []entities.Genre{{ID: 1, Name: "Fiction"}, {ID: 2, Name: "Fantasy", ParentID: &parentID}}
+
+	genreMock.EXPECT().
+		List(ctx).
+		Return(expected, nil)
+
+	us := NewListGenreUsecase(genreMock, observUsecase)
+	genres, err := us.Execute(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, genres)
+}
@@ -0,0 +1,39 @@
+package genre
+
+// GenreOptions -.
+type GenreOptions func(*GenreUsecases)
+
+// WithAddGenreUsecase - Set usecase add_genre
+func WithAddGenreUsecase(uc AddGenreUsecase) GenreOptions {
+	return func(g *GenreUsecases) {
+		g.Add = uc
+	}
+}
+
+// WithGetGenreUsecase - Set usecase get_genre
+func WithGetGenreUsecase(uc GetGenreUsecase) GenreOptions {
+	return func(g *GenreUsecases) {
+		g.Get = uc
+	}
+}
+
+// WithListGenreUsecase - Set usecase list_genre
+func WithListGenreUsecase(uc ListGenreUsecase) GenreOptions {
+	return func(g *GenreUsecases) {
+		g.List = uc
+	}
+}
+
+// WithUpdateGenreUsecase - Set usecase update_genre
+func WithUpdateGenreUsecase(uc UpdateGenreUsecase) GenreOptions {
+	return func(g *GenreUsecases) {
+		g.Update = uc
+	}
+}
+
+// WithRemoveGenreUsecase - Set usecase remove_genre
+func WithRemoveGenreUsecase(uc RemoveGenreUsecase) GenreOptions {
+	return func(g *GenreUsecases) {
+		g.Remove = uc
+	}
+}
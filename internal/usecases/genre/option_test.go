@@ -0,0 +1,37 @@
+package genre
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestWithGenreUsecases(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockGenreRepo := mocks.NewMockGenreRepository(ctrl)
+	mockUoWRepo := mocks.NewMockUnitOfWork(ctrl)
+	observUsecase := createMockUsecaseObservability(ctrl)
+
+	addUC := NewAddGenreUsecase(mockGenreRepo, observUsecase)
+	getUC := NewGetGenreUsecase(mockGenreRepo, observUsecase)
+	listUC := NewListGenreUsecase(mockGenreRepo, observUsecase)
+	updateUC := NewUpdateGenreUsecase(mockUoWRepo, observUsecase)
+	removeUC := NewRemoveGenreUsecase(mockUoWRepo, observUsecase)
+
+	uc := New(
+		WithAddGenreUsecase(addUC),
+		WithGetGenreUsecase(getUC),
+		WithListGenreUsecase(listUC),
+		WithUpdateGenreUsecase(updateUC),
+		WithRemoveGenreUsecase(removeUC),
+	)
+
+	assert.Equal(t, addUC, uc.Add)
+	assert.Equal(t, getUC, uc.Get)
+	assert.Equal(t, listUC, uc.List)
+	assert.Equal(t, updateUC, uc.Update)
+	assert.Equal(t, removeUC, uc.Remove)
+}
@@ -0,0 +1,66 @@
+package genre
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type RemoveGenreUsecase struct {
+	repoUOW repositories.UnitOfWork
+	observ  observability.UsecaseObservability
+}
+
+// NewRemoveGenreUsecase - Constructor RemoveGenreUsecase
+func NewRemoveGenreUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) RemoveGenreUsecase {
+	return RemoveGenreUsecase{repoUOW: uow, observ: observ}
+}
+
+// Execute - Deletes the genres. Genres that have books or child genres are not deleted.
+func (uc *RemoveGenreUsecase) Execute(ctx context.Context, IDs []int64) error {
+	ctx, span := uc.observ.StartSpan(ctx, "RemoveGenreUsecase")
+
+	defer span.End()
+
+	err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
+		count, err := repo.Genre.CountBooks(ctx, IDs)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+			return errors.Wrap(err, "RemoveGenreUsecase.Execute: count books")
+		}
+
+		if count > 0 {
+			span.SetAttributes([]observability.Attribute{{Key: "genre.linked", Value: true}})
+
+			return errors.Wrap(errors.ErrConflict, fmt.Sprintf("RemoveGenreUsecase.Execute: genres have %d books", count))
+		}
+
+		count, err = repo.Genre.CountChildren(ctx, IDs)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+			return errors.Wrap(err, "RemoveGenreUsecase.Execute: count children")
+		}
+
+		if count > 0 {
+			span.SetAttributes([]observability.Attribute{{Key: "genre.hasChildren", Value: true}})
+
+			return errors.Wrap(errors.ErrConflict, fmt.Sprintf("RemoveGenreUsecase.Execute: genres have %d child genres", count))
+		}
+
+		err = repo.Genre.Remove(ctx, IDs)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+			return errors.Wrap(err, "RemoveGenreUsecase.Execute: remove genres")
+		}
+
+		return nil
+	})
+
+	return err
+}
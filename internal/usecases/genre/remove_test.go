@@ -0,0 +1,111 @@
+package genre
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestGenre_Remove_ErrorHasBooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				CountBooks(ctx, []int64{1, 2}).
+				Return(int64(3), nil)
+
+			genreMock.EXPECT().
+				Remove(ctx, gomock.Any()).
+				Times(0)
+
+			return fn(&repositories.Repository{Genre: genreMock})
+		})
+
+	us := NewRemoveGenreUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1, 2})
+
+	assert.True(t, errors.Is(err, errs.ErrConflict))
+	assert.Contains(t, err.Error(), "RemoveGenreUsecase.Execute: genres have 3 books")
+}
+
+func TestGenre_Remove_ErrorHasChildren(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				CountBooks(ctx, []int64{1}).
+				Return(int64(0), nil)
+
+			genreMock.EXPECT().
+				CountChildren(ctx, []int64{1}).
+				Return(int64(2), nil)
+
+			genreMock.EXPECT().
+				Remove(ctx, gomock.Any()).
+				Times(0)
+
+			return fn(&repositories.Repository{Genre: genreMock})
+		})
+
+	us := NewRemoveGenreUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1})
+
+	assert.True(t, errors.Is(err, errs.ErrConflict))
+	assert.Contains(t, err.Error(), "RemoveGenreUsecase.Execute: genres have 2 child genres")
+}
+
+func TestGenre_Remove_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				CountBooks(ctx, []int64{1, 2}).
+				Return(int64(0), nil)
+
+			genreMock.EXPECT().
+				CountChildren(ctx, []int64{1, 2}).
+				Return(int64(0), nil)
+
+			genreMock.EXPECT().
+				Remove(ctx, []int64{1, 2}).
+				Return(nil)
+
+			return fn(&repositories.Repository{Genre: genreMock})
+		})
+
+	us := NewRemoveGenreUsecase(uowMock, observUsecase)
+	err := us.Execute(ctx, []int64{1, 2})
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,65 @@
+package genre
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	"github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
+
+type UpdateGenreUsecase struct {
+	repoUOW repositories.UnitOfWork
+	observ  observability.UsecaseObservability
+}
+
+// NewUpdateGenreUsecase - Constructor UpdateGenreUsecase
+func NewUpdateGenreUsecase(uow repositories.UnitOfWork, observ observability.UsecaseObservability) UpdateGenreUsecase {
+	return UpdateGenreUsecase{repoUOW: uow, observ: observ}
+}
+
+// Execute - Updates the genre, returns the updated genre.
+// The parent can't be the genre itself or one of its descendants.
+func (uc *UpdateGenreUsecase) Execute(ctx context.Context, genre entities.Genre) (entities.Genre, error) {
+	ctx, span := uc.observ.StartSpan(ctx, "UpdateGenreUsecase")
+
+	defer span.End()
+
+	span.SetAttributes([]observability.Attribute{{Key: "genre.id", Value: genre.ID}})
+
+	var updated entities.Genre
+	err := uc.repoUOW.Do(ctx, func(repo *repositories.Repository) error {
+		if genre.ParentID != nil {
+			subtree, err := repo.Genre.Subtree(ctx, genre.ID)
+			if err != nil {
+				span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+				return errors.Wrap(err, "UpdateGenreUsecase.Execute: get subtree")
+			}
+
+			if slices.Contains(subtree, *genre.ParentID) {
+				span.SetAttributes([]observability.Attribute{{Key: "genre.cycle", Value: true}})
+
+				return errors.Wrap(errors.ErrInvalidInput, fmt.Sprintf("UpdateGenreUsecase.Execute: genre %d can't be a parent of genre %d", *genre.ParentID, genre.ID))
+			}
+		}
+
+		var err error
+		updated, err = repo.Genre.Update(ctx, genre)
+		if err != nil {
+			span.SetAttributes([]observability.Attribute{{Key: "repo.genre.failed", Value: true}})
+
+			return errors.Wrap(err, "UpdateGenreUsecase.Execute: update genre")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entities.Genre{}, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,105 @@
+package genre
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/internal/domain/entities"
+	errs "github.com/mathbdw/book/internal/errors"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+	"github.com/mathbdw/book/mocks"
+)
+
+func TestGenre_Update_ErrorCycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	parentID := int64(5)
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				Subtree(ctx, int64(1)).
+				Return([]int64{1, 2, 5}, nil)
+
+			genreMock.EXPECT().
+				Update(ctx, gomock.Any()).
+				Times(0)
+
+			return fn(&repositories.Repository{Genre: genreMock})
+		})
+
+	us := NewUpdateGenreUsecase(uowMock, observUsecase)
+	_, err := us.Execute(ctx, entities.Genre{ID: 1, Name: "Fiction", ParentID: &parentID})
+
+	assert.True(t, errors.Is(err, errs.ErrInvalidInput))
+	assert.Contains(t, err.Error(), "UpdateGenreUsecase.Execute: genre 5 can't be a parent of genre 1")
+}
+
+func TestGenre_Update_ErrorNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				Update(ctx, entities.Genre{ID: 1, Name: "Fiction"}).
+				Return(entities.Genre{}, errs.ErrNotFound)
+
+			return fn(&repositories.Repository{Genre: genreMock})
+		})
+
+	us := NewUpdateGenreUsecase(uowMock, observUsecase)
+	genre, err := us.Execute(ctx, entities.Genre{ID: 1, Name: "Fiction"})
+
+	assert.True(t, errors.Is(err, errs.ErrNotFound))
+	assert.Equal(t, entities.Genre{}, genre)
+	assert.Contains(t, err.Error(), "UpdateGenreUsecase.Execute: update genre")
+}
+
+func TestGenre_Update_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uowMock := mocks.NewMockUnitOfWork(ctrl)
+	genreMock := mocks.NewMockGenreRepository(ctrl)
+	//observUsecase - add_test.go
+	observUsecase := createMockUsecaseObservability(ctrl)
+	ctx := context.Background()
+	parentID := int64(1)
+	genre := entities.Genre{ID: 2, Name: "Fantasy", ParentID: &parentID}
+
+	uowMock.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(repo *repositories.Repository) error) error {
+			genreMock.EXPECT().
+				Subtree(ctx, int64(2)).
+				Return([]int64{2, 3}, nil)
+
+			genreMock.EXPECT().
+				Update(ctx, genre).
+				Return(genre, nil)
+
+			return fn(&repositories.Repository{Genre: genreMock})
+		})
+
+	us := NewUpdateGenreUsecase(uowMock, observUsecase)
+	updated, err := us.Execute(ctx, genre)
+
+	assert.NoError(t, err)
+	assert.Equal(t, genre, updated)
+}
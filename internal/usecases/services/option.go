@@ -1,16 +1,35 @@
 package services
 
-import "time"
+import (
+	"time"
+
+	"github.com/mathbdw/book/internal/interfaces/observability"
+	"github.com/mathbdw/book/internal/interfaces/repositories"
+)
 
 type Option func(*OutboxProcessor)
 
-// WithInterval - sets interval for run process
+// WithInterval - sets the longest wait of the idle worker, it sweeps the events missed by the listener
 func WithInterval(interval time.Duration) Option {
 	return func(p *OutboxProcessor) {
 		p.interval = interval
 	}
 }
 
+// WithListener - sets the listener waking the idle workers on new events
+func WithListener(listener repositories.BookEventListener) Option {
+	return func(p *OutboxProcessor) {
+		p.listener = listener
+	}
+}
+
+// WithMetrics - sets the metrics of the outbox, e.g. the failed locks
+func WithMetrics(metrics observability.RepositoriesMetrics) Option {
+	return func(p *OutboxProcessor) {
+		p.metrics = metrics
+	}
+}
+
 // WithBatchsize - sets the sample size for the book_event
 func WithBatchSize(batch uint64) Option {
 	return func(p *OutboxProcessor) {
@@ -24,3 +43,35 @@ func WithCountWorkers(count uint8) Option {
 		p.countWorkers = count
 	}
 }
+
+// WithMaxAttempts - sets the number of attempts to publish the event before it is moved to the dead events, 0 - the default
+func WithMaxAttempts(attempts int32) Option {
+	return func(p *OutboxProcessor) {
+		if attempts > 0 {
+			p.maxAttempts = attempts
+		}
+	}
+}
+
+// WithRetryBackoff - sets the delay after the first failed attempt and the limit of the delay doubled after every next one,
+// 0 - the default
+func WithRetryBackoff(base, max time.Duration) Option {
+	return func(p *OutboxProcessor) {
+		if base > 0 {
+			p.retryBase = base
+		}
+		if max > 0 {
+			p.retryMax = max
+		}
+	}
+}
+
+// WithLockLease - sets the time the worker holds the locked events, after it they are locked again by any worker,
+// 0 - the default
+func WithLockLease(lease time.Duration) Option {
+	return func(p *OutboxProcessor) {
+		if lease > 0 {
+			p.lockLease = lease
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mathbdw/book/mocks"
 )
 
 func TestWithInterval(t *testing.T) {
@@ -35,3 +38,55 @@ func TestWithCountWorkers(t *testing.T) {
 	opt(p)
 	assert.Equal(t, count, p.countWorkers)
 }
+
+func TestWithMaxAttempts(t *testing.T) {
+	p := &OutboxProcessor{maxAttempts: _defaultMaxAttempts}
+
+	WithMaxAttempts(0)(p)
+	assert.Equal(t, int32(_defaultMaxAttempts), p.maxAttempts)
+
+	WithMaxAttempts(3)(p)
+	assert.Equal(t, int32(3), p.maxAttempts)
+}
+
+func TestWithRetryBackoff(t *testing.T) {
+	p := &OutboxProcessor{retryBase: _defaultRetryBase, retryMax: _defaultRetryMax}
+
+	WithRetryBackoff(0, time.Minute)(p)
+	assert.Equal(t, _defaultRetryBase, p.retryBase)
+	assert.Equal(t, time.Minute, p.retryMax)
+
+	WithRetryBackoff(time.Millisecond, 0)(p)
+	assert.Equal(t, time.Millisecond, p.retryBase)
+	assert.Equal(t, time.Minute, p.retryMax)
+}
+
+func TestWithLockLease(t *testing.T) {
+	p := &OutboxProcessor{lockLease: _defaultLockLease}
+
+	WithLockLease(0)(p)
+	assert.Equal(t, _defaultLockLease, p.lockLease)
+
+	WithLockLease(time.Hour)(p)
+	assert.Equal(t, time.Hour, p.lockLease)
+}
+
+func TestWithListener(t *testing.T) {
+	p := &OutboxProcessor{}
+
+	listener := mocks.NewMockBookEventListener(gomock.NewController(t))
+	opt := WithListener(listener)
+	opt(p)
+
+	assert.Equal(t, listener, p.listener)
+}
+
+func TestWithMetrics(t *testing.T) {
+	p := &OutboxProcessor{}
+
+	metrics := mocks.NewMockRepositoriesMetrics(gomock.NewController(t))
+	opt := WithMetrics(metrics)
+	opt(p)
+
+	assert.Equal(t, metrics, p.metrics)
+}
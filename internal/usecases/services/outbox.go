@@ -3,31 +3,77 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/mathbdw/book/internal/domain/entities"
 	errs "github.com/mathbdw/book/internal/errors"
 	"github.com/mathbdw/book/internal/interfaces/observability"
 	"github.com/mathbdw/book/internal/interfaces/publisher"
 	"github.com/mathbdw/book/internal/interfaces/repositories"
 )
 
+const (
+	_defaultMaxAttempts = 10
+	_defaultRetryBase   = time.Second
+	_defaultRetryMax    = 10 * time.Minute
+	_defaultLockLease   = time.Minute
+	_defaultIdleBackoff = 100 * time.Millisecond
+)
+
+// Stats - the state of the worker pool
+type Stats struct {
+	// Workers - the running workers
+	Workers int
+	// Busy - the workers processing a batch
+	Busy int
+	// InFlight - the locked events being published
+	InFlight int
+}
+
 type OutboxProcessor struct {
 	eventRepo repositories.BookEventRepository
+	listener  repositories.BookEventListener
 	publisher publisher.EventPublisher
 	logger    observability.Logger
+	metrics   observability.RepositoriesMetrics
 
 	batchSize    uint64
 	interval     time.Duration
 	countWorkers uint8
+	// idleMin - the first wait of the idle worker, it is doubled up to interval
+	idleMin time.Duration
+
+	maxAttempts int32
+	retryBase   time.Duration
+	retryMax    time.Duration
+
+	// lockLease - the events locked by a worker are reclaimed by any worker after it
+	lockLease time.Duration
+	instance  string
+	locks     atomic.Uint64
+
+	workers  atomic.Int32
+	busy     atomic.Int32
+	inFlight atomic.Int64
 }
 
 // New - constructor outbox processor
 func New(repo repositories.BookEventRepository, publisher publisher.EventPublisher, logger observability.Logger, opts ...Option) *OutboxProcessor {
 	op := &OutboxProcessor{
-		eventRepo: repo,
-		publisher: publisher,
-		logger:    logger,
+		eventRepo:   repo,
+		publisher:   publisher,
+		logger:      logger,
+		maxAttempts: _defaultMaxAttempts,
+		retryBase:   _defaultRetryBase,
+		retryMax:    _defaultRetryMax,
+		lockLease:   _defaultLockLease,
+		idleMin:     _defaultIdleBackoff,
+		instance:    instanceName(),
 	}
 
 	for _, opt := range opts {
@@ -36,20 +82,33 @@ func New(repo repositories.BookEventRepository, publisher publisher.EventPublish
 	return op
 }
 
-// Start - sets semafor for outbox
+// Start - runs countWorkers long-lived workers until the context is done.
+// A worker locks the next batch at once while the batches come back full, otherwise it waits
+// for the notification of the listener or the idle backoff doubled up to interval.
+// The failed batches are waited out the same way, they do not stop the pool.
 func (op *OutboxProcessor) Start(ctx context.Context) error {
+	var listen <-chan struct{}
+	if op.listener != nil {
+		listen = op.listener.Listen(ctx)
+	}
+
 	var wg sync.WaitGroup
-	errCh := make(chan error, op.countWorkers)
-	defer close(errCh)
+	wakes := make([]chan struct{}, op.countWorkers)
 
-	ticker := time.NewTicker(op.interval)
-	defer ticker.Stop()
+	for i := range wakes {
+		wakes[i] = make(chan struct{}, 1)
+
+		wg.Add(1)
+		go func(workNumber uint8, wake <-chan struct{}) {
+			defer wg.Done()
+			op.work(ctx, workNumber, wake)
+		}(uint8(i), wakes[i])
+	}
 
 	for {
 		select {
 		case <-ctx.Done():
 			wg.Wait()
-			op.logger.Info("outbox.Start: graceful shutdown", nil)
 			op.logger.Info("outbox.Start: graceful shutdown",
 				map[string]any{
 					"reason":  ctx.Err().Error(),
@@ -57,58 +116,117 @@ func (op *OutboxProcessor) Start(ctx context.Context) error {
 				})
 
 			return nil
-		case err := <-errCh:
-			wg.Wait()
+		case _, ok := <-listen:
+			if !ok {
+				// the listener is stopped, the idle backoff is left
+				listen = nil
+				continue
+			}
 
-			op.logger.Error("outbox.Start: graceful shutdown: critical error shutdown",
-				map[string]any{
-					"error":   err.Error(),
-					"workers": op.countWorkers,
-					"reason":  "worker_error",
-				})
+			for _, wake := range wakes {
+				select {
+				case wake <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}
+}
+
+// Stats - returns the state of the worker pool
+func (op *OutboxProcessor) Stats() Stats {
+	return Stats{
+		Workers:  int(op.workers.Load()),
+		Busy:     int(op.busy.Load()),
+		InFlight: int(op.inFlight.Load()),
+	}
+}
+
+// work - processes the batches of one worker until the context is done.
+// The batch started before the context is done is finished, so its events are not published twice.
+func (op *OutboxProcessor) work(ctx context.Context, number uint8, wake <-chan struct{}) {
+	op.workers.Add(1)
+	defer op.workers.Add(-1)
+
+	idle := op.idleBackoff()
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		op.busy.Add(1)
+		// the failed batch is logged by processEvent, its events are locked again after the retry delay or the lease
+		published, err := op.processEvent(context.WithoutCancel(ctx), number)
+		op.busy.Add(-1)
 
-			return err
-		case <-ticker.C:
-			for i := uint8(0); i < op.countWorkers; i++ {
-				wg.Add(1)
-				go func(workNumber uint8) {
-					defer wg.Done()
-					if err := op.processEvent(ctx, workNumber); err != nil {
-						errCh <- err
-					}
-				}(i)
+		if err == nil && published > 0 {
+			idle = op.idleBackoff()
+			if uint64(published) == op.batchSize {
+				continue
 			}
 		}
+
+		wait := idle
+		idle = min(idle*2, max(op.interval, op.idleBackoff()))
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return
+		case <-wake:
+			timer.Stop()
+			idle = op.idleBackoff()
+		case <-timer.C:
+		}
+	}
+}
+
+// idleBackoff - returns the first wait of the worker finding the queue empty
+func (op *OutboxProcessor) idleBackoff() time.Duration {
+	if op.interval > 0 && op.interval < op.idleMin {
+		return op.interval
 	}
+
+	return op.idleMin
 }
 
-// processEvent - preparing event for sending
-func (op *OutboxProcessor) processEvent(ctx context.Context, number uint8) error {
+// processEvent - preparing event for sending, returns the number of the published events
+func (op *OutboxProcessor) processEvent(ctx context.Context, number uint8) (int, error) {
 	op.logger.Debug("outbox.processEvent: run workers", map[string]any{"worker": number})
 
-	events, err := op.eventRepo.Lock(ctx, op.batchSize)
+	owner := fmt.Sprintf("%s/%d/%d", op.instance, number, op.locks.Add(1))
+	events, err := op.eventRepo.Lock(ctx, op.batchSize, owner, op.lockLease)
 	if err != nil {
 		if errors.Is(err, errs.ErrNotFound) {
 			op.logger.Debug(
 				"outbox.processEvent: no events found",
 				map[string]any{"worker": number},
 			)
-			return nil
+			return 0, nil
 		}
+		// the lock fails on the database errors, the worker waits like after an empty batch and locks again
 		op.logger.Error(
 			"outbox.processEvent: lock failed",
 			map[string]any{"worker": number, "error": err},
 		)
+		if op.metrics != nil {
+			op.metrics.RecordEventsLockFailed(ctx)
+		}
 
-		return err
+		return 0, nil
 	}
 
+	op.inFlight.Add(int64(len(events)))
+	defer op.inFlight.Add(-int64(len(events)))
+
 	eventsIDsSuccess := make([]int64, 0, len(events))
 	var errSend error
 
 	defer func() {
 		if len(eventsIDsSuccess) > 0 {
-			err = op.eventRepo.Remove(ctx, eventsIDsSuccess)
+			err = op.eventRepo.Remove(ctx, eventsIDsSuccess, owner)
 
 			if err != nil {
 				op.logger.Error(
@@ -125,7 +243,7 @@ func (op *OutboxProcessor) processEvent(ctx context.Context, number uint8) error
 		if err != nil {
 			op.logger.Error(
 				"outbox.processEvent: publish failed",
-				map[string]any{"worker": number, "error": err, "eventID": event.ID},
+				map[string]any{"worker": number, "error": err, "eventID": event.ID, "attempt": event.Attempts + 1},
 			)
 
 			errSend = err
@@ -136,24 +254,100 @@ func (op *OutboxProcessor) processEvent(ctx context.Context, number uint8) error
 	}
 
 	if errSend != nil {
-		eventsIDsFailure := make([]int64, 0, len(events)-len(eventsIDsSuccess))
+		// the failed event waits for its next attempt, the rest of the batch is locked again by the next run
+		failed := events[len(eventsIDsSuccess)]
+		err = op.failEvent(ctx, failed, owner, errSend)
+		if errors.Is(err, errs.ErrNotFound) {
+			op.logger.Warn(
+				"outbox.processEvent: lock lease lost",
+				map[string]any{"worker": number, "owner": owner, "eventID": failed.ID},
+			)
 
-		for i := len(eventsIDsSuccess); i < len(events); i++ {
+			return len(eventsIDsSuccess), nil
+		}
+		if err != nil {
+			op.logger.Error(
+				"outbox.processEvent: fail event failed",
+				map[string]any{"worker": number, "error": err, "eventID": failed.ID},
+			)
+
+			return len(eventsIDsSuccess), err
+		}
+
+		eventsIDsFailure := make([]int64, 0, len(events)-len(eventsIDsSuccess)-1)
+
+		for i := len(eventsIDsSuccess) + 1; i < len(events); i++ {
 			eventsIDsFailure = append(eventsIDsFailure, events[i].ID)
 		}
 
-		err = op.eventRepo.Unlock(ctx, eventsIDsFailure)
+		if len(eventsIDsFailure) == 0 {
+			return len(eventsIDsSuccess), nil
+		}
+
+		err = op.eventRepo.Unlock(ctx, eventsIDsFailure, owner)
+		if errors.Is(err, errs.ErrNotFound) {
+			op.logger.Warn(
+				"outbox.processEvent: lock lease lost",
+				map[string]any{"worker": number, "owner": owner, "eventIDs": eventsIDsFailure},
+			)
+
+			return len(eventsIDsSuccess), nil
+		}
 		if err != nil {
 			op.logger.Error(
 				"outbox.processEvent: unlock failed",
 				map[string]any{"worker": number, "error": err, "eventIDs": eventsIDsFailure},
 			)
 
-			return err
+			return len(eventsIDsSuccess), err
 		}
+	}
+
+	return len(eventsIDsSuccess), nil
+}
+
+// failEvent - counts the failed attempt of the event: it is retried after the backoff
+// or moved to the dead events when it runs out of attempts
+func (op *OutboxProcessor) failEvent(ctx context.Context, event entities.BookEvent, owner string, errSend error) error {
+	attempts := event.Attempts + 1
+	if attempts >= op.maxAttempts {
+		op.logger.Warn(
+			"outbox.failEvent: event is dead",
+			map[string]any{"eventID": event.ID, "bookID": event.BookId, "attempts": attempts, "error": errSend.Error()},
+		)
+
+		return op.eventRepo.Bury(ctx, event.ID, owner, errSend.Error())
+	}
+
+	return op.eventRepo.Retry(ctx, event.ID, owner, op.retryDelay(attempts), errSend.Error())
+}
+
+// retryDelay - returns the delay after the attempt: retryBase doubled with every attempt up to retryMax,
+// the second half of the delay is random so the failed events do not come back at once
+func (op *OutboxProcessor) retryDelay(attempt int32) time.Duration {
+	delay := op.retryBase
+	for i := int32(1); i < attempt && delay < op.retryMax; i++ {
+		delay *= 2
+	}
+
+	if delay > op.retryMax {
+		delay = op.retryMax
+	}
+
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+
+	return half + rand.N(delay-half+1)
+}
 
-		return errSend
+// instanceName - returns the name of the publisher process in the lock owners
+func instanceName() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "outbox"
 	}
 
-	return nil
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -45,7 +46,7 @@ func TestProcessEvent_NoRows(t *testing.T) {
 
 	ctx := context.Background()
 	eventRepo.EXPECT().
-		Lock(ctx, op.batchSize).
+		Lock(ctx, op.batchSize, gomock.Any(), op.lockLease).
 		Return(nil, sql.ErrNoRows).
 		Times(1)
 
@@ -53,7 +54,11 @@ func TestProcessEvent_NoRows(t *testing.T) {
 	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).Times(1)
 	//Log - error to repo.lock
 	logger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
-	op.processEvent(ctx, uint8(1))
+	published, err := op.processEvent(ctx, uint8(1))
+
+	// the worker waits and locks again
+	require.NoError(t, err)
+	require.Equal(t, 0, published)
 }
 
 func TestProcessEvent_NoEvent(t *testing.T) {
@@ -67,7 +72,7 @@ func TestProcessEvent_NoEvent(t *testing.T) {
 
 	ctx := context.Background()
 	eventRepo.EXPECT().
-		Lock(ctx, op.batchSize).
+		Lock(ctx, op.batchSize, gomock.Any(), op.lockLease).
 		Return(nil, errs.ErrNotFound).
 		Times(1)
 
@@ -97,9 +102,15 @@ func TestProcessEvent_FalseSend(t *testing.T) {
 			Payload: []byte("{\"Title\":\"Test\"}"),
 			Type:    entities.Created,
 		},
+		{
+			ID:      2,
+			BookId:  2,
+			Payload: []byte("{\"Title\":\"Test\"}"),
+			Type:    entities.Created,
+		},
 	}
 	eventRepo.EXPECT().
-		Lock(ctx, op.batchSize).
+		Lock(ctx, op.batchSize, gomock.Any(), op.lockLease).
 		Return(events, nil).
 		Times(1)
 
@@ -109,7 +120,12 @@ func TestProcessEvent_FalseSend(t *testing.T) {
 		Times(1)
 
 	eventRepo.EXPECT().
-		Unlock(ctx, []int64{1}).
+		Retry(ctx, int64(1), gomock.Any(), gomock.Any(), "false send").
+		Return(nil).
+		Times(1)
+
+	eventRepo.EXPECT().
+		Unlock(ctx, []int64{2}, gomock.Any()).
 		Return(nil).
 		Times(1)
 
@@ -118,7 +134,106 @@ func TestProcessEvent_FalseSend(t *testing.T) {
 	//Log - error to send kafka
 	logger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
 
-	op.processEvent(ctx, uint8(1))
+	published, err := op.processEvent(ctx, uint8(1))
+
+	require.NoError(t, err)
+	require.Equal(t, 0, published)
+}
+
+func TestProcessEvent_FalseSendDead(t *testing.T) {
+	_, eventRepo, publisher, logger := setup(t)
+
+	op := New(
+		eventRepo,
+		publisher,
+		logger,
+		WithMaxAttempts(3),
+	)
+	op.batchSize = uint64(50)
+
+	ctx := context.Background()
+
+	events := []entities.BookEvent{
+		{
+			ID:       1,
+			BookId:   1,
+			Payload:  []byte("{\"Title\":\"Test\"}"),
+			Type:     entities.Created,
+			Attempts: 2,
+		},
+	}
+	eventRepo.EXPECT().
+		Lock(ctx, op.batchSize, gomock.Any(), op.lockLease).
+		Return(events, nil).
+		Times(1)
+
+	publisher.EXPECT().
+		Publish(ctx, &events[0]).
+		Return(errors.New("false send")).
+		Times(1)
+
+	eventRepo.EXPECT().
+		Bury(ctx, int64(1), gomock.Any(), "false send").
+		Return(nil).
+		Times(1)
+
+	//Log - run workers - #N
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).Times(1)
+	//Log - error to send kafka
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+	//Log - dead event
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
+
+	published, err := op.processEvent(ctx, uint8(1))
+
+	require.NoError(t, err)
+	require.Equal(t, 0, published)
+}
+
+func TestProcessEvent_FalseRetry(t *testing.T) {
+	_, eventRepo, publisher, logger := setup(t)
+
+	op := New(
+		eventRepo,
+		publisher,
+		logger,
+	)
+	op.batchSize = uint64(50)
+
+	ctx := context.Background()
+
+	events := []entities.BookEvent{
+		{
+			ID:      1,
+			BookId:  1,
+			Payload: []byte("{\"Title\":\"Test\"}"),
+			Type:    entities.Created,
+		},
+	}
+	eventRepo.EXPECT().
+		Lock(ctx, op.batchSize, gomock.Any(), op.lockLease).
+		Return(events, nil).
+		Times(1)
+
+	publisher.EXPECT().
+		Publish(ctx, &events[0]).
+		Return(errors.New("false send")).
+		Times(1)
+
+	eventRepo.EXPECT().
+		Retry(ctx, int64(1), gomock.Any(), gomock.Any(), "false send").
+		Return(errors.New("error retry")).
+		Times(1)
+
+	//Log - run workers - #N
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).Times(1)
+	//Log - error to send kafka and error eventRepo.retry
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(2)
+
+	published, err := op.processEvent(ctx, uint8(1))
+
+	require.Error(t, err)
+	require.Equal(t, 0, published)
 }
 
 func TestProcessEvent_FalseUnlock(t *testing.T) {
@@ -140,9 +255,15 @@ func TestProcessEvent_FalseUnlock(t *testing.T) {
 			Payload: []byte("{\"Title\":\"Test\"}"),
 			Type:    entities.Created,
 		},
+		{
+			ID:      2,
+			BookId:  2,
+			Payload: []byte("{\"Title\":\"Test\"}"),
+			Type:    entities.Created,
+		},
 	}
 	eventRepo.EXPECT().
-		Lock(ctx, op.batchSize).
+		Lock(ctx, op.batchSize, gomock.Any(), op.lockLease).
 		Return(events, nil).
 		Times(1)
 
@@ -152,7 +273,12 @@ func TestProcessEvent_FalseUnlock(t *testing.T) {
 		Times(1)
 
 	eventRepo.EXPECT().
-		Unlock(ctx, []int64{1}).
+		Retry(ctx, int64(1), gomock.Any(), gomock.Any(), "false send").
+		Return(nil).
+		Times(1)
+
+	eventRepo.EXPECT().
+		Unlock(ctx, []int64{2}, gomock.Any()).
 		Return(errors.New("error unlock")).
 		Times(1)
 
@@ -163,3 +289,290 @@ func TestProcessEvent_FalseUnlock(t *testing.T) {
 
 	op.processEvent(ctx, uint8(1))
 }
+
+func TestProcessEvent_SuccessOwner(t *testing.T) {
+	_, eventRepo, publisher, logger := setup(t)
+
+	op := New(
+		eventRepo,
+		publisher,
+		logger,
+		WithLockLease(time.Minute),
+	)
+	op.batchSize = uint64(50)
+
+	ctx := context.Background()
+
+	events := []entities.BookEvent{
+		{ID: 1, BookId: 1, Payload: []byte("{}"), Type: entities.Created},
+		{ID: 2, BookId: 2, Payload: []byte("{}"), Type: entities.Created},
+	}
+
+	var owner string
+	eventRepo.EXPECT().
+		Lock(ctx, op.batchSize, gomock.Any(), time.Minute).
+		DoAndReturn(func(ctx context.Context, batchSize uint64, lockOwner string, lease time.Duration) ([]entities.BookEvent, error) {
+			owner = lockOwner
+
+			return events, nil
+		})
+
+	publisher.EXPECT().Publish(ctx, gomock.Any()).Return(nil).Times(2)
+
+	eventRepo.EXPECT().
+		Remove(ctx, []int64{1, 2}, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, eventIDs []int64, removeOwner string) error {
+			require.Equal(t, owner, removeOwner)
+
+			return nil
+		})
+
+	//Log - run workers - #N
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).Times(1)
+
+	published, err := op.processEvent(ctx, uint8(1))
+
+	require.NoError(t, err)
+	require.Contains(t, owner, op.instance+"/1/")
+	require.Equal(t, 2, published)
+}
+
+func TestProcessEvent_LeaseLost(t *testing.T) {
+	_, eventRepo, publisher, logger := setup(t)
+
+	op := New(
+		eventRepo,
+		publisher,
+		logger,
+	)
+	op.batchSize = uint64(50)
+
+	ctx := context.Background()
+
+	events := []entities.BookEvent{
+		{ID: 1, BookId: 1, Payload: []byte("{}"), Type: entities.Created},
+		{ID: 2, BookId: 2, Payload: []byte("{}"), Type: entities.Created},
+	}
+	eventRepo.EXPECT().
+		Lock(ctx, op.batchSize, gomock.Any(), op.lockLease).
+		Return(events, nil)
+
+	publisher.EXPECT().
+		Publish(ctx, &events[0]).
+		Return(errors.New("false send"))
+
+	eventRepo.EXPECT().
+		Retry(ctx, int64(1), gomock.Any(), gomock.Any(), "false send").
+		Return(errs.Wrap(errs.ErrNotFound, "bookEventPostgres.Retry: locked event 1"))
+
+	//Log - run workers - #N
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).Times(1)
+	//Log - error to send kafka
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).Times(1)
+	//Log - the event is reclaimed by another worker
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).Times(1)
+
+	published, err := op.processEvent(ctx, uint8(1))
+
+	require.NoError(t, err)
+	require.Equal(t, 0, published)
+}
+
+func TestStart_Wake(t *testing.T) {
+	ctrl, eventRepo, publisher, logger := setup(t)
+	listener := mocks.NewMockBookEventListener(ctrl)
+
+	op := New(
+		eventRepo,
+		publisher,
+		logger,
+		WithListener(listener),
+		WithInterval(time.Hour),
+		WithCountWorkers(1),
+	)
+	op.idleMin = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wake := make(chan struct{}, 1)
+	listener.EXPECT().Listen(gomock.Any()).Return(wake)
+
+	locked := make(chan struct{}, 2)
+	eventRepo.EXPECT().
+		Lock(gomock.Any(), op.batchSize, gomock.Any(), op.lockLease).
+		DoAndReturn(func(ctx context.Context, batchSize uint64, owner string, lease time.Duration) ([]entities.BookEvent, error) {
+			locked <- struct{}{}
+
+			return nil, errs.ErrNotFound
+		}).
+		Times(2)
+
+	//Log - run workers, no events found
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).Times(4)
+	//Log - graceful shutdown
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- op.Start(ctx)
+	}()
+
+	// the first batch is locked at start, the second one only on the wake
+	waitLocked(t, locked)
+	wake <- struct{}{}
+	waitLocked(t, locked)
+
+	cancel()
+	require.NoError(t, <-errCh)
+	require.Equal(t, Stats{}, op.Stats())
+}
+
+func TestStart_DrainFullBatches(t *testing.T) {
+	_, eventRepo, publisher, logger := setup(t)
+
+	op := New(
+		eventRepo,
+		publisher,
+		logger,
+		WithInterval(time.Hour),
+		WithCountWorkers(1),
+		WithBatchSize(2),
+	)
+	op.idleMin = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := []entities.BookEvent{
+		{ID: 1, BookId: 1, Payload: []byte("{}"), Type: entities.Created},
+		{ID: 2, BookId: 2, Payload: []byte("{}"), Type: entities.Created},
+	}
+
+	locked := make(chan struct{}, 1)
+	gomock.InOrder(
+		eventRepo.EXPECT().
+			Lock(gomock.Any(), uint64(2), gomock.Any(), op.lockLease).
+			Return(events, nil),
+		eventRepo.EXPECT().
+			Lock(gomock.Any(), uint64(2), gomock.Any(), op.lockLease).
+			DoAndReturn(func(ctx context.Context, batchSize uint64, owner string, lease time.Duration) ([]entities.BookEvent, error) {
+				locked <- struct{}{}
+
+				return nil, errs.ErrNotFound
+			}),
+	)
+
+	publisher.EXPECT().
+		Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, event *entities.BookEvent) error {
+			require.Equal(t, Stats{Workers: 1, Busy: 1, InFlight: 2}, op.Stats())
+
+			return nil
+		}).
+		Times(2)
+	eventRepo.EXPECT().Remove(gomock.Any(), []int64{1, 2}, gomock.Any()).Return(nil)
+
+	//Log - run workers #2, no events found
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).Times(3)
+	//Log - graceful shutdown
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- op.Start(ctx)
+	}()
+
+	// the full batch is followed by the next one without the wait
+	waitLocked(t, locked)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestStart_LockErrorBackoff(t *testing.T) {
+	ctrl, eventRepo, publisher, logger := setup(t)
+	metrics := mocks.NewMockRepositoriesMetrics(ctrl)
+
+	op := New(
+		eventRepo,
+		publisher,
+		logger,
+		WithMetrics(metrics),
+		WithInterval(time.Hour),
+		WithCountWorkers(1),
+	)
+	op.idleMin = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	locked := make(chan struct{}, 1)
+	gomock.InOrder(
+		eventRepo.EXPECT().
+			Lock(gomock.Any(), op.batchSize, gomock.Any(), op.lockLease).
+			Return(nil, errors.New("db error")),
+		eventRepo.EXPECT().
+			Lock(gomock.Any(), op.batchSize, gomock.Any(), op.lockLease).
+			DoAndReturn(func(ctx context.Context, batchSize uint64, owner string, lease time.Duration) ([]entities.BookEvent, error) {
+				locked <- struct{}{}
+
+				return nil, errs.ErrNotFound
+			}),
+	)
+	metrics.EXPECT().RecordEventsLockFailed(gomock.Any()).Times(1)
+
+	//Log - run workers #2, no events found
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).Times(3)
+	//Log - lock failed
+	logger.EXPECT().Error("outbox.processEvent: lock failed", gomock.Any()).Times(1)
+	//Log - graceful shutdown
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- op.Start(ctx)
+	}()
+
+	// the worker locks again after the backoff, the pool keeps running
+	waitLocked(t, locked)
+
+	cancel()
+	require.NoError(t, <-errCh)
+	require.Equal(t, Stats{}, op.Stats())
+}
+
+func waitLocked(t *testing.T, locked <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("the batch is not locked")
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	op := New(nil, nil, nil, WithRetryBackoff(time.Second, 10*time.Second))
+
+	tests := []struct {
+		attempt int32
+		max     time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 8 * time.Second},
+		{5, 10 * time.Second},
+		{40, 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		for range 20 {
+			delay := op.retryDelay(tt.attempt)
+
+			require.GreaterOrEqual(t, delay, tt.max/2)
+			require.LessOrEqual(t, delay, tt.max)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+ALTER TABLE book_event DROP CONSTRAINT IF EXISTS book_event_book_id_fkey;
+CREATE INDEX idx_book_removed_updated_at ON book(updated_at) WHERE removed = TRUE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP INDEX IF EXISTS idx_book_removed_updated_at;
+DELETE FROM book_event WHERE book_id NOT IN (SELECT id FROM book);
+ALTER TABLE book_event ADD CONSTRAINT book_event_book_id_fkey FOREIGN KEY (book_id) REFERENCES book(id) ON DELETE CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+CREATE TABLE IF NOT EXISTS authors(
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS book_authors(
+    book_id BIGINT NOT NULL REFERENCES book(id) ON DELETE CASCADE,
+    author_id BIGINT NOT NULL REFERENCES authors(id) ON DELETE RESTRICT,
+    position SMALLINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (book_id, author_id)
+);
+CREATE INDEX idx_book_authors_author_id ON book_authors(author_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP TABLE book_authors;
+DROP TABLE authors;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+CREATE TABLE IF NOT EXISTS genres(
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(127) NOT NULL,
+    parent_id BIGINT REFERENCES genres(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX idx_genres_name ON genres(LOWER(name));
+CREATE INDEX idx_genres_parent_id ON genres(parent_id);
+
+INSERT INTO genres (name)
+SELECT DISTINCT INITCAP(LOWER(COALESCE(NULLIF(TRIM(genre), ''), 'Other'))) FROM book;
+
+ALTER TABLE book ADD COLUMN genre_id BIGINT REFERENCES genres(id);
+UPDATE book SET genre_id = genres.id
+FROM genres
+WHERE LOWER(genres.name) = LOWER(COALESCE(NULLIF(TRIM(book.genre), ''), 'Other'));
+ALTER TABLE book ALTER COLUMN genre_id SET NOT NULL;
+ALTER TABLE book DROP COLUMN genre;
+CREATE INDEX idx_book_genre_id ON book(genre_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+ALTER TABLE book ADD COLUMN genre VARCHAR(127);
+UPDATE book SET genre = genres.name FROM genres WHERE genres.id = book.genre_id;
+ALTER TABLE book ALTER COLUMN genre SET NOT NULL;
+ALTER TABLE book DROP COLUMN genre_id;
+DROP TABLE genres;
+-- +goose StatementEnd
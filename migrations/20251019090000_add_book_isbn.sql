@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+ALTER TABLE book ADD COLUMN isbn VARCHAR(13) NOT NULL DEFAULT '';
+CREATE UNIQUE INDEX idx_book_isbn ON book(isbn) WHERE removed = false AND isbn <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP INDEX IF EXISTS idx_book_isbn;
+ALTER TABLE book DROP COLUMN isbn;
+-- +goose StatementEnd
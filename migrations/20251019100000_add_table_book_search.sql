@@ -0,0 +1,70 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+CREATE OR REPLACE FUNCTION book_search_config() RETURNS regconfig AS $$
+    SELECT COALESCE(NULLIF(current_setting('book.search_config', true), ''), 'simple')::regconfig;
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION book_search_document(title TEXT, description TEXT, genre_id BIGINT) RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector(book_search_config(), COALESCE(title, '')), 'A') ||
+        setweight(to_tsvector(book_search_config(), COALESCE(description, '')), 'B') ||
+        setweight(to_tsvector(book_search_config(), COALESCE((SELECT name FROM genres WHERE id = genre_id), '')), 'C');
+$$ LANGUAGE sql STABLE;
+
+CREATE TABLE IF NOT EXISTS book_search(
+    book_id BIGINT PRIMARY KEY REFERENCES book(id) ON DELETE CASCADE,
+    document TSVECTOR NOT NULL
+);
+CREATE INDEX idx_book_search_document ON book_search USING GIN(document);
+
+CREATE OR REPLACE FUNCTION book_search_refresh() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO book_search (book_id, document)
+    VALUES (NEW.id, book_search_document(NEW.title, NEW.description, NEW.genre_id))
+    ON CONFLICT (book_id) DO UPDATE SET document = EXCLUDED.document;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER book_search_refresh AFTER INSERT OR UPDATE OF title, description, genre_id ON book
+    FOR EACH ROW EXECUTE FUNCTION book_search_refresh();
+
+CREATE OR REPLACE FUNCTION genre_search_refresh() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE book_search SET document = book_search_document(book.title, book.description, book.genre_id)
+    FROM book
+    WHERE book.id = book_search.book_id AND book.genre_id = NEW.id;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER genre_search_refresh AFTER UPDATE OF name ON genres
+    FOR EACH ROW WHEN (OLD.name IS DISTINCT FROM NEW.name) EXECUTE FUNCTION genre_search_refresh();
+
+CREATE OR REPLACE FUNCTION book_search_rebuild() RETURNS BIGINT AS $$
+    WITH rebuilt AS (
+        INSERT INTO book_search (book_id, document)
+        SELECT id, book_search_document(title, description, genre_id) FROM book
+        ON CONFLICT (book_id) DO UPDATE SET document = EXCLUDED.document
+        RETURNING 1
+    )
+    SELECT COUNT(*) FROM rebuilt;
+$$ LANGUAGE sql;
+
+SELECT book_search_rebuild();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP TRIGGER IF EXISTS genre_search_refresh ON genres;
+DROP TRIGGER IF EXISTS book_search_refresh ON book;
+DROP FUNCTION IF EXISTS genre_search_refresh();
+DROP FUNCTION IF EXISTS book_search_refresh();
+DROP FUNCTION IF EXISTS book_search_rebuild();
+DROP TABLE IF EXISTS book_search;
+DROP FUNCTION IF EXISTS book_search_document(TEXT, TEXT, BIGINT);
+DROP FUNCTION IF EXISTS book_search_config();
+-- +goose StatementEnd
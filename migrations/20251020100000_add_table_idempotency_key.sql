@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+CREATE TABLE IF NOT EXISTS idempotency_key(
+    operation VARCHAR(32) NOT NULL,
+    key VARCHAR(128) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL,
+    response BYTEA,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (operation, key)
+);
+CREATE INDEX idx_idempotency_key_expires_at ON idempotency_key(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP TABLE idempotency_key;
+-- +goose StatementEnd
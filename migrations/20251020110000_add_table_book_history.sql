@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+CREATE TABLE IF NOT EXISTS book_history(
+    book_id BIGINT NOT NULL,
+    version BIGINT NOT NULL,
+    type SMALLINT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    year SMALLINT NOT NULL,
+    genre_id BIGINT NOT NULL,
+    isbn VARCHAR(13) NOT NULL DEFAULT '',
+    removed BOOL NOT NULL,
+    author_ids JSONB NOT NULL DEFAULT '[]',
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    changed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (book_id, version)
+);
+CREATE INDEX idx_book_history_changed_at ON book_history(changed_at);
+
+INSERT INTO book_history (book_id, version, type, title, description, year, genre_id, isbn, removed, author_ids, created_at, changed_at)
+SELECT b.id, b.version, CASE WHEN b.version = 1 THEN 1 ELSE 2 END, b.title, b.description, b.year, b.genre_id, b.isbn, b.removed,
+    COALESCE((SELECT jsonb_agg(ba.author_id ORDER BY ba.position) FROM book_authors ba WHERE ba.book_id = b.id), '[]'),
+    b.created_at, b.updated_at
+FROM book b;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP TABLE book_history;
+-- +goose StatementEnd
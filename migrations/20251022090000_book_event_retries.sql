@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+ALTER TABLE book_event
+    ADD COLUMN attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN last_error TEXT NOT NULL DEFAULT '';
+CREATE INDEX idx_book_event_next_attempt_at ON book_event(next_attempt_at);
+
+CREATE TABLE book_event_dead PARTITION OF book_event FOR VALUES IN (4);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+UPDATE book_event SET status = 3 WHERE status = 4;
+DROP TABLE book_event_dead;
+DROP INDEX IF EXISTS idx_book_event_next_attempt_at;
+ALTER TABLE book_event
+    DROP COLUMN last_error,
+    DROP COLUMN next_attempt_at,
+    DROP COLUMN attempts;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+ALTER TABLE book_event
+    ADD COLUMN locked_until TIMESTAMP,
+    ADD COLUMN locked_by TEXT NOT NULL DEFAULT '';
+-- the events locked before the lease are reclaimed at once
+UPDATE book_event SET locked_until = NOW() WHERE status = 2;
+CREATE INDEX idx_book_event_locked_until ON book_event(locked_until);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP INDEX IF EXISTS idx_book_event_locked_until;
+ALTER TABLE book_event
+    DROP COLUMN locked_by,
+    DROP COLUMN locked_until;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- the notification is delivered on commit of the transaction adding the events
+CREATE OR REPLACE FUNCTION book_event_notify() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('book_event', '');
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER book_event_notify AFTER INSERT ON book_event
+    FOR EACH STATEMENT EXECUTE FUNCTION book_event_notify();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+DROP TRIGGER IF EXISTS book_event_notify ON book_event;
+DROP FUNCTION IF EXISTS book_event_notify();
+-- +goose StatementEnd
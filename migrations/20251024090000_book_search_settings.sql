@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- the single row holds the config of the search, it is set from database.searchConfig on startup
+CREATE TABLE IF NOT EXISTS book_search_settings(
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    config REGCONFIG NOT NULL
+);
+INSERT INTO book_search_settings (config) VALUES ('english') ON CONFLICT (id) DO NOTHING;
+
+-- the vectors written by any session and the search queries read the same row
+CREATE OR REPLACE FUNCTION book_search_config() RETURNS regconfig AS $$
+    SELECT config FROM book_search_settings;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+CREATE OR REPLACE FUNCTION book_search_config() RETURNS regconfig AS $$
+    SELECT 'english'::regconfig;
+$$ LANGUAGE sql IMMUTABLE;
+
+DROP TABLE IF EXISTS book_search_settings;
+
+SELECT book_search_rebuild();
+-- +goose StatementEnd
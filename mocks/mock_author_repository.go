@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./author_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./../../../mocks/mock_author_repository.go -package=mocks -source=./author_repository.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/mathbdw/book/internal/domain/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthorRepository is a mock of AuthorRepository interface.
+type MockAuthorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorRepositoryMockRecorder is the mock recorder for MockAuthorRepository.
+type MockAuthorRepositoryMockRecorder struct {
+	mock *MockAuthorRepository
+}
+
+// NewMockAuthorRepository creates a new mock instance.
+func NewMockAuthorRepository(ctrl *gomock.Controller) *MockAuthorRepository {
+	mock := &MockAuthorRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorRepository) EXPECT() *MockAuthorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountBooks mocks base method.
+func (m *MockAuthorRepository) CountBooks(ctx context.Context, IDs []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountBooks", ctx, IDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountBooks indicates an expected call of CountBooks.
+func (mr *MockAuthorRepositoryMockRecorder) CountBooks(ctx, IDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountBooks", reflect.TypeOf((*MockAuthorRepository)(nil).CountBooks), ctx, IDs)
+}
+
+// Create mocks base method.
+func (m *MockAuthorRepository) Create(ctx context.Context, author entities.Author) (entities.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, author)
+	ret0, _ := ret[0].(entities.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuthorRepositoryMockRecorder) Create(ctx, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuthorRepository)(nil).Create), ctx, author)
+}
+
+// GetByBookIDs mocks base method.
+func (m *MockAuthorRepository) GetByBookIDs(ctx context.Context, bookIDs []int64) (map[int64][]entities.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByBookIDs", ctx, bookIDs)
+	ret0, _ := ret[0].(map[int64][]entities.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByBookIDs indicates an expected call of GetByBookIDs.
+func (mr *MockAuthorRepositoryMockRecorder) GetByBookIDs(ctx, bookIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByBookIDs", reflect.TypeOf((*MockAuthorRepository)(nil).GetByBookIDs), ctx, bookIDs)
+}
+
+// GetByIDs mocks base method.
+func (m *MockAuthorRepository) GetByIDs(ctx context.Context, IDs []int64) ([]entities.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, IDs)
+	ret0, _ := ret[0].([]entities.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockAuthorRepositoryMockRecorder) GetByIDs(ctx, IDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockAuthorRepository)(nil).GetByIDs), ctx, IDs)
+}
+
+// List mocks base method.
+func (m *MockAuthorRepository) List(ctx context.Context, params entities.AuthorListParams) (*entities.ResponseAuthors, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, params)
+	ret0, _ := ret[0].(*entities.ResponseAuthors)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuthorRepositoryMockRecorder) List(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuthorRepository)(nil).List), ctx, params)
+}
+
+// Remove mocks base method.
+func (m *MockAuthorRepository) Remove(ctx context.Context, IDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, IDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockAuthorRepositoryMockRecorder) Remove(ctx, IDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockAuthorRepository)(nil).Remove), ctx, IDs)
+}
+
+// SetBookAuthors mocks base method.
+func (m *MockAuthorRepository) SetBookAuthors(ctx context.Context, bookID int64, authorIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBookAuthors", ctx, bookID, authorIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBookAuthors indicates an expected call of SetBookAuthors.
+func (mr *MockAuthorRepositoryMockRecorder) SetBookAuthors(ctx, bookID, authorIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBookAuthors", reflect.TypeOf((*MockAuthorRepository)(nil).SetBookAuthors), ctx, bookID, authorIDs)
+}
+
+// Update mocks base method.
+func (m *MockAuthorRepository) Update(ctx context.Context, author entities.Author) (entities.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, author)
+	ret0, _ := ret[0].(entities.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAuthorRepositoryMockRecorder) Update(ctx, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAuthorRepository)(nil).Update), ctx, author)
+}
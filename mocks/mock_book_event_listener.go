@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./book_event_listener.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./../../../mocks/mock_book_event_listener.go -package=mocks -source=./book_event_listener.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBookEventListener is a mock of BookEventListener interface.
+type MockBookEventListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookEventListenerMockRecorder
+	isgomock struct{}
+}
+
+// MockBookEventListenerMockRecorder is the mock recorder for MockBookEventListener.
+type MockBookEventListenerMockRecorder struct {
+	mock *MockBookEventListener
+}
+
+// NewMockBookEventListener creates a new mock instance.
+func NewMockBookEventListener(ctrl *gomock.Controller) *MockBookEventListener {
+	mock := &MockBookEventListener{ctrl: ctrl}
+	mock.recorder = &MockBookEventListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookEventListener) EXPECT() *MockBookEventListenerMockRecorder {
+	return m.recorder
+}
+
+// Listen mocks base method.
+func (m *MockBookEventListener) Listen(ctx context.Context) <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Listen", ctx)
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// Listen indicates an expected call of Listen.
+func (mr *MockBookEventListenerMockRecorder) Listen(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Listen", reflect.TypeOf((*MockBookEventListener)(nil).Listen), ctx)
+}
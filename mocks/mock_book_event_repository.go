@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/mathbdw/book/internal/domain/entities"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,20 @@ func (m *MockBookEventRepository) EXPECT() *MockBookEventRepositoryMockRecorder
 	return m.recorder
 }
 
+// Bury mocks base method.
+func (m *MockBookEventRepository) Bury(ctx context.Context, eventID int64, owner, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bury", ctx, eventID, owner, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Bury indicates an expected call of Bury.
+func (mr *MockBookEventRepositoryMockRecorder) Bury(ctx, eventID, owner, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bury", reflect.TypeOf((*MockBookEventRepository)(nil).Bury), ctx, eventID, owner, lastError)
+}
+
 // Create mocks base method.
 func (m *MockBookEventRepository) Create(ctx context.Context, bookEvent entities.BookEvent) (int64, error) {
 	m.ctrl.T.Helper()
@@ -56,45 +71,74 @@ func (mr *MockBookEventRepositoryMockRecorder) Create(ctx, bookEvent any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookEventRepository)(nil).Create), ctx, bookEvent)
 }
 
+// CreateBatch mocks base method.
+func (m *MockBookEventRepository) CreateBatch(ctx context.Context, bookEvents []entities.BookEvent) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, bookEvents)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockBookEventRepositoryMockRecorder) CreateBatch(ctx, bookEvents any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockBookEventRepository)(nil).CreateBatch), ctx, bookEvents)
+}
+
 // Lock mocks base method.
-func (m *MockBookEventRepository) Lock(ctx context.Context, batchSize uint64) ([]entities.BookEvent, error) {
+func (m *MockBookEventRepository) Lock(ctx context.Context, batchSize uint64, owner string, lease time.Duration) ([]entities.BookEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Lock", ctx, batchSize)
+	ret := m.ctrl.Call(m, "Lock", ctx, batchSize, owner, lease)
 	ret0, _ := ret[0].([]entities.BookEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Lock indicates an expected call of Lock.
-func (mr *MockBookEventRepositoryMockRecorder) Lock(ctx, batchSize any) *gomock.Call {
+func (mr *MockBookEventRepositoryMockRecorder) Lock(ctx, batchSize, owner, lease any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockBookEventRepository)(nil).Lock), ctx, batchSize)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockBookEventRepository)(nil).Lock), ctx, batchSize, owner, lease)
 }
 
 // Remove mocks base method.
-func (m *MockBookEventRepository) Remove(ctx context.Context, eventIDs []int64) error {
+func (m *MockBookEventRepository) Remove(ctx context.Context, eventIDs []int64, owner string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Remove", ctx, eventIDs)
+	ret := m.ctrl.Call(m, "Remove", ctx, eventIDs, owner)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Remove indicates an expected call of Remove.
-func (mr *MockBookEventRepositoryMockRecorder) Remove(ctx, eventIDs any) *gomock.Call {
+func (mr *MockBookEventRepositoryMockRecorder) Remove(ctx, eventIDs, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockBookEventRepository)(nil).Remove), ctx, eventIDs, owner)
+}
+
+// Retry mocks base method.
+func (m *MockBookEventRepository) Retry(ctx context.Context, eventID int64, owner string, delay time.Duration, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", ctx, eventID, owner, delay, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockBookEventRepositoryMockRecorder) Retry(ctx, eventID, owner, delay, lastError any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockBookEventRepository)(nil).Remove), ctx, eventIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockBookEventRepository)(nil).Retry), ctx, eventID, owner, delay, lastError)
 }
 
 // Unlock mocks base method.
-func (m *MockBookEventRepository) Unlock(ctx context.Context, eventIDs []int64) error {
+func (m *MockBookEventRepository) Unlock(ctx context.Context, eventIDs []int64, owner string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Unlock", ctx, eventIDs)
+	ret := m.ctrl.Call(m, "Unlock", ctx, eventIDs, owner)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Unlock indicates an expected call of Unlock.
-func (mr *MockBookEventRepositoryMockRecorder) Unlock(ctx, eventIDs any) *gomock.Call {
+func (mr *MockBookEventRepositoryMockRecorder) Unlock(ctx, eventIDs, owner any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockBookEventRepository)(nil).Unlock), ctx, eventIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockBookEventRepository)(nil).Unlock), ctx, eventIDs, owner)
 }
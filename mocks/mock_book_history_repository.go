@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./book_history_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./../../../mocks/mock_book_history_repository.go -package=mocks -source=./book_history_repository.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/mathbdw/book/internal/domain/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBookHistoryRepository is a mock of BookHistoryRepository interface.
+type MockBookHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBookHistoryRepositoryMockRecorder is the mock recorder for MockBookHistoryRepository.
+type MockBookHistoryRepositoryMockRecorder struct {
+	mock *MockBookHistoryRepository
+}
+
+// NewMockBookHistoryRepository creates a new mock instance.
+func NewMockBookHistoryRepository(ctrl *gomock.Controller) *MockBookHistoryRepository {
+	mock := &MockBookHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockBookHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookHistoryRepository) EXPECT() *MockBookHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByBookID mocks base method.
+func (m *MockBookHistoryRepository) GetByBookID(ctx context.Context, bookID int64) ([]entities.BookHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByBookID", ctx, bookID)
+	ret0, _ := ret[0].([]entities.BookHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByBookID indicates an expected call of GetByBookID.
+func (mr *MockBookHistoryRepositoryMockRecorder) GetByBookID(ctx, bookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByBookID", reflect.TypeOf((*MockBookHistoryRepository)(nil).GetByBookID), ctx, bookID)
+}
+
+// Record mocks base method.
+func (m *MockBookHistoryRepository) Record(ctx context.Context, bookIDs []int64, eventType entities.EventType, actor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, bookIDs, eventType, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockBookHistoryRepositoryMockRecorder) Record(ctx, bookIDs, eventType, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockBookHistoryRepository)(nil).Record), ctx, bookIDs, eventType, actor)
+}
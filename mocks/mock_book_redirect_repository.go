@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./book_redirect_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./../../../mocks/mock_book_redirect_repository.go -package=mocks -source=./book_redirect_repository.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBookRedirectRepository is a mock of BookRedirectRepository interface.
+type MockBookRedirectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookRedirectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBookRedirectRepositoryMockRecorder is the mock recorder for MockBookRedirectRepository.
+type MockBookRedirectRepositoryMockRecorder struct {
+	mock *MockBookRedirectRepository
+}
+
+// NewMockBookRedirectRepository creates a new mock instance.
+func NewMockBookRedirectRepository(ctrl *gomock.Controller) *MockBookRedirectRepository {
+	mock := &MockBookRedirectRepository{ctrl: ctrl}
+	mock.recorder = &MockBookRedirectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookRedirectRepository) EXPECT() *MockBookRedirectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockBookRedirectRepository) Add(ctx context.Context, sourceIDs []int64, targetID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, sourceIDs, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockBookRedirectRepositoryMockRecorder) Add(ctx, sourceIDs, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockBookRedirectRepository)(nil).Add), ctx, sourceIDs, targetID)
+}
+
+// Delete mocks base method.
+func (m *MockBookRedirectRepository) Delete(ctx context.Context, sourceIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, sourceIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBookRedirectRepositoryMockRecorder) Delete(ctx, sourceIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookRedirectRepository)(nil).Delete), ctx, sourceIDs)
+}
+
+// GetTargets mocks base method.
+func (m *MockBookRedirectRepository) GetTargets(ctx context.Context, IDs []int64) (map[int64]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTargets", ctx, IDs)
+	ret0, _ := ret[0].(map[int64]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTargets indicates an expected call of GetTargets.
+func (mr *MockBookRedirectRepositoryMockRecorder) GetTargets(ctx, IDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargets", reflect.TypeOf((*MockBookRedirectRepository)(nil).GetTargets), ctx, IDs)
+}
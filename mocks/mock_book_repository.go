@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/mathbdw/book/internal/domain/entities"
 	gomock "go.uber.org/mock/gomock"
@@ -41,11 +42,26 @@ func (m *MockBookRepository) EXPECT() *MockBookRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountPurgeable mocks base method.
+func (m *MockBookRepository) CountPurgeable(ctx context.Context, removedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPurgeable", ctx, removedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPurgeable indicates an expected call of CountPurgeable.
+func (mr *MockBookRepositoryMockRecorder) CountPurgeable(ctx, removedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPurgeable", reflect.TypeOf((*MockBookRepository)(nil).CountPurgeable), ctx, removedBefore)
+}
+
 // Create mocks base method.
-func (m *MockBookRepository) Create(ctx context.Context, book entities.Book) (int64, error) {
+func (m *MockBookRepository) Create(ctx context.Context, book entities.Book) (entities.Book, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, book)
-	ret0, _ := ret[0].(int64)
+	ret0, _ := ret[0].(entities.Book)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -56,6 +72,36 @@ func (mr *MockBookRepositoryMockRecorder) Create(ctx, book any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookRepository)(nil).Create), ctx, book)
 }
 
+// CreateBatch mocks base method.
+func (m *MockBookRepository) CreateBatch(ctx context.Context, books []entities.Book) ([]entities.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, books)
+	ret0, _ := ret[0].([]entities.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockBookRepositoryMockRecorder) CreateBatch(ctx, books any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockBookRepository)(nil).CreateBatch), ctx, books)
+}
+
+// FindDuplicates mocks base method.
+func (m *MockBookRepository) FindDuplicates(ctx context.Context, book entities.Book, minSimilarity float64, limit uint64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicates", ctx, book, minSimilarity, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicates indicates an expected call of FindDuplicates.
+func (mr *MockBookRepositoryMockRecorder) FindDuplicates(ctx, book, minSimilarity, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicates", reflect.TypeOf((*MockBookRepository)(nil).FindDuplicates), ctx, book, minSimilarity, limit)
+}
+
 // GetByIDs mocks base method.
 func (m *MockBookRepository) GetByIDs(ctx context.Context, IDs []int64) ([]entities.Book, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +117,36 @@ func (mr *MockBookRepositoryMockRecorder) GetByIDs(ctx, IDs any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockBookRepository)(nil).GetByIDs), ctx, IDs)
 }
 
+// GetByIDsAsOf mocks base method.
+func (m *MockBookRepository) GetByIDsAsOf(ctx context.Context, IDs []int64, asOf time.Time) ([]entities.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDsAsOf", ctx, IDs, asOf)
+	ret0, _ := ret[0].([]entities.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDsAsOf indicates an expected call of GetByIDsAsOf.
+func (mr *MockBookRepositoryMockRecorder) GetByIDsAsOf(ctx, IDs, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDsAsOf", reflect.TypeOf((*MockBookRepository)(nil).GetByIDsAsOf), ctx, IDs, asOf)
+}
+
+// GetByISBN mocks base method.
+func (m *MockBookRepository) GetByISBN(ctx context.Context, isbn string) (entities.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByISBN", ctx, isbn)
+	ret0, _ := ret[0].(entities.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByISBN indicates an expected call of GetByISBN.
+func (mr *MockBookRepositoryMockRecorder) GetByISBN(ctx, isbn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByISBN", reflect.TypeOf((*MockBookRepository)(nil).GetByISBN), ctx, isbn)
+}
+
 // List mocks base method.
 func (m *MockBookRepository) List(ctx context.Context, params entities.PaginationParams) (*entities.ResponseBooks, error) {
 	m.ctrl.T.Helper()
@@ -86,16 +162,89 @@ func (mr *MockBookRepositoryMockRecorder) List(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBookRepository)(nil).List), ctx, params)
 }
 
+// LockPurgeable mocks base method.
+func (m *MockBookRepository) LockPurgeable(ctx context.Context, removedBefore time.Time, limit uint64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPurgeable", ctx, removedBefore, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockPurgeable indicates an expected call of LockPurgeable.
+func (mr *MockBookRepositoryMockRecorder) LockPurgeable(ctx, removedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPurgeable", reflect.TypeOf((*MockBookRepository)(nil).LockPurgeable), ctx, removedBefore, limit)
+}
+
+// Purge mocks base method.
+func (m *MockBookRepository) Purge(ctx context.Context, IDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, IDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockBookRepositoryMockRecorder) Purge(ctx, IDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockBookRepository)(nil).Purge), ctx, IDs)
+}
+
 // Remove mocks base method.
-func (m *MockBookRepository) Remove(ctx context.Context, IDs []int64) error {
+func (m *MockBookRepository) Remove(ctx context.Context, IDs []int64, expectedVersion int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Remove", ctx, IDs)
+	ret := m.ctrl.Call(m, "Remove", ctx, IDs, expectedVersion)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Remove indicates an expected call of Remove.
-func (mr *MockBookRepositoryMockRecorder) Remove(ctx, IDs any) *gomock.Call {
+func (mr *MockBookRepositoryMockRecorder) Remove(ctx, IDs, expectedVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockBookRepository)(nil).Remove), ctx, IDs, expectedVersion)
+}
+
+// Restore mocks base method.
+func (m *MockBookRepository) Restore(ctx context.Context, IDs []int64, expectedVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, IDs, expectedVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockBookRepositoryMockRecorder) Restore(ctx, IDs, expectedVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockBookRepository)(nil).Restore), ctx, IDs, expectedVersion)
+}
+
+// Search mocks base method.
+func (m *MockBookRepository) Search(ctx context.Context, text string, params entities.PaginationParams) (*entities.ResponseBookSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, text, params)
+	ret0, _ := ret[0].(*entities.ResponseBookSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockBookRepositoryMockRecorder) Search(ctx, text, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockBookRepository)(nil).Search), ctx, text, params)
+}
+
+// Update mocks base method.
+func (m *MockBookRepository) Update(ctx context.Context, book entities.Book, fields []entities.BookField) (entities.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, book, fields)
+	ret0, _ := ret[0].(entities.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBookRepositoryMockRecorder) Update(ctx, book, fields any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockBookRepository)(nil).Remove), ctx, IDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBookRepository)(nil).Update), ctx, book, fields)
 }
@@ -0,0 +1,176 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./genre_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./../../../mocks/mock_genre_repository.go -package=mocks -source=./genre_repository.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/mathbdw/book/internal/domain/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGenreRepository is a mock of GenreRepository interface.
+type MockGenreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenreRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGenreRepositoryMockRecorder is the mock recorder for MockGenreRepository.
+type MockGenreRepositoryMockRecorder struct {
+	mock *MockGenreRepository
+}
+
+// NewMockGenreRepository creates a new mock instance.
+func NewMockGenreRepository(ctrl *gomock.Controller) *MockGenreRepository {
+	mock := &MockGenreRepository{ctrl: ctrl}
+	mock.recorder = &MockGenreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenreRepository) EXPECT() *MockGenreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountBooks mocks base method.
+func (m *MockGenreRepository) CountBooks(ctx context.Context, IDs []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountBooks", ctx, IDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountBooks indicates an expected call of CountBooks.
+func (mr *MockGenreRepositoryMockRecorder) CountBooks(ctx, IDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountBooks", reflect.TypeOf((*MockGenreRepository)(nil).CountBooks), ctx, IDs)
+}
+
+// CountChildren mocks base method.
+func (m *MockGenreRepository) CountChildren(ctx context.Context, IDs []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountChildren", ctx, IDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountChildren indicates an expected call of CountChildren.
+func (mr *MockGenreRepositoryMockRecorder) CountChildren(ctx, IDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountChildren", reflect.TypeOf((*MockGenreRepository)(nil).CountChildren), ctx, IDs)
+}
+
+// Create mocks base method.
+func (m *MockGenreRepository) Create(ctx context.Context, genre entities.Genre) (entities.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, genre)
+	ret0, _ := ret[0].(entities.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGenreRepositoryMockRecorder) Create(ctx, genre any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGenreRepository)(nil).Create), ctx, genre)
+}
+
+// GetByIDs mocks base method.
+func (m *MockGenreRepository) GetByIDs(ctx context.Context, IDs []int64) ([]entities.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, IDs)
+	ret0, _ := ret[0].([]entities.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockGenreRepositoryMockRecorder) GetByIDs(ctx, IDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockGenreRepository)(nil).GetByIDs), ctx, IDs)
+}
+
+// GetByName mocks base method.
+func (m *MockGenreRepository) GetByName(ctx context.Context, name string) (entities.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(entities.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockGenreRepositoryMockRecorder) GetByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockGenreRepository)(nil).GetByName), ctx, name)
+}
+
+// List mocks base method.
+func (m *MockGenreRepository) List(ctx context.Context) ([]entities.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]entities.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockGenreRepositoryMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGenreRepository)(nil).List), ctx)
+}
+
+// Remove mocks base method.
+func (m *MockGenreRepository) Remove(ctx context.Context, IDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, IDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockGenreRepositoryMockRecorder) Remove(ctx, IDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockGenreRepository)(nil).Remove), ctx, IDs)
+}
+
+// Subtree mocks base method.
+func (m *MockGenreRepository) Subtree(ctx context.Context, ID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subtree", ctx, ID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subtree indicates an expected call of Subtree.
+func (mr *MockGenreRepositoryMockRecorder) Subtree(ctx, ID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subtree", reflect.TypeOf((*MockGenreRepository)(nil).Subtree), ctx, ID)
+}
+
+// Update mocks base method.
+func (m *MockGenreRepository) Update(ctx context.Context, genre entities.Genre) (entities.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, genre)
+	ret0, _ := ret[0].(entities.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockGenreRepositoryMockRecorder) Update(ctx, genre any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockGenreRepository)(nil).Update), ctx, genre)
+}
@@ -112,6 +112,20 @@ func (m *MockRepositoriesMetrics) EXPECT() *MockRepositoriesMetricsMockRecorder
 	return m.recorder
 }
 
+// ObserveOutbox mocks base method.
+func (m *MockRepositoriesMetrics) ObserveOutbox(stats func() (int64, int64, int64)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObserveOutbox", stats)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ObserveOutbox indicates an expected call of ObserveOutbox.
+func (mr *MockRepositoriesMetricsMockRecorder) ObserveOutbox(stats any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveOutbox", reflect.TypeOf((*MockRepositoriesMetrics)(nil).ObserveOutbox), stats)
+}
+
 // RecordDatabaseQuery mocks base method.
 func (m *MockRepositoriesMetrics) RecordDatabaseQuery(ctx context.Context, operation, table string, duration float64, success bool) {
 	m.ctrl.T.Helper()
@@ -123,3 +137,27 @@ func (mr *MockRepositoriesMetricsMockRecorder) RecordDatabaseQuery(ctx, operatio
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDatabaseQuery", reflect.TypeOf((*MockRepositoriesMetrics)(nil).RecordDatabaseQuery), ctx, operation, table, duration, success)
 }
+
+// RecordEventsLockFailed mocks base method.
+func (m *MockRepositoriesMetrics) RecordEventsLockFailed(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordEventsLockFailed", ctx)
+}
+
+// RecordEventsLockFailed indicates an expected call of RecordEventsLockFailed.
+func (mr *MockRepositoriesMetricsMockRecorder) RecordEventsLockFailed(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEventsLockFailed", reflect.TypeOf((*MockRepositoriesMetrics)(nil).RecordEventsLockFailed), ctx)
+}
+
+// RecordEventsReclaimed mocks base method.
+func (m *MockRepositoriesMetrics) RecordEventsReclaimed(ctx context.Context, count int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordEventsReclaimed", ctx, count)
+}
+
+// RecordEventsReclaimed indicates an expected call of RecordEventsReclaimed.
+func (mr *MockRepositoriesMetricsMockRecorder) RecordEventsReclaimed(ctx, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEventsReclaimed", reflect.TypeOf((*MockRepositoriesMetrics)(nil).RecordEventsReclaimed), ctx, count)
+}
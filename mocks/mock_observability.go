@@ -229,6 +229,20 @@ func (m *MockRepositoryObservability) EXPECT() *MockRepositoryObservabilityMockR
 	return m.recorder
 }
 
+// ObserveOutbox mocks base method.
+func (m *MockRepositoryObservability) ObserveOutbox(stats func() (int64, int64, int64)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObserveOutbox", stats)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ObserveOutbox indicates an expected call of ObserveOutbox.
+func (mr *MockRepositoryObservabilityMockRecorder) ObserveOutbox(stats any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveOutbox", reflect.TypeOf((*MockRepositoryObservability)(nil).ObserveOutbox), stats)
+}
+
 // RecordDatabaseQuery mocks base method.
 func (m *MockRepositoryObservability) RecordDatabaseQuery(ctx context.Context, operation, table string, duration float64, success bool) {
 	m.ctrl.T.Helper()
@@ -241,6 +255,30 @@ func (mr *MockRepositoryObservabilityMockRecorder) RecordDatabaseQuery(ctx, oper
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDatabaseQuery", reflect.TypeOf((*MockRepositoryObservability)(nil).RecordDatabaseQuery), ctx, operation, table, duration, success)
 }
 
+// RecordEventsLockFailed mocks base method.
+func (m *MockRepositoryObservability) RecordEventsLockFailed(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordEventsLockFailed", ctx)
+}
+
+// RecordEventsLockFailed indicates an expected call of RecordEventsLockFailed.
+func (mr *MockRepositoryObservabilityMockRecorder) RecordEventsLockFailed(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEventsLockFailed", reflect.TypeOf((*MockRepositoryObservability)(nil).RecordEventsLockFailed), ctx)
+}
+
+// RecordEventsReclaimed mocks base method.
+func (m *MockRepositoryObservability) RecordEventsReclaimed(ctx context.Context, count int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordEventsReclaimed", ctx, count)
+}
+
+// RecordEventsReclaimed indicates an expected call of RecordEventsReclaimed.
+func (mr *MockRepositoryObservabilityMockRecorder) RecordEventsReclaimed(ctx, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEventsReclaimed", reflect.TypeOf((*MockRepositoryObservability)(nil).RecordEventsReclaimed), ctx, count)
+}
+
 // StartSpan mocks base method.
 func (m *MockRepositoryObservability) StartSpan(ctx context.Context, name string) (context.Context, interfaces.Span) {
 	m.ctrl.T.Helper()
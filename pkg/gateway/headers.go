@@ -0,0 +1,81 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/mathbdw/book/proto"
+)
+
+const (
+	// headerIfMatch - the conditional request header, forwarded to the metadata key "if-match"
+	headerIfMatch = "If-Match"
+	// headerETag - the version of the book in the response
+	headerETag = "ETag"
+	// headerIdempotencyKey - the key of the retried mutating request, forwarded to the metadata key "idempotency-key"
+	headerIdempotencyKey = "Idempotency-Key"
+	// headerCallerID - the caller of the request recorded in the book history, forwarded to the metadata key "x-caller-id"
+	headerCallerID = "X-Caller-Id"
+)
+
+// headerMatcher - forwards If-Match, Idempotency-Key and X-Caller-Id as is, the other headers as the default matcher does
+func headerMatcher(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case headerIfMatch:
+		return "if-match", true
+	case headerIdempotencyKey:
+		return "idempotency-key", true
+	case headerCallerID:
+		return "x-caller-id", true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// etagForwarder - sets ETag to the version of the only book of the response
+func etagForwarder(_ context.Context, w http.ResponseWriter, msg proto.Message) error {
+	var book *pb.Book
+	switch resp := msg.(type) {
+	case *pb.Book:
+		book = resp
+	case *pb.BooksResponse:
+		if len(resp.GetBook()) == 1 {
+			book = resp.GetBook()[0]
+		}
+	}
+
+	if book.GetVersion() > 0 {
+		w.Header().Set(headerETag, fmt.Sprintf(`"%d"`, book.GetVersion()))
+	}
+
+	return nil
+}
+
+// errorHandler - responds 412 Precondition Failed instead of 409 Conflict when the If-Match version does not match
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if status.Code(err) == codes.Aborted && r.Header.Get(headerIfMatch) != "" {
+		w = &preconditionWriter{ResponseWriter: w}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// preconditionWriter - replaces the status 409 Conflict with 412 Precondition Failed
+type preconditionWriter struct {
+	http.ResponseWriter
+}
+
+func (pw *preconditionWriter) WriteHeader(code int) {
+	if code == http.StatusConflict {
+		code = http.StatusPreconditionFailed
+	}
+
+	pw.ResponseWriter.WriteHeader(code)
+}
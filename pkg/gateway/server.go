@@ -52,12 +52,26 @@ func (s *Server) Start(log observability.Logger) {
 		log.Error("gateway.Start: failed to dial server", map[string]any{"error": err.Error()})
 	}
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithForwardResponseOption(etagForwarder),
+		runtime.WithErrorHandler(errorHandler),
+	)
 	if err := pb.RegisterBookServiceHandler(context.Background(), mux, conn); err != nil {
 		log.Error("gateway.Start: failed registration handler book", map[string]any{"error": err.Error()})
 
 		return
 	}
+	if err := pb.RegisterAuthorServiceHandler(context.Background(), mux, conn); err != nil {
+		log.Error("gateway.Start: failed registration handler author", map[string]any{"error": err.Error()})
+
+		return
+	}
+	if err := pb.RegisterGenreServiceHandler(context.Background(), mux, conn); err != nil {
+		log.Error("gateway.Start: failed registration handler genre", map[string]any{"error": err.Error()})
+
+		return
+	}
 
 	var handler http.Handler = mux
 	handler = GatewayMiddleware(log, handler)
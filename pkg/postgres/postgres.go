@@ -1,9 +1,11 @@
 package postgres
 
 import (
+	"context"
 	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/jmoiron/sqlx"
 
@@ -57,3 +59,8 @@ func New(log observability.Logger, opts ...Option) (*Postgres, error) {
 
 	return pg, nil
 }
+
+// Connect - opens the connection outside the pool, for the sessions holding it, e.g. LISTEN
+func (p *Postgres) Connect(ctx context.Context) (*pgx.Conn, error) {
+	return pgx.Connect(ctx, p.dsn)
+}